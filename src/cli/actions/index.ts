@@ -11,3 +11,13 @@ export {
 	executeRDFFileAction,
 	type RDFFileActionOptions,
 } from "./rdf-file-action";
+export {
+	executeReverseDepsAction,
+	formatReverseDepsTree,
+	type ReverseDepsActionOptions,
+} from "./reverse-deps-action";
+export {
+	executeSemanticCheckAction,
+	type SemanticCheckActionOptions,
+} from "./semantic-check-action";
+export { executeServeAction, type ServeActionOptions } from "./serve-action";
@@ -0,0 +1,106 @@
+import path from "node:path";
+import type { ImpactEntry } from "../../semantic/impact";
+import { SemanticQueryEngine } from "../../semantic/SemanticQueryEngine";
+import type { SemanticNode } from "../../semantic/types";
+import { analyzeSemanticProject } from "./semantic-project";
+
+export interface ReverseDepsActionOptions {
+	directory?: string;
+	pattern?: string;
+	depth?: string;
+	format?: string;
+}
+
+/**
+ * 심볼의 역방향 의존성(이 심볼에 의존하는 심볼)을 트리로 출력
+ *
+ * 잘못된 깊이나 찾을 수 없는 심볼이면 2를 반환한다.
+ */
+export async function executeReverseDepsAction(
+	symbol: string,
+	options: ReverseDepsActionOptions,
+): Promise<number> {
+	const depth = Number(options.depth ?? "0");
+	if (!Number.isInteger(depth) || depth < 0) {
+		console.error(`❌ Invalid --depth: ${options.depth} (expected 0 or more)`);
+		return 2;
+	}
+
+	const directory = path.resolve(options.directory || process.cwd());
+	const graph = await analyzeSemanticProject(directory, options.pattern);
+	const root =
+		graph.getNode(symbol) ??
+		Array.from(graph.nodes.values()).find((node) => node.fqn === symbol);
+	if (!root) {
+		console.error(`❌ Unknown symbol: ${symbol}`);
+		return 2;
+	}
+
+	const entries = new SemanticQueryEngine(graph).reverseDeps(root.id, depth);
+
+	if (options.format === "json") {
+		console.log(
+			JSON.stringify(
+				{
+					symbol: root.id,
+					depth,
+					dependents: entries.map((entry) => ({
+						id: entry.node.id,
+						filePath: entry.node.filePath,
+						line: entry.node.line,
+						depth: entry.depth,
+						via: entry.via,
+					})),
+				},
+				null,
+				2,
+			),
+		);
+		return 0;
+	}
+
+	for (const line of formatReverseDepsTree(root, entries)) {
+		console.log(line);
+	}
+	console.log(`\n📊 ${entries.length} dependents`);
+	return 0;
+}
+
+/**
+ * 역방향 의존성을 들여쓴 트리 줄로 변환
+ *
+ * 각 심볼은 의존하는 노드(via) 아래에 한 번만 나온다. 루트의 멤버를 통해
+ * 의존하면 "(via 멤버 이름)"을 덧붙인다.
+ */
+export function formatReverseDepsTree(
+	root: SemanticNode,
+	entries: ImpactEntry[],
+): string[] {
+	const children = new Map<string, ImpactEntry[]>();
+	for (const entry of entries) {
+		const parent = entry.depth === 1 ? root.id : entry.via;
+		const siblings = children.get(parent) ?? [];
+		siblings.push(entry);
+		children.set(parent, siblings);
+	}
+
+	const lines = [`${root.fqn} (${formatLocation(root)})`];
+	const visit = (id: string, indent: string) => {
+		for (const entry of children.get(id) ?? []) {
+			const via =
+				entry.depth === 1 && entry.via !== root.id
+					? ` (via ${entry.via.slice(entry.via.lastIndexOf(".") + 1)})`
+					: "";
+			lines.push(
+				`${indent}└─ ${entry.node.fqn} (${formatLocation(entry.node)})${via}`,
+			);
+			visit(entry.node.id, `${indent}   `);
+		}
+	};
+	visit(root.id, "  ");
+	return lines;
+}
+
+function formatLocation(node: SemanticNode): string {
+	return node.line ? `${node.filePath}:${node.line}` : node.filePath;
+}
@@ -0,0 +1,130 @@
+import { promises as fs } from "node:fs";
+import path from "node:path";
+import {
+	checkLayering,
+	type LayeringConfig,
+} from "../../semantic/checks/layering";
+import {
+	DEFAULT_CHECKS,
+	DEFAULT_TAG_RULES,
+	runChecks,
+} from "../../semantic/checks/run-checks";
+import { createTagKindRule } from "../../semantic/checks/tag-kinds";
+import { checkTagTaxonomy } from "../../semantic/checks/tag-taxonomy";
+import { checkUnusedReceivers } from "../../semantic/checks/unused-receiver";
+import {
+	formatGitHubAnnotations,
+	formatSarif,
+} from "../../semantic/diagnostic-formats";
+import {
+	countFailures,
+	isDiagnosticSeverity,
+} from "../../semantic/diagnostics";
+import { DEFAULT_KIND_REFINEMENTS } from "../../semantic/kind-inference";
+import { loadTagTaxonomy } from "../../semantic/tag-taxonomy";
+import type { SemanticDiagnostic } from "../../semantic/types";
+import { analyzeSemanticProject } from "./semantic-project";
+
+export interface SemanticCheckActionOptions {
+	directory?: string;
+	pattern?: string;
+	failLevel?: string;
+	format?: string;
+	/**
+	 * 태그 택소노미 파일 (JSON/YAML, 지정 시 tag-taxonomy 검사 추가,
+	 * tagKinds가 있으면 tag-kind 검사의 태그 -> 종류 규칙으로 사용)
+	 */
+	taxonomy?: string;
+	/** 계층 규칙 JSON 파일 (LayeringConfig, 지정 시 layering 검사 추가) */
+	layers?: string;
+	/** 리시버를 쓰지 않는 메서드 제안(unused-receiver, info) 추가 */
+	unusedReceivers?: boolean;
+}
+
+const FORMATS = ["text", "json", "github", "sarif"];
+
+const SEVERITY_ICONS = {
+	error: "❌",
+	warning: "⚠️",
+	info: "ℹ️",
+};
+
+/**
+ * 시맨틱 그래프 검사 실행
+ *
+ * 억제되지 않은 진단 중 --fail-level 이상인 진단이 있으면 1을 반환한다.
+ * 기본 fail level은 info(모든 위반이 실패)이다. --format github는 GitHub
+ * Actions 주석 명령을, sarif는 코드 스캐닝용 SARIF 로그를 출력한다.
+ */
+export async function executeSemanticCheckAction(
+	options: SemanticCheckActionOptions,
+): Promise<number> {
+	const failLevel = options.failLevel ?? "info";
+	if (!isDiagnosticSeverity(failLevel)) {
+		console.error(
+			`❌ Invalid --fail-level: ${failLevel} (expected error, warning or info)`,
+		);
+		return 2;
+	}
+	const format = options.format ?? "text";
+	if (!FORMATS.includes(format)) {
+		console.error(
+			`❌ Invalid --format: ${format} (expected ${FORMATS.join(", ")})`,
+		);
+		return 2;
+	}
+
+	const directory = path.resolve(options.directory || process.cwd());
+	const checks = { ...DEFAULT_CHECKS };
+	const tagRules = { ...DEFAULT_TAG_RULES };
+	if (options.taxonomy) {
+		const taxonomy = await loadTagTaxonomy(path.resolve(options.taxonomy));
+		checks["tag-taxonomy"] = (graph) => checkTagTaxonomy(graph, taxonomy);
+		const { tagKinds } = taxonomy;
+		if (tagKinds) {
+			tagRules["tag-kind"] = createTagKindRule({
+				tagKinds,
+				refinements: DEFAULT_KIND_REFINEMENTS,
+			});
+		}
+	}
+	if (options.layers) {
+		const layering = JSON.parse(
+			await fs.readFile(path.resolve(options.layers), "utf-8"),
+		) as LayeringConfig;
+		checks.layering = (graph) => checkLayering(graph, layering);
+	}
+	if (options.unusedReceivers) {
+		checks["unused-receiver"] = (graph) => checkUnusedReceivers(graph);
+	}
+
+	const graph = await analyzeSemanticProject(directory, options.pattern);
+	const diagnostics = runChecks(graph, checks, tagRules);
+	const failures = countFailures(diagnostics, failLevel);
+
+	if (format === "json") {
+		console.log(JSON.stringify({ failLevel, failures, diagnostics }, null, 2));
+	} else if (format === "github") {
+		process.stdout.write(formatGitHubAnnotations(diagnostics, { graph }));
+	} else if (format === "sarif") {
+		console.log(JSON.stringify(formatSarif(diagnostics, { graph }), null, 2));
+	} else {
+		for (const diagnostic of diagnostics) {
+			console.log(formatDiagnostic(diagnostic));
+		}
+		const suppressed = diagnostics.filter((d) => d.suppressed).length;
+		console.log(
+			`\n📊 ${diagnostics.length} diagnostics (${suppressed} suppressed), ${failures} at or above ${failLevel}`,
+		);
+	}
+
+	return failures > 0 ? 1 : 0;
+}
+
+function formatDiagnostic(diagnostic: SemanticDiagnostic): string {
+	const location = diagnostic.filePath
+		? `${diagnostic.filePath}${diagnostic.line ? `:${diagnostic.line}` : ""} `
+		: "";
+	const suppressed = diagnostic.suppressed ? " (suppressed)" : "";
+	return `${SEVERITY_ICONS[diagnostic.severity]} ${location}[${diagnostic.ruleId}] ${diagnostic.message}${suppressed}`;
+}
@@ -0,0 +1,29 @@
+import { glob } from "glob";
+import path from "node:path";
+import { SemanticAnalyzer } from "../../semantic/SemanticAnalyzer";
+import type { SemanticGraph } from "../../semantic/SemanticGraph";
+
+/**
+ * 시맨틱 명령의 분석 대상 디렉토리를 그래프로 변환
+ *
+ * pattern이 없으면 analyzeDirectory로 등록된 추출기가 지원하는 모든 파일을
+ * 분석한다. pattern이 있으면 그 glob에 맞는 파일 중 analyzeDirectory가
+ * 분석할 파일만 남기므로 .linkerignore와 기본 제외 디렉토리가 똑같이 적용된다.
+ */
+export async function analyzeSemanticProject(
+	directory: string,
+	pattern?: string,
+): Promise<SemanticGraph> {
+	const analyzer = new SemanticAnalyzer({ projectRoot: directory });
+	if (!pattern) {
+		return analyzer.analyzeDirectory(directory);
+	}
+
+	const matched = new Set(
+		(await glob(pattern, { cwd: directory, absolute: true })).map((file) =>
+			path.resolve(file),
+		),
+	);
+	const files = await analyzer.listFiles(directory);
+	return analyzer.analyzeFiles(files.filter((file) => matched.has(file)));
+}
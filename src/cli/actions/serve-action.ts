@@ -0,0 +1,75 @@
+import type { AddressInfo } from "node:net";
+import path from "node:path";
+import { ExtractionCache } from "../../semantic/extraction-cache";
+import { GraphService } from "../../semantic/graph-service";
+import { SemanticAnalyzer } from "../../semantic/SemanticAnalyzer";
+import { createGraphServer } from "../../semantic/server";
+
+export interface ServeActionOptions {
+	directory?: string;
+	port?: string;
+	host?: string;
+	/** 파일 변경을 감시해 그래프를 자동으로 갱신 */
+	watch?: boolean;
+	/** 추출 캐시 파일 (시작 시 읽고 종료 시 저장) */
+	cache?: string;
+}
+
+/**
+ * 디렉토리를 분석한 그래프를 HTTP로 조회하는 서버 실행
+ *
+ * SIGINT/SIGTERM을 받으면 서버를 닫고 0을 반환한다. 잘못된 포트면
+ * 2를 반환한다.
+ */
+export async function executeServeAction(
+	options: ServeActionOptions,
+): Promise<number> {
+	const port = Number(options.port ?? "7070");
+	if (!Number.isInteger(port) || port < 0 || port > 65535) {
+		console.error(`❌ Invalid --port: ${options.port}`);
+		return 2;
+	}
+
+	const directory = path.resolve(options.directory || process.cwd());
+	const cacheFile = options.cache ? path.resolve(options.cache) : undefined;
+	const cache = cacheFile
+		? await ExtractionCache.load(cacheFile)
+		: new ExtractionCache();
+	const service = new GraphService(directory, {
+		analyzer: new SemanticAnalyzer({ projectRoot: directory, cache }),
+	});
+
+	const graph = await service.reload();
+	console.log(
+		`📊 Loaded ${graph.nodes.size} nodes, ${graph.edges.length} edges`,
+	);
+
+	const controller = new AbortController();
+	const server = createGraphServer(service);
+	await new Promise<void>((resolve) =>
+		server.listen(port, options.host ?? "127.0.0.1", resolve),
+	);
+	const address = server.address() as AddressInfo;
+	console.log(
+		`🚀 Serving ${directory} on http://${address.address}:${address.port}`,
+	);
+
+	const watching = options.watch
+		? service.watch({ signal: controller.signal })
+		: Promise.resolve();
+
+	await new Promise<void>((resolve) => {
+		const stop = () => {
+			controller.abort();
+			server.close(() => resolve());
+		};
+		process.once("SIGINT", stop);
+		process.once("SIGTERM", stop);
+	});
+	await watching;
+
+	if (cacheFile) {
+		await cache.save(cacheFile);
+	}
+	return 0;
+}
@@ -10,6 +10,9 @@ import {
 	executeDependenciesAction,
 	executeRDFAction,
 	executeRDFFileAction,
+	executeReverseDepsAction,
+	executeSemanticCheckAction,
+	executeServeAction,
 } from "./actions/index";
 import {
 	ContextDocumentsHandler,
@@ -409,6 +412,73 @@ program
 		await executeDependenciesAction(options);
 	});
 
+// ============================================================================
+// 시맨틱 그래프 검사 명령어
+// ============================================================================
+
+program
+	.command("check")
+	.description("Run semantic graph checks on project sources")
+	.option("-d, --directory <dir>", "Project root directory")
+	.option("-p, --pattern <pattern>", "File pattern to analyze")
+	.option(
+		"--fail-level <level>",
+		"Minimum severity that fails the run (error, warning, info)",
+		"info",
+	)
+	.option(
+		"--format <format>",
+		"Output format (text, json, github, sarif)",
+		"text",
+	)
+	.option("--taxonomy <file>", "Tag taxonomy file (JSON or YAML)")
+	.option("--layers <file>", "Layer dependency rules file (JSON)")
+	.option(
+		"--unused-receivers",
+		"Also report methods that never use their receiver (info)",
+	)
+	.action(async (options) => {
+		try {
+			process.exit(await executeSemanticCheckAction(options));
+		} catch (error) {
+			console.error("❌ Semantic check failed:", error);
+			process.exit(1);
+		}
+	});
+
+program
+	.command("reverse-deps <symbol>")
+	.description("Print symbols that transitively depend on a symbol")
+	.option("-d, --directory <dir>", "Project root directory")
+	.option("-p, --pattern <pattern>", "File pattern to analyze")
+	.option("--depth <number>", "Maximum depth (0 = unlimited)", "0")
+	.option("--format <format>", "Output format (text, json)", "text")
+	.action(async (symbol, options) => {
+		try {
+			process.exit(await executeReverseDepsAction(symbol, options));
+		} catch (error) {
+			console.error("❌ Reverse dependency lookup failed:", error);
+			process.exit(1);
+		}
+	});
+
+program
+	.command("serve")
+	.description("Serve graph queries over HTTP")
+	.option("-d, --directory <dir>", "Project root directory")
+	.option("--port <number>", "Port to listen on", "7070")
+	.option("--host <host>", "Host to bind", "127.0.0.1")
+	.option("-w, --watch", "Re-analyze changed files while serving")
+	.option("--cache <file>", "Extraction cache file to reuse across runs")
+	.action(async (options) => {
+		try {
+			process.exit(await executeServeAction(options));
+		} catch (error) {
+			console.error("❌ Server failed:", error);
+			process.exit(1);
+		}
+	});
+
 // ============================================================================
 // 벤치마크 명령어
 // ============================================================================
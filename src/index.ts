@@ -144,6 +144,8 @@ export type {
 	NamespaceList,
 	NamespaceWithFiles,
 } from "./namespace/types";
+// ===== SEMANTIC GRAPH EXPORTS =====
+export * from "./semantic";
 // ===== PARSER EXPORTS =====
 export type { ParseResult, ParserOptions } from "./parsers/base";
 export { GoParser } from "./parsers/go";
@@ -11,6 +11,21 @@ export interface ParserOptions {
 	filePath?: string;
 	/** 파싱 옵션 */
 	parseOptions?: Record<string, any>;
+	/** 파싱 시간 제한 (ms, 넘기면 ParseTimeoutError, 0 또는 생략 시 제한 없음) */
+	timeoutMs?: number;
+}
+
+/**
+ * 파싱이 시간 제한을 넘겨 중단되었을 때의 예외
+ */
+export class ParseTimeoutError extends Error {
+	constructor(
+		public timeoutMs: number,
+		filePath?: string,
+	) {
+		super(`Parsing ${filePath ?? "source"} exceeded ${timeoutMs}ms`);
+		this.name = "ParseTimeoutError";
+	}
 }
 
 export interface ParseResult {
@@ -55,6 +70,36 @@ export abstract class BaseParser {
 		options?: ParserOptions,
 	): Promise<ParseResult>;
 
+	/**
+	 * 시간 제한을 적용한 tree-sitter 파싱
+	 *
+	 * 제한을 넘기면 파서 상태를 초기화하고 ParseTimeoutError를 던진다
+	 * (초기화하지 않으면 다음 parse 호출이 중단된 파싱을 이어서 한다).
+	 */
+	protected parseTree(
+		parser: Parser,
+		sourceCode: string,
+		options: ParserOptions,
+	): Parser.Tree {
+		const { timeoutMs } = options;
+		if (!timeoutMs) {
+			return parser.parse(sourceCode);
+		}
+
+		let tree: Parser.Tree | null;
+		parser.setTimeoutMicros(timeoutMs * 1000);
+		try {
+			tree = parser.parse(sourceCode);
+		} finally {
+			parser.setTimeoutMicros(0);
+		}
+		if (!tree) {
+			parser.reset();
+			throw new ParseTimeoutError(timeoutMs, options.filePath);
+		}
+		return tree;
+	}
+
 	/**
 	 * 언어 지원 확인
 	 */
@@ -7,7 +7,12 @@ import { promises as fs } from "node:fs";
 import Parser from "tree-sitter";
 import Go from "tree-sitter-go";
 import type { QueryExecutionContext } from "../../core/types";
-import { BaseParser, type ParseResult, type ParserOptions } from "../base";
+import {
+	BaseParser,
+	type ParseResult,
+	type ParserOptions,
+	ParseTimeoutError,
+} from "../base";
 
 export class GoParser extends BaseParser {
 	protected language = "go" as const;
@@ -64,7 +69,7 @@ export class GoParser extends BaseParser {
 
 		try {
 			const parser = this.getParser();
-			const tree = parser.parse(sourceCode);
+			const tree = this.parseTree(parser, sourceCode, options);
 
 			if (!tree) {
 				throw new Error("Go parser returned null");
@@ -94,6 +99,9 @@ export class GoParser extends BaseParser {
 				},
 			};
 		} catch (error) {
+			if (error instanceof ParseTimeoutError) {
+				throw error;
+			}
 			throw new Error(
 				`Go parsing failed: ${error instanceof Error ? error.message : "Unknown error"}`,
 			);
@@ -10,6 +10,7 @@ export type {
 	ParserFactory as IParserFactory,
 	ParserOptions,
 } from "./base";
+export { ParseTimeoutError } from "./base";
 export * from "./go";
 export * from "./java";
 // ===== PARSER FACTORY =====
@@ -7,7 +7,12 @@ import { promises as fs } from "node:fs";
 import Parser from "tree-sitter";
 import Java from "tree-sitter-java";
 import type { QueryExecutionContext } from "../../core/types";
-import { BaseParser, type ParseResult, type ParserOptions } from "../base";
+import {
+	BaseParser,
+	type ParseResult,
+	type ParserOptions,
+	ParseTimeoutError,
+} from "../base";
 
 export class JavaParser extends BaseParser {
 	protected language = "java" as const;
@@ -65,21 +70,7 @@ export class JavaParser extends BaseParser {
 		try {
 			const parser = this.getParser();
 
-			// 파서 언어 설정 확인
-			const language = parser.getLanguage();
-			console.log("Java parser language:", {
-				language: !!language,
-				languageName: language?.name,
-			});
-
-			const tree = parser.parse(sourceCode);
-
-			console.log("Java parsing result:", {
-				tree: !!tree,
-				rootNode: !!tree?.rootNode,
-				rootNodeType: tree?.rootNode?.type,
-				sourceCodeLength: sourceCode.length,
-			});
+			const tree = this.parseTree(parser, sourceCode, options);
 
 			if (!tree) {
 				throw new Error("Java parser returned null");
@@ -109,6 +100,9 @@ export class JavaParser extends BaseParser {
 				},
 			};
 		} catch (error) {
+			if (error instanceof ParseTimeoutError) {
+				throw error;
+			}
 			console.error("Java parsing error details:", {
 				error: error instanceof Error ? error.message : error,
 				stack: error instanceof Error ? error.stack : undefined,
@@ -8,7 +8,12 @@ import { promises as fs } from "node:fs";
 import Parser from "tree-sitter";
 import TypeScript from "tree-sitter-typescript";
 import type { QueryExecutionContext } from "../../core/types";
-import { BaseParser, type ParseResult, type ParserOptions } from "../base";
+import {
+	BaseParser,
+	type ParseResult,
+	type ParserOptions,
+	ParseTimeoutError,
+} from "../base";
 
 /**
  * Parser Pool for Thread Safety
@@ -244,7 +249,7 @@ export class TypeScriptParser extends BaseParser {
 				}
 
 				// Tree-sitter 파싱 시도
-				tree = parser.parse(sourceCode);
+				tree = this.parseTree(parser, sourceCode, options);
 
 				// 테스트 환경에서 파싱 실패 원인 분석
 				if (!tree.rootNode) {
@@ -299,6 +304,9 @@ export class TypeScriptParser extends BaseParser {
 					throw new Error("Tree-sitter rootNode has invalid type");
 				}
 			} catch (parseError) {
+				if (parseError instanceof ParseTimeoutError) {
+					throw parseError;
+				}
 				// 파싱 실패 시 오류 던지기
 				throw new Error(`Tree-sitter parsing failed: ${parseError}`);
 			}
@@ -323,6 +331,9 @@ export class TypeScriptParser extends BaseParser {
 				},
 			};
 		} catch (error) {
+			if (error instanceof ParseTimeoutError) {
+				throw error;
+			}
 			console.error("TypeScript parsing error details:", {
 				error: error instanceof Error ? error.message : error,
 				stack: error instanceof Error ? error.stack : undefined,
@@ -0,0 +1,1505 @@
+/**
+ * Semantic Analyzer
+ * 언어별 추출기를 실행해 파일 단위 결과를 심볼 그래프로 병합
+ */
+
+import { promises as fs, watch as watchFiles } from "node:fs";
+import os from "node:os";
+import path from "node:path";
+import type Parser from "tree-sitter";
+import type { SupportedLanguage } from "../core/types";
+import {
+	type BaseParser,
+	type ParseResult,
+	ParseTimeoutError,
+} from "../parsers/base";
+import { globalParserFactory } from "../parsers/ParserFactory";
+import {
+	type AnnotationParser,
+	DEFAULT_ANNOTATION_PARSERS,
+	mergeAnnotationParsers,
+} from "./annotations";
+import {
+	type CacheBackend,
+	EXTRACTION_CACHE_VERSION,
+	type ExtractionCache,
+	extractionCacheKey,
+	hashContent,
+} from "./extraction-cache";
+import {
+	createExternalResolver,
+	type ExternalInfo,
+	type ExternalResolver,
+	resolveExternals,
+} from "./external-resolution";
+import { ExtractionPool, MIN_FILES_PER_WORKER } from "./extraction-pool";
+import { GoExtractor } from "./extractors/GoExtractor";
+import { GoImportExtractor } from "./extractors/GoImportExtractor";
+import { JavaExtractor } from "./extractors/JavaExtractor";
+import type {
+	FileExtraction,
+	FileSkip,
+	LanguageExtractor,
+	ParseError,
+} from "./extractors/LanguageExtractor";
+import { ProtoExtractor } from "./extractors/ProtoExtractor";
+import { PythonExtractor } from "./extractors/PythonExtractor";
+import { TypeScriptExtractor } from "./extractors/TypeScriptExtractor";
+import {
+	applyFileDirectives,
+	parseFileDirectives,
+} from "./file-directives";
+import {
+	filterGitTree,
+	type GitTreeEntry,
+	isBinaryContent,
+	listGitTree,
+	readGitBlobs,
+	readGitIgnoreFiles,
+} from "./git-source";
+import { type IgnoreRule, isIgnored, loadIgnoreFile } from "./ignore";
+import { isDependencyEdge } from "./impact";
+import { loadModulePaths, resolveImports } from "./import-resolution";
+import {
+	type AnalysisRoot,
+	mergeRootGraphs,
+	normalizeRoots,
+	scopeRootGraph,
+} from "./multi-root";
+import { collectParseErrors } from "./parse-errors";
+import { publicSurface } from "./public-surface";
+import { SemanticGraph } from "./SemanticGraph";
+import { isTestFile, TEST_TAG } from "./test-files";
+import type {
+	AmbiguousReference,
+	SemanticEdge,
+	SemanticNode,
+} from "./types";
+
+/**
+ * 같은 ID의 노드가 여러 파일에서 선언되었을 때의 처리 정책
+ *
+ * - file-scoped: 모든 노드의 ID를 "ID@파일경로"로 바꿔 보존하고, 다른 파일에서
+ *   원래 ID를 가리키는 참조는 연결하지 않고 모호한 참조로 기록 (기본값)
+ * - overwrite: 나중에 추가된 노드로 교체
+ * - error: 예외 발생
+ * - suffix: 나중 노드의 ID/FQN에 "#2", "#3" ... 구분자를 붙여 모두 보존
+ * - keep-first: 먼저 추가된 노드를 유지하고 나중 노드는 버림
+ */
+export type FqnCollisionPolicy =
+	| "file-scoped"
+	| "overwrite"
+	| "error"
+	| "suffix"
+	| "keep-first";
+
+/**
+ * 분석기 옵션
+ */
+export interface SemanticAnalyzerOptions {
+	/** 프로젝트 루트 (지정 시 노드 파일 경로를 루트 기준 상대 경로로 기록) */
+	projectRoot?: string;
+	/** 기본 추출기 대신 사용할 추출기 목록 */
+	extractors?: LanguageExtractor[];
+	/** 노드 ID 충돌 처리 정책 (기본: "file-scoped") */
+	collisionPolicy?: FqnCollisionPolicy;
+	/** 파일 내용 해시 기반 추출 결과 캐시 (지정 시 바뀐 파일만 다시 파싱) */
+	cache?: ExtractionCache;
+	/**
+	 * 키 기반 공유 캐시 저장소 (Redis, S3 등, CacheBackend 참고)
+	 *
+	 * cache가 있으면 cache를 먼저 조회하고, 둘 다 미스일 때만 파싱한다.
+	 */
+	cacheBackend?: CacheBackend;
+	/** 동시에 읽고 분석할 최대 파일 수 (기본: CPU 코어 수) */
+	concurrency?: number;
+	/**
+	 * 파싱/추출에 쓸 최대 worker thread 수 (기본: CPU 코어 수)
+	 *
+	 * 1이면 메인 스레드에서 파싱한다. 사용자 추출기나 어노테이션 파서를
+	 * 등록한 분석기는 worker로 옮길 수 없으므로 항상 메인 스레드에서
+	 * 파싱한다. 실제 worker 수는 concurrency와 파일 수에 따라 줄어든다
+	 * (MIN_FILES_PER_WORKER 참고).
+	 */
+	workers?: number;
+	/** 기본 파서(@semantic-tags, @description)에 더해 쓸 어노테이션 파서 */
+	annotationParsers?: AnnotationParser[];
+	/** 테스트 파일(_test.go, *.test.ts 등)도 분석 (기본: false) */
+	includeTests?: boolean;
+	/** 공개 심볼만 그래프에 남김 (기본: false, isPublicSymbol 참고) */
+	publicOnly?: boolean;
+	/**
+	 * 외부 의존성 보강 콜백 (external-resolution 참고)
+	 *
+	 * 디렉토리/파일/git ref 분석이 끝난 그래프의 외부 노드마다 호출하며,
+	 * 같은 import 경로는 이 분석기에서 한 번만 조회한다.
+	 */
+	externalResolver?: ExternalResolver;
+	/** 외부 의존성 콜백의 최대 동시 호출 수 (기본: 4) */
+	externalConcurrency?: number;
+	/** 이보다 큰 파일은 파싱하지 않고 건너뜀 (바이트, 기본: 10MiB, 0은 제한 없음) */
+	maxFileBytes?: number;
+	/** 파일 하나의 파싱 시간 제한 (ms, 기본: 30초, 0은 제한 없음) */
+	parseTimeoutMs?: number;
+}
+
+/**
+ * 분석 실행 옵션
+ */
+export interface AnalyzeOptions {
+	/** 중단 신호 (중단되면 다음 파일을 분석하기 전에 종료) */
+	signal?: AbortSignal;
+}
+
+/**
+ * 여러 루트 분석 옵션
+ */
+export interface AnalyzeRootsOptions extends AnalyzeOptions {
+	/**
+	 * 각 루트 go.mod 외에 추가할 모듈 경로 매핑 (접두사 -> "<이름표>/<디렉토리>")
+	 */
+	modulePaths?: Record<string, string>;
+}
+
+/**
+ * 소스 코드 분석 옵션
+ */
+export interface AnalyzeSourceOptions {
+	/** 추출기를 고를 언어 (생략 시 파일 확장자로 추론, 예: "go", "python") */
+	language?: string;
+}
+
+/**
+ * 분석이 중단 신호로 멈췄을 때의 예외
+ *
+ * partial에는 중단 전까지 분석한 파일만으로 만든 그래프가 들어 있다.
+ */
+export class AnalysisAbortedError extends Error {
+	constructor(
+		public partial: SemanticGraph,
+		public reason?: unknown,
+	) {
+		super("Analysis aborted");
+		this.name = "AnalysisAbortedError";
+	}
+}
+
+/**
+ * 여러 파일이 함께 가리키는 자리표시 노드 종류
+ *
+ * 같은 ID가 여러 파일에서 나와도 충돌로 보지 않고 먼저 추가된 노드를 유지한다.
+ */
+const PLACEHOLDER_KINDS = new Set(["external", "table"]);
+
+/** 기본 파일 크기 제한 (생성된 거대 파일이 분석 전체를 막지 않도록) */
+export const DEFAULT_MAX_FILE_BYTES = 10 * 1024 * 1024;
+
+/** 기본 파일당 파싱 시간 제한 (ms) */
+export const DEFAULT_PARSE_TIMEOUT_MS = 30_000;
+
+/** analyzeDirectory가 들어가지 않는 디렉토리 */
+const IGNORED_DIRECTORIES = new Set(["node_modules", "vendor"]);
+
+/**
+ * 샤드 단위 부분 그래프
+ */
+export interface PartialGraph {
+	/** 샤드 안에서 해석된 노드와 엣지 */
+	graph: SemanticGraph;
+	/** 샤드 밖의 심볼을 가리켜 아직 연결하지 못한 엣지 */
+	unresolved: SemanticEdge[];
+}
+
+/**
+ * JSON Lines 스트림의 파일 분석 레코드
+ */
+export interface FileAnalysisRecord {
+	type: "file";
+	filePath: string;
+	language: string;
+	symbols: Array<{
+		id: string;
+		name: string;
+		kind: string;
+		line?: number;
+		semanticTags: string[];
+	}>;
+	/** 파일에서 나가는 의존 엣지 (contains/declares 제외) */
+	dependencies: Array<{ from: string; to: string; type: string }>;
+}
+
+/**
+ * JSON Lines 스트림의 파일 오류 레코드
+ */
+export interface FileErrorRecord {
+	type: "error";
+	filePath: string;
+	error: string;
+}
+
+export type AnalysisStreamRecord = FileAnalysisRecord | FileErrorRecord;
+
+/** 감시 모드의 기본 디바운스 간격 (ms) */
+export const DEFAULT_WATCH_DEBOUNCE_MS = 100;
+
+/**
+ * 감시 모드 옵션
+ */
+export interface WatchOptions {
+	/** 마지막 변경 이벤트 후 다시 분석하기까지 기다리는 시간 (ms) */
+	debounceMs?: number;
+	/** 중단 신호 (중단되면 감시를 멈추고 watch가 반환됨) */
+	signal?: AbortSignal;
+}
+
+/**
+ * 감시 모드 이벤트 (filePath는 노드의 filePath와 같은 형식)
+ */
+export type WatchEvent =
+	| { type: "analyzed"; filePath: string; extraction: FileExtraction }
+	| { type: "removed"; filePath: string }
+	| { type: "error"; filePath: string; error: string }
+	| { type: "ready"; files: number };
+
+/**
+ * 스트림 출력 대상 (write가 false를 반환하면 drain 이벤트까지 기다림)
+ */
+export interface AnalysisStreamWriter {
+	write(chunk: string): boolean | undefined;
+	once?(event: "drain", listener: () => void): unknown;
+}
+
+/**
+ * 스트리밍 분석 요약
+ */
+export interface AnalysisStreamSummary {
+	files: number;
+	errors: number;
+}
+
+/**
+ * 심볼 그래프 분석기
+ */
+export class SemanticAnalyzer {
+	private options: SemanticAnalyzerOptions;
+	private extractors: LanguageExtractor[] = [];
+	private annotationParsers: AnnotationParser[];
+	private parsers = new Map<string, BaseParser>();
+	private resolveExternal?: (importPath: string) => Promise<ExternalInfo>;
+	/** 기본 추출기/어노테이션 파서만 쓰는지 (worker에서 같은 분석기를 만들 수 있는지) */
+	private transferable: boolean;
+
+	constructor(options: SemanticAnalyzerOptions = {}) {
+		for (const [name, count] of [
+			["concurrency", options.concurrency],
+			["workers", options.workers],
+		] as const) {
+			if (count !== undefined && (!Number.isInteger(count) || count < 1)) {
+				throw new Error(`Invalid ${name}: ${count}`);
+			}
+		}
+		for (const [name, limit] of [
+			["maxFileBytes", options.maxFileBytes],
+			["parseTimeoutMs", options.parseTimeoutMs],
+		] as const) {
+			if (limit !== undefined && !(limit >= 0)) {
+				throw new Error(`Invalid ${name}: ${limit}`);
+			}
+		}
+
+		this.options = options;
+		if (options.externalResolver) {
+			this.resolveExternal = createExternalResolver(
+				options.externalResolver,
+				options.externalConcurrency,
+			);
+		}
+		this.annotationParsers = mergeAnnotationParsers(
+			DEFAULT_ANNOTATION_PARSERS,
+			options.annotationParsers ?? [],
+		);
+		const defaults = [
+			new GoExtractor(),
+			new GoImportExtractor(),
+			new JavaExtractor(),
+			new ProtoExtractor(),
+			new PythonExtractor(),
+			new TypeScriptExtractor(),
+		];
+		for (const extractor of options.extractors ?? defaults) {
+			this.registerExtractor(extractor);
+		}
+		this.transferable = !options.extractors && !options.annotationParsers;
+	}
+
+	/**
+	 * 추출기 등록 (같은 확장자에 여러 추출기를 등록할 수 있음)
+	 */
+	registerExtractor(extractor: LanguageExtractor): void {
+		this.extractors.push(extractor);
+		this.transferable = false;
+	}
+
+	/**
+	 * 어노테이션 파서 등록 (같은 이름의 파서는 대체, 이 분석기에만 적용)
+	 */
+	registerAnnotationParser(parser: AnnotationParser): void {
+		this.annotationParsers = mergeAnnotationParsers(this.annotationParsers, [
+			parser,
+		]);
+		this.transferable = false;
+	}
+
+	/**
+	 * 파일을 처리할 추출기 목록
+	 */
+	getExtractorsForFile(filePath: string): LanguageExtractor[] {
+		const extension = path.extname(filePath).slice(1).toLowerCase();
+		return this.extractors.filter((extractor) =>
+			extractor.extensions.includes(extension),
+		);
+	}
+
+	/**
+	 * 분석 가능한 파일인지 확인 (includeTests가 아니면 테스트 파일 제외)
+	 */
+	supportsFile(filePath: string): boolean {
+		if (!this.options.includeTests && isTestFile(filePath)) {
+			return false;
+		}
+		return this.getExtractorsForFile(filePath).length > 0;
+	}
+
+	/**
+	 * 소스 코드 분석
+	 *
+	 * options.language가 있으면 확장자 대신 그 언어의 추출기를 사용한다.
+	 * 파일 머리의 @file-tags/@file-description을 심볼에 적용하고
+	 * (file-directives 참고), 테스트 파일에서 추출한 심볼에는 TEST_TAG 태그를
+	 * 붙인다 (패키지와 자리표시 노드는 운영 코드와 공유하므로 제외).
+	 * 구문 트리는 이 호출 안에서만 쓰고 결과에 남기지 않으므로, 파일 수가
+	 * 늘어도 메모리에는 가벼운 노드/엣지만 쌓인다 (withSyntaxTree 참고).
+	 * 구문 오류가 있어도 파일을 버리지 않고 추출 가능한 심볼을 반환하며,
+	 * 오류는 errors에 담고 partial을 true로 표시한다. maxFileBytes를 넘거나
+	 * 파싱이 parseTimeoutMs 안에 끝나지 않은 파일은 예외 대신 skipped를
+	 * 기록한 파일 노드 하나만 반환한다 (checkSkippedFiles 참고).
+	 */
+	async analyzeSource(
+		sourceCode: string,
+		filePath: string,
+		options: AnalyzeSourceOptions = {},
+	): Promise<FileExtraction> {
+		const { language } = options;
+		const extractors = language
+			? this.extractors.filter((extractor) => extractor.language === language)
+			: this.getExtractorsForFile(filePath);
+		if (extractors.length === 0) {
+			throw new Error(
+				language
+					? `No extractor registered for language: ${language}`
+					: `No extractor registered for file: ${filePath}`,
+			);
+		}
+
+		const maxFileBytes = this.options.maxFileBytes ?? DEFAULT_MAX_FILE_BYTES;
+		const size = Buffer.byteLength(sourceCode, "utf-8");
+		if (maxFileBytes > 0 && size > maxFileBytes) {
+			return createSkippedExtraction(
+				filePath,
+				extractors[0].language,
+				fileSizeSkip(size, maxFileBytes),
+			);
+		}
+
+		const result: FileExtraction = {
+			filePath,
+			language: extractors[0].language,
+			nodes: [],
+			edges: [],
+		};
+		const errors: ParseError[] = [];
+		const timeoutMs = this.options.parseTimeoutMs ?? DEFAULT_PARSE_TIMEOUT_MS;
+
+		// 같은 언어의 추출기들은 한 번 파싱한 트리를 공유
+		const parsed = new Map<string, ParseResult>();
+		for (const extractor of extractors) {
+			let tree: Parser.Tree | undefined;
+			if (extractor.requiresTree) {
+				let parseResult = parsed.get(extractor.language);
+				if (!parseResult) {
+					try {
+						parseResult = await this.getParser(extractor.language).parse(
+							sourceCode,
+							{ filePath, timeoutMs },
+						);
+					} catch (error) {
+						if (!(error instanceof ParseTimeoutError)) throw error;
+						return createSkippedExtraction(filePath, result.language, {
+							reason: "parse-timeout",
+							message: `Parsing did not finish within ${timeoutMs}ms`,
+						});
+					}
+					parsed.set(extractor.language, parseResult);
+					errors.push(...collectParseErrors(parseResult.tree));
+				}
+				tree = parseResult.tree;
+			}
+
+			const extraction = extractor.extract({
+				sourceCode,
+				filePath,
+				tree,
+				annotationParsers: this.annotationParsers,
+			});
+			result.nodes.push(...extraction.nodes);
+			result.edges.push(...extraction.edges);
+		}
+
+		result.partial = errors.length > 0;
+		result.errors = errors;
+		result.nodes = applyFileDirectives(
+			result.nodes,
+			parseFileDirectives(sourceCode),
+		);
+		if (isTestFile(filePath)) {
+			result.nodes = result.nodes.map((node) =>
+				node.kind === "package" || PLACEHOLDER_KINDS.has(node.kind)
+					? node
+					: { ...node, semanticTags: [...node.semanticTags, TEST_TAG] },
+			);
+		}
+		return result;
+	}
+
+	/**
+	 * 스트림(저장하지 않은 편집기 버퍼 등)에서 읽은 소스 분석
+	 *
+	 * 파일 시스템에 접근하지 않으며 analyzeFile과 같은 형태의 결과를 반환한다.
+	 * filePath는 노드 식별과 (language가 없을 때) 언어 추론에만 쓰이고,
+	 * 디스크 내용과 다를 수 있으므로 캐시는 사용하지 않는다.
+	 */
+	async analyzeReader(
+		reader: AsyncIterable<string | Uint8Array>,
+		filePath: string,
+		options: AnalyzeSourceOptions = {},
+	): Promise<FileExtraction> {
+		const chunks: Buffer[] = [];
+		for await (const chunk of reader) {
+			chunks.push(
+				typeof chunk === "string"
+					? Buffer.from(chunk, "utf-8")
+					: Buffer.from(chunk),
+			);
+		}
+		const sourceCode = Buffer.concat(chunks).toString("utf-8");
+		return this.analyzeSource(sourceCode, this.toNodePath(filePath), options);
+	}
+
+	/**
+	 * 파일을 다시 파싱해 구문 트리가 필요한 작업 실행
+	 *
+	 * 분석 결과에는 구문 트리를 보관하지 않으므로, 전체 AST가 필요한 드문
+	 * 작업은 보관 대신 이 메서드로 필요할 때만 다시 파싱한다. callback이
+	 * 끝난 뒤에는 트리를 참조하지 않아야 한다. filePath는 노드의 filePath
+	 * (projectRoot 기준 상대 경로)와 절대 경로를 모두 받는다.
+	 */
+	async withSyntaxTree<T>(
+		filePath: string,
+		callback: (tree: Parser.Tree, sourceCode: string) => T | Promise<T>,
+		options: AnalyzeSourceOptions = {},
+	): Promise<T> {
+		const { projectRoot } = this.options;
+		const absolutePath = projectRoot
+			? path.resolve(projectRoot, filePath)
+			: filePath;
+		const nodePath = this.toNodePath(absolutePath);
+		const candidates = options.language
+			? this.extractors.filter(
+					(extractor) => extractor.language === options.language,
+				)
+			: this.getExtractorsForFile(nodePath);
+		const extractor = candidates.find((candidate) => candidate.requiresTree);
+		if (!extractor) {
+			throw new Error(`No syntax tree parser for file: ${filePath}`);
+		}
+
+		const sourceCode = await fs.readFile(absolutePath, "utf-8");
+		const { tree } = await this.getParser(extractor.language).parse(
+			sourceCode,
+			{ filePath: nodePath },
+		);
+		return callback(tree, sourceCode);
+	}
+
+	/**
+	 * 파일 분석
+	 *
+	 * 캐시가 있으면 내용 해시와 분석기 버전이 같은 이전 결과를 재사용한다.
+	 * 파일을 읽은 뒤 파싱 전에 중단되면 AnalysisAbortedError를 던진다.
+	 */
+	async analyzeFile(
+		filePath: string,
+		options: AnalyzeOptions = {},
+	): Promise<FileExtraction> {
+		return this.readAndAnalyze(filePath, options.signal);
+	}
+
+	/**
+	 * 디렉토리를 감시하며 바뀐 파일의 분석 결과를 전달
+	 *
+	 * 먼저 analyzeDirectory와 같은 규칙으로 모은 파일을 모두 분석해
+	 * "analyzed" 이벤트로 보내고 "ready"를 보낸다. 이후 파일 변경 이벤트는
+	 * debounceMs 동안 모아 한 번에 처리하며, 내용 해시가 마지막으로 보낸
+	 * 것과 같은 파일은 다시 파싱하지 않는다 (저장만 반복되는 경우). 사라진
+	 * 파일은 "removed"로 알리고 캐시 항목도 지운다. 분석에 실패한 파일은
+	 * "error"를 보내고 감시를 계속한다. listener는 순서대로 하나씩 호출되고,
+	 * listener가 던진 예외는 감시를 멈추고 watch의 예외가 된다.
+	 * signal이 없으면 반환하지 않는다.
+	 */
+	async watch(
+		root: string,
+		listener: (event: WatchEvent) => void | Promise<void>,
+		options: WatchOptions = {},
+	): Promise<void> {
+		const debounceMs = options.debounceMs ?? DEFAULT_WATCH_DEBOUNCE_MS;
+		if (!Number.isFinite(debounceMs) || debounceMs < 0) {
+			throw new Error(`Invalid debounce interval: ${debounceMs}`);
+		}
+		const { signal } = options;
+		if (signal?.aborted) return;
+
+		const directory = path.resolve(root);
+		// 파일 경로 -> 마지막으로 보낸 내용 해시
+		const hashes = new Map<string, string>();
+
+		// paths가 없으면 모든 파일, 있으면 그 경로(디렉토리면 하위 포함)만 확인
+		const refresh = async (paths?: string[]) => {
+			const files = await this.listFiles(directory);
+			const current = new Set(files);
+			for (const file of Array.from(hashes.keys())) {
+				if (current.has(file)) continue;
+				hashes.delete(file);
+				const filePath = this.toNodePath(file);
+				this.options.cache?.delete(filePath);
+				await listener({ type: "removed", filePath });
+			}
+
+			for (const file of files) {
+				if (
+					paths &&
+					!paths.some(
+						(changed) =>
+							file === changed || file.startsWith(`${changed}${path.sep}`),
+					)
+				) {
+					continue;
+				}
+
+				const filePath = this.toNodePath(file);
+				let extraction: FileExtraction;
+				try {
+					const skipped = await this.skipOversizedFile(file);
+					if (skipped) {
+						// 내용을 읽지 않으므로 크기가 그대로면 다시 알리지 않는다
+						const marker = `skipped:${skipped.skipped?.message}`;
+						if (hashes.get(file) === marker) continue;
+						extraction = skipped;
+						hashes.set(file, marker);
+					} else {
+						const sourceCode = await fs.readFile(file, "utf-8");
+						const hash = hashContent(sourceCode);
+						if (hashes.get(file) === hash) continue;
+						extraction = await this.analyzeContent(sourceCode, filePath, hash);
+						hashes.set(file, hash);
+					}
+				} catch (error) {
+					await listener({
+						type: "error",
+						filePath,
+						error: (error as Error).message,
+					});
+					continue;
+				}
+				await listener({ type: "analyzed", filePath, extraction });
+			}
+		};
+
+		await new Promise<void>((resolve, reject) => {
+			const pending = new Set<string>();
+			let everything = false;
+			let timer: NodeJS.Timeout | undefined;
+			// 처리는 한 번에 하나씩 (이벤트 순서 보장)
+			let running = Promise.resolve();
+
+			const stop = (error?: unknown) => {
+				clearTimeout(timer);
+				watcher.close();
+				signal?.removeEventListener("abort", onAbort);
+				running.then(
+					() => (error === undefined ? resolve() : reject(error)),
+					reject,
+				);
+			};
+			const onAbort = () => stop();
+			const schedule = (task: () => Promise<void>) => {
+				running = running.then(task).catch((error) => {
+					stop(error);
+					throw error;
+				});
+			};
+
+			const watcher = watchFiles(
+				directory,
+				{ recursive: true },
+				(_event, filename) => {
+					if (filename) {
+						pending.add(path.join(directory, filename.toString()));
+					} else {
+						everything = true;
+					}
+					clearTimeout(timer);
+					timer = setTimeout(() => {
+						const paths = everything ? undefined : Array.from(pending);
+						pending.clear();
+						everything = false;
+						schedule(() => refresh(paths));
+					}, debounceMs);
+				},
+			);
+			watcher.on("error", (error) => stop(error));
+			signal?.addEventListener("abort", onAbort, { once: true });
+
+			schedule(async () => {
+				await refresh();
+				await listener({ type: "ready", files: hashes.size });
+			});
+		});
+	}
+
+	/**
+	 * 파일을 하나씩 분석해 결과를 JSON Lines로 출력
+	 *
+	 * 그래프를 메모리에 모으지 않고 파일마다 레코드 한 줄을 바로 쓴다.
+	 * 파일 분석이 실패하면 전체를 중단하지 않고 오류 레코드를 쓰며,
+	 * 출력 버퍼가 차면 다음 파일로 넘어가기 전에 비워질 때까지 기다린다.
+	 */
+	async analyzeStream(
+		filePaths: string[],
+		writer: AnalysisStreamWriter,
+	): Promise<AnalysisStreamSummary> {
+		const summary: AnalysisStreamSummary = { files: 0, errors: 0 };
+
+		for (const filePath of filePaths) {
+			let record: AnalysisStreamRecord;
+			try {
+				const extraction = await this.analyzeFile(filePath);
+				record = {
+					type: "file",
+					filePath: extraction.filePath,
+					language: extraction.language,
+					symbols: extraction.nodes
+						.filter((node) => node.filePath === extraction.filePath)
+						.map((node) => ({
+							id: node.id,
+							name: node.name,
+							kind: node.kind,
+							line: node.line,
+							semanticTags: node.semanticTags,
+						})),
+					dependencies: extraction.edges
+						.filter((edge) => isDependencyEdge(edge.type))
+						.map(({ from, to, type }) => ({ from, to, type })),
+				};
+				summary.files++;
+			} catch (error) {
+				record = {
+					type: "error",
+					filePath: this.toNodePath(filePath),
+					error: (error as Error).message,
+				};
+				summary.errors++;
+			}
+
+			const line = `${JSON.stringify(record)}\n`;
+			if (writer.write(line) === false && writer.once) {
+				await new Promise<void>((resolve) => writer.once?.("drain", resolve));
+			}
+		}
+
+		return summary;
+	}
+
+	/**
+	 * 디렉토리 아래의 지원 파일을 모두 분석해 하나의 그래프로 병합
+	 *
+	 * 숨김 디렉토리와 node_modules, vendor, 그리고 각 디렉토리의
+	 * .linkerignore 규칙에 걸린 경로는 파싱 전에 건너뛴다. 파일 경로를
+	 * 직접 넘기면 ignore 규칙과 관계없이 그 파일을 분석한다.
+	 * 캐시가 있으면 더 이상 존재하지 않는 파일의 항목을 제거한다.
+	 * signal이 중단되면 AnalysisAbortedError로 부분 그래프를 돌려준다.
+	 */
+	async analyzeDirectory(
+		directory: string,
+		options: AnalyzeOptions = {},
+	): Promise<SemanticGraph> {
+		if ((await fs.stat(directory)).isFile()) {
+			return this.analyzeFiles([directory], options);
+		}
+
+		const supported = await this.listFiles(directory);
+		if (!options.signal?.aborted) {
+			this.options.cache?.prune(
+				supported.map((file) => this.toNodePath(file)),
+			);
+		}
+
+		const cacheKeys = new Set<string>();
+		const extractions = await this.extractFiles(
+			supported,
+			options.signal,
+			cacheKeys,
+		);
+		await this.options.cacheBackend?.prune(cacheKeys);
+		return this.completeGraph(extractions);
+	}
+
+	/**
+	 * analyzeDirectory가 분석할 파일 목록 (경로 순)
+	 *
+	 * 숨김 디렉토리와 node_modules, vendor, .linkerignore 규칙에 걸린 경로,
+	 * 등록된 추출기가 지원하지 않는 파일은 뺀다.
+	 */
+	async listFiles(directory: string): Promise<string[]> {
+		const files = await collectFiles(directory, directory, []);
+		return files.filter((file) => this.supportsFile(file));
+	}
+
+	/**
+	 * git ref 시점의 저장소 분석 (작업 트리 체크아웃 없이 객체 저장소에서 읽음)
+	 *
+	 * 그 ref에 커밋된 .linkerignore를 적용하고, 이진 파일과 추출기가 없는
+	 * 파일은 건너뛴다. 서브모듈은 따라가지 않는다. 노드 파일 경로는
+	 * analyzeDirectory(repoPath)와 같게 기록하므로 두 그래프를 비교할 수 있다.
+	 * 캐시가 있으면 내용 해시로 재사용하지만 prune하지 않는다.
+	 * maxFileBytes를 넘는 blob은 ls-tree가 알려 준 크기로 걸러 읽지 않는다.
+	 */
+	async analyzeGitRef(
+		repoPath: string,
+		ref: string,
+		options: AnalyzeOptions = {},
+	): Promise<SemanticGraph> {
+		const entries = await listGitTree(repoPath, ref);
+		const ignoreContents = await readGitIgnoreFiles(repoPath, entries);
+		const files = filterGitTree(
+			entries,
+			ignoreContents,
+			IGNORED_DIRECTORIES,
+		).filter((entry) => this.supportsFile(entry.path));
+		// 큰 blob은 ls-tree 크기만 보고 건너뛰어 내용을 읽지 않는다
+		const maxFileBytes = this.options.maxFileBytes ?? DEFAULT_MAX_FILE_BYTES;
+		const oversized = (entry: GitTreeEntry) =>
+			maxFileBytes > 0 && entry.size > maxFileBytes;
+		const blobs = await readGitBlobs(
+			repoPath,
+			files.filter((entry) => !oversized(entry)).map((entry) => entry.oid),
+		);
+
+		const extractions: FileExtraction[] = [];
+		for (const entry of files) {
+			if (options.signal?.aborted) {
+				throw new AnalysisAbortedError(
+					this.buildGraph(extractions),
+					options.signal.reason,
+				);
+			}
+			const nodePath = this.toNodePath(path.join(repoPath, entry.path));
+			if (oversized(entry)) {
+				extractions.push(
+					createSkippedExtraction(
+						nodePath,
+						this.getExtractorsForFile(entry.path)[0].language,
+						fileSizeSkip(entry.size, maxFileBytes),
+					),
+				);
+				continue;
+			}
+			const content = blobs.get(entry.oid);
+			if (!content || isBinaryContent(content)) continue;
+			extractions.push(
+				await this.analyzeContent(content.toString("utf-8"), nodePath),
+			);
+		}
+		return this.completeGraph(extractions);
+	}
+
+	/**
+	 * 나란히 있는 여러 루트(저장소)를 분석해 하나의 그래프로 병합
+	 *
+	 * 루트마다 projectRoot를 그 루트로 둔 analyzeDirectory 결과를
+	 * scopeRootGraph로 구분해 합친다 (노드 ID와 파일 경로에 "<이름표>/" 접두사,
+	 * metadata.root에 이름표). 각 루트 go.mod의 모듈 경로로 import를 해석해
+	 * 다른 루트의 패키지를 가리키는 엣지를 연결하고, 해석되지 않은 같은 외부
+	 * 의존성은 하나의 노드로 남는다. 루트마다 파일 경로가 겹칠 수 있으므로
+	 * 추출 캐시는 쓰지 않는다.
+	 */
+	async analyzeRoots(
+		roots: Array<string | AnalysisRoot>,
+		options: AnalyzeRootsOptions = {},
+	): Promise<SemanticGraph> {
+		const graphs: SemanticGraph[] = [];
+		const modulePaths: Record<string, string> = {};
+
+		for (const root of normalizeRoots(roots)) {
+			const analyzer = new SemanticAnalyzer({
+				...this.options,
+				projectRoot: root.path,
+				cache: undefined,
+				externalResolver: this.resolveExternal,
+			});
+			const graph = await analyzer.analyzeDirectory(root.path, options);
+			graphs.push(scopeRootGraph(graph, root.label));
+
+			const rootModules = await loadModulePaths(root.path);
+			for (const [prefix, directory] of Object.entries(rootModules)) {
+				modulePaths[prefix] = path.posix.join(root.label, directory);
+			}
+		}
+
+		return resolveImports(mergeRootGraphs(graphs), {
+			modulePaths: { ...modulePaths, ...options.modulePaths },
+		});
+	}
+
+	/**
+	 * 여러 파일을 분석해 하나의 그래프로 병합
+	 */
+	async analyzeFiles(
+		filePaths: string[],
+		options: AnalyzeOptions = {},
+	): Promise<SemanticGraph> {
+		return this.completeGraph(
+			await this.extractFiles(filePaths, options.signal),
+		);
+	}
+
+	/**
+	 * 파일 추출 결과를 그래프로 병합
+	 *
+	 * 모든 노드를 먼저 추가한 뒤, 양 끝 노드가 모두 존재하는 엣지만 연결한다.
+	 * 같은 디렉토리의 여러 파일에 나뉜 같은 패키지 노드는 하나로 합친다.
+	 * "external", "table" 자리표시 노드는 같은 ID의 실제 노드를 덮어쓰지 않으며,
+	 * 자리표시 노드를 실제 노드로 교체하는 것은 충돌로 보지 않는다.
+	 * 실제 노드끼리의 충돌은 collisionPolicy에 따라 처리하고, file-scoped/suffix
+	 * 정책으로 이름이 바뀐 노드를 가리키는 같은 파일의 엣지는 새 ID로 다시
+	 * 연결한다. file-scoped 정책에서 다른 파일의 참조는 임의로 고르지 않고
+	 * 참조하는 노드의 metadata.ambiguousReferences에 기록한다.
+	 * 마지막으로 각 추출기의 link 단계를 실행하고, publicOnly면 공개
+	 * 심볼만 남긴다 (link가 비공개 심볼까지 보고 관계를 만든 뒤 제거).
+	 */
+	buildGraph(extractions: FileExtraction[]): SemanticGraph {
+		const { graph } = this.mergeExtractions(extractions);
+		for (const extractor of this.extractors) {
+			extractor.link?.(graph);
+		}
+		return this.options.publicOnly ? publicSurface(graph) : graph;
+	}
+
+	/**
+	 * buildGraph 후 외부 의존성 보강 (externalResolver가 있을 때)
+	 *
+	 * 직접 모은 추출 결과(감시 등)로 그래프를 만들 때도 analyzeDirectory와
+	 * 같은 결과를 얻으려면 buildGraph 대신 이 메서드를 쓴다.
+	 */
+	async completeGraph(
+		extractions: FileExtraction[],
+	): Promise<SemanticGraph> {
+		const graph = this.buildGraph(extractions);
+		return this.resolveExternal
+			? resolveExternals(graph, this.resolveExternal)
+			: graph;
+	}
+
+	/**
+	 * 파일 일부(샤드)만 분석해 부분 그래프 생성
+	 *
+	 * 샤드 밖의 심볼을 가리키는 엣지는 버리지 않고 unresolved에 보관하며,
+	 * 파일 간 link 단계는 mergeShards에서 한 번만 실행한다.
+	 */
+	async analyzePartial(filePaths: string[]): Promise<PartialGraph> {
+		return this.mergeExtractions(await this.extractFiles(filePaths));
+	}
+
+	/**
+	 * 부분 그래프들을 병합하고 샤드 간 엣지를 해석
+	 *
+	 * 각 부분 그래프를 하나의 추출 결과로 보고 buildGraph와 같은 규칙으로
+	 * 병합하므로, 결과는 전체 파일을 한 번에 분석한 그래프와 같다.
+	 */
+	mergeShards(partials: PartialGraph[]): SemanticGraph {
+		return this.buildGraph(
+			partials.map((partial, index) => ({
+				filePath: `shard-${index}`,
+				language: "mixed",
+				nodes: Array.from(partial.graph.nodes.values()),
+				edges: [...partial.graph.edges, ...partial.unresolved],
+			})),
+		);
+	}
+
+	/**
+	 * 지원하는 파일만 추출해 경로 순으로 반환
+	 *
+	 * concurrency개의 작업자가 경로 순으로 다음 파일을 가져가 읽고 캐시를
+	 * 조회하며, 캐시에 없는 파일의 파싱/추출은 worker thread 풀에 맡겨
+	 * 여러 코어에서 동시에 수행한다. 결과는 완료 순서와 관계없이 경로 순
+	 * 슬롯에 기록하고, 병합은 모든 작업자가 끝난 뒤 메인 스레드에서 한 번에
+	 * 수행하므로 그래프는 동시에 수정되지 않는다.
+	 * 중단되면 그때까지 완료된 파일로 만든 부분 그래프를 담아
+	 * AnalysisAbortedError를 던진다.
+	 */
+	private async extractFiles(
+		filePaths: string[],
+		signal?: AbortSignal,
+		cacheKeys?: Set<string>,
+	): Promise<FileExtraction[]> {
+		const files = filePaths.filter((file) => this.supportsFile(file)).sort();
+		const results: Array<FileExtraction | undefined> = new Array(files.length);
+		const failures: unknown[] = [];
+		const concurrency = Math.min(
+			this.options.concurrency ?? Math.max(1, os.cpus().length),
+			files.length,
+		);
+		const pool = this.createPool(files.length, concurrency);
+		let next = 0;
+
+		const work = async () => {
+			while (next < files.length && failures.length === 0) {
+				if (signal?.aborted) return;
+				const index = next++;
+				try {
+					results[index] = await this.readAndAnalyze(
+						files[index],
+						signal,
+						cacheKeys,
+						pool,
+					);
+				} catch (error) {
+					if (!(error instanceof AnalysisAbortedError)) {
+						failures.push(error);
+					}
+				}
+			}
+		};
+
+		try {
+			await Promise.all(Array.from({ length: concurrency }, work));
+		} finally {
+			await pool?.close();
+		}
+		if (failures.length > 0) {
+			throw failures[0];
+		}
+
+		const extractions = results.filter(
+			(extraction): extraction is FileExtraction => extraction !== undefined,
+		);
+		if (extractions.length < files.length) {
+			throw new AnalysisAbortedError(
+				this.buildGraph(extractions),
+				signal?.reason,
+			);
+		}
+		return extractions;
+	}
+
+	/**
+	 * 파일 수에 맞는 worker 풀 생성 (worker가 2개 미만이면 undefined)
+	 */
+	private createPool(
+		files: number,
+		concurrency: number,
+	): ExtractionPool | undefined {
+		if (!this.transferable) return undefined;
+		const size = Math.min(
+			this.options.workers ?? Math.max(1, os.cpus().length),
+			concurrency,
+			Math.floor(files / MIN_FILES_PER_WORKER),
+		);
+		if (size < 2) return undefined;
+		return new ExtractionPool(size, {
+			maxFileBytes: this.options.maxFileBytes,
+			parseTimeoutMs: this.options.parseTimeoutMs,
+		});
+	}
+
+	/**
+	 * 추출 결과의 노드/엣지 병합 (link 단계 제외)
+	 */
+	private mergeExtractions(extractions: FileExtraction[]): PartialGraph {
+		const graph = new SemanticGraph();
+		const unresolved: SemanticEdge[] = [];
+		const policy = this.options.collisionPolicy ?? "file-scoped";
+		const renamed = new Map<FileExtraction, Map<string, string>>();
+		const scoped = new Map<string, SemanticNode[]>();
+
+		for (const extraction of extractions) {
+			for (const node of extraction.nodes) {
+				const existing = graph.getNode(node.id);
+				if (
+					existing?.kind === "package" &&
+					node.kind === "package" &&
+					isSamePackageDirectory(existing, node)
+				) {
+					graph.addNode(mergePackageNodes(existing, node));
+					continue;
+				}
+				if (
+					PLACEHOLDER_KINDS.has(node.kind) &&
+					(existing || scoped.has(node.id))
+				) {
+					continue;
+				}
+				if (policy === "file-scoped" && !PLACEHOLDER_KINDS.has(node.kind)) {
+					addFileScopedNode(graph, node, scoped);
+					continue;
+				}
+				if (
+					!existing ||
+					PLACEHOLDER_KINDS.has(existing.kind) ||
+					policy === "overwrite"
+				) {
+					graph.addNode(node);
+					continue;
+				}
+
+				switch (policy) {
+					case "error":
+						throw new Error(
+							`FQN collision: ${node.id} is declared in both ${existing.filePath} and ${node.filePath}`,
+						);
+					case "keep-first":
+						break;
+					case "suffix": {
+						const id = nextAvailableId(graph, node.id);
+						const disambiguator = id.slice(node.id.length);
+						graph.addNode({
+							...node,
+							id,
+							fqn: `${node.fqn}${disambiguator}`,
+							metadata: { ...node.metadata, collidesWith: node.id },
+						});
+
+						let fileRenames = renamed.get(extraction);
+						if (!fileRenames) {
+							fileRenames = new Map();
+							renamed.set(extraction, fileRenames);
+						}
+						fileRenames.set(node.id, id);
+						break;
+					}
+				}
+			}
+		}
+
+		for (const extraction of extractions) {
+			const fileRenames = renamed.get(extraction);
+			const declaredIn = new Map(
+				extraction.nodes.map((node) => [node.id, node.filePath]),
+			);
+			for (const original of extraction.edges) {
+				let edge = fileRenames
+					? {
+							...original,
+							from: fileRenames.get(original.from) ?? original.from,
+							to: fileRenames.get(original.to) ?? original.to,
+						}
+					: original;
+				if (scoped.size > 0) {
+					const file = declaredIn.get(edge.from) ?? extraction.filePath;
+					const from = resolveFileScopedId(scoped, edge.from, file);
+					const to = resolveFileScopedId(scoped, edge.to, file);
+					if (from !== undefined && to === undefined) {
+						recordAmbiguousReference(graph, from, edge, scoped);
+						continue;
+					}
+					if (from !== undefined && to !== undefined) {
+						edge = { ...edge, from, to };
+					}
+				}
+				if (graph.hasNode(edge.from) && graph.hasNode(edge.to)) {
+					graph.addEdge(edge);
+				} else {
+					unresolved.push(edge);
+				}
+			}
+		}
+
+		return { graph, unresolved };
+	}
+
+	/**
+	 * 파일을 읽어 분석 (파일을 읽은 뒤 파싱 전에 중단되면 예외)
+	 *
+	 * maxFileBytes를 넘는 파일은 읽지 않고 크기만 보고 건너뛴다.
+	 */
+	private async readAndAnalyze(
+		filePath: string,
+		signal?: AbortSignal,
+		cacheKeys?: Set<string>,
+		pool?: ExtractionPool,
+	): Promise<FileExtraction> {
+		const skipped = await this.skipOversizedFile(filePath);
+		if (skipped) {
+			return skipped;
+		}
+		const sourceCode = await fs.readFile(filePath, "utf-8");
+		if (signal?.aborted) {
+			throw new AnalysisAbortedError(new SemanticGraph(), signal.reason);
+		}
+		return this.analyzeContent(
+			sourceCode,
+			this.toNodePath(filePath),
+			undefined,
+			cacheKeys,
+			pool,
+		);
+	}
+
+	/**
+	 * maxFileBytes를 넘는 파일이면 건너뛴 결과 (파일을 읽기 전에 stat으로 확인)
+	 *
+	 * 거대한 생성 파일을 문자열로 읽고 디코딩하는 비용을 피하고, 문자열 한도를
+	 * 넘는 파일도 예외 대신 건너뛴 파일로 기록한다. 추출기가 없는 파일은
+	 * 확인하지 않는다 (analyzeSource가 오류를 낸다).
+	 */
+	private async skipOversizedFile(
+		filePath: string,
+	): Promise<FileExtraction | undefined> {
+		const maxFileBytes = this.options.maxFileBytes ?? DEFAULT_MAX_FILE_BYTES;
+		const [extractor] = this.getExtractorsForFile(filePath);
+		if (maxFileBytes === 0 || !extractor) return undefined;
+
+		const { size } = await fs.stat(filePath);
+		return size > maxFileBytes
+			? createSkippedExtraction(
+					this.toNodePath(filePath),
+					extractor.language,
+					fileSizeSkip(size, maxFileBytes),
+				)
+			: undefined;
+	}
+
+	/**
+	 * 읽은 파일 내용 분석 (캐시가 있으면 내용 해시로 이전 결과 재사용)
+	 *
+	 * cacheKeys에는 cacheBackend 조회에 쓴 키를 모은다 (prune 대상 판단용).
+	 * pool이 있으면 캐시에 없는 파일의 파싱은 worker thread에서 수행한다.
+	 */
+	private async analyzeContent(
+		sourceCode: string,
+		nodePath: string,
+		hash?: string,
+		cacheKeys?: Set<string>,
+		pool?: ExtractionPool,
+	): Promise<FileExtraction> {
+		const parse = () =>
+			pool
+				? pool.analyze(sourceCode, nodePath)
+				: this.analyzeSource(sourceCode, nodePath);
+		const { cache, cacheBackend } = this.options;
+		if (!cache && !cacheBackend) {
+			return parse();
+		}
+
+		const contentHash = hash ?? hashContent(sourceCode);
+		const version = this.getAnalyzerVersion();
+		const key = extractionCacheKey(nodePath, contentHash, version);
+		if (cacheBackend) {
+			cacheKeys?.add(key);
+		}
+		const cached = cache?.get(nodePath, contentHash, version);
+		if (cached) {
+			return cached;
+		}
+
+		let extraction = await cacheBackend?.get(key);
+		if (!extraction) {
+			extraction = await parse();
+			// 제한은 설정에 따라 달라지므로 건너뛴 결과는 캐시하지 않는다
+			if (extraction.skipped) {
+				return extraction;
+			}
+			await cacheBackend?.put(key, structuredClone(extraction));
+		}
+		cache?.set(nodePath, contentHash, version, extraction);
+		return extraction;
+	}
+
+	/**
+	 * 캐시 무효화 기준 버전 (캐시 형식 버전 + 등록된 추출기)
+	 */
+	private getAnalyzerVersion(): string {
+		const names = this.extractors.map((extractor) => extractor.name);
+		const parsers = this.annotationParsers.map((parser) => parser.name);
+		return `${EXTRACTION_CACHE_VERSION}:${names.join(",")}:${parsers.join(",")}`;
+	}
+
+	private getParser(language: string): BaseParser {
+		let parser = this.parsers.get(language);
+		if (!parser) {
+			parser = globalParserFactory.createParser(language as SupportedLanguage);
+			this.parsers.set(language, parser);
+		}
+		return parser;
+	}
+
+	private toNodePath(filePath: string): string {
+		if (!this.options.projectRoot) {
+			return filePath;
+		}
+		return path
+			.relative(this.options.projectRoot, path.resolve(filePath))
+			.replace(/\\/g, "/");
+	}
+}
+
+/**
+ * 디렉토리 아래의 파일 경로를 재귀적으로 수집 (경로 순)
+ *
+ * 상위 디렉토리의 ignore 규칙 뒤에 현재 디렉토리의 규칙을 이어 붙여
+ * 하위 .linkerignore가 우선하게 한다.
+ */
+async function collectFiles(
+	root: string,
+	directory: string,
+	inheritedRules: IgnoreRule[],
+): Promise<string[]> {
+	const base = path.relative(root, directory).replace(/\\/g, "/");
+	const localRules = await loadIgnoreFile(directory, base);
+	const rules = [...inheritedRules, ...localRules];
+
+	const files: string[] = [];
+	const entries = await fs.readdir(directory, { withFileTypes: true });
+	for (const entry of entries) {
+		const entryPath = path.join(directory, entry.name);
+		const relativePath = path.relative(root, entryPath);
+		if (entry.isDirectory()) {
+			if (
+				entry.name.startsWith(".") ||
+				IGNORED_DIRECTORIES.has(entry.name) ||
+				isIgnored(relativePath, true, rules)
+			) {
+				continue;
+			}
+			files.push(...(await collectFiles(root, entryPath, rules)));
+		} else if (entry.isFile() && !isIgnored(relativePath, false, rules)) {
+			files.push(entryPath);
+		}
+	}
+	return files.sort();
+}
+
+/**
+ * 두 패키지 노드가 같은 디렉토리에서 선언되었는지 확인
+ *
+ * 다른 디렉토리의 같은 ID 패키지는 같은 패키지가 아니므로 병합하지 않고
+ * 일반 노드처럼 충돌 정책을 따른다.
+ */
+function isSamePackageDirectory(a: SemanticNode, b: SemanticNode): boolean {
+	return path.posix.dirname(a.filePath) === path.posix.dirname(b.filePath);
+}
+
+/**
+ * 여러 파일에 나뉜 같은 패키지 노드 병합
+ *
+ * 패키지 문서 주석이 있는 파일의 노드를 대표로 삼고(둘 다 같으면 경로가
+ * 앞선 파일), 태그는 합집합으로 모은다. 파일 순서와 관계없이 결과가 같다.
+ */
+function mergePackageNodes(
+	existing: SemanticNode,
+	node: SemanticNode,
+): SemanticNode {
+	const documented = (candidate: SemanticNode) =>
+		candidate.semanticTags.length > 0 || Boolean(candidate.description);
+	const primary =
+		documented(existing) !== documented(node)
+			? documented(existing)
+				? existing
+				: node
+			: existing.filePath <= node.filePath
+				? existing
+				: node;
+	const other = primary === existing ? node : existing;
+
+	return {
+		...primary,
+		semanticTags: Array.from(
+			new Set([...primary.semanticTags, ...other.semanticTags]),
+		),
+	};
+}
+
+/**
+ * file-scoped 정책으로 노드 추가
+ *
+ * 다른 파일에서 선언된 같은 ID의 노드가 있으면 두 노드 모두 ID를
+ * "ID@파일경로"로 바꾸고 metadata.collidesWith에 원래 ID를 기록한다.
+ * 같은 파일의 노드는 교체하며, 이미 바뀐 ID의 노드(샤드 병합)도 같은
+ * 원래 ID의 후보로 묶는다.
+ */
+function addFileScopedNode(
+	graph: SemanticGraph,
+	node: SemanticNode,
+	scoped: Map<string, SemanticNode[]>,
+): void {
+	const original =
+		(node.metadata.collidesWith as string | undefined) ?? node.id;
+	const existing = graph.getNode(original);
+	let candidates = scoped.get(original);
+	if (!candidates) {
+		const conflicts =
+			existing !== undefined &&
+			!PLACEHOLDER_KINDS.has(existing.kind) &&
+			existing.filePath !== node.filePath;
+		if (node.id === original && !conflicts) {
+			graph.addNode(node);
+			return;
+		}
+		candidates = [];
+		scoped.set(original, candidates);
+		if (existing && !PLACEHOLDER_KINDS.has(existing.kind)) {
+			graph.removeNode(original);
+			addCandidate(graph, existing, original, candidates);
+		}
+	}
+	addCandidate(graph, node, original, candidates);
+}
+
+function addCandidate(
+	graph: SemanticGraph,
+	node: SemanticNode,
+	original: string,
+	candidates: SemanticNode[],
+): void {
+	const candidate = {
+		...node,
+		id: `${original}@${node.filePath}`,
+		metadata: { ...node.metadata, collidesWith: original },
+	};
+	graph.addNode(candidate);
+
+	const index = candidates.findIndex((other) => other.id === candidate.id);
+	if (index >= 0) {
+		candidates[index] = candidate;
+	} else {
+		candidates.push(candidate);
+	}
+}
+
+/**
+ * 엣지 끝점 ID 해석 (충돌한 ID면 같은 파일의 후보, 없으면 undefined)
+ */
+function resolveFileScopedId(
+	scoped: Map<string, SemanticNode[]>,
+	id: string,
+	filePath: string,
+): string | undefined {
+	const candidates = scoped.get(id);
+	if (!candidates) {
+		return id;
+	}
+	return candidates.find((candidate) => candidate.filePath === filePath)?.id;
+}
+
+/**
+ * 여러 파일에 선언된 ID를 가리키는 참조를 참조하는 노드에 기록
+ */
+function recordAmbiguousReference(
+	graph: SemanticGraph,
+	from: string,
+	edge: SemanticEdge,
+	scoped: Map<string, SemanticNode[]>,
+): void {
+	const source = graph.getNode(from);
+	if (!source) return;
+
+	const reference: AmbiguousReference = {
+		target: edge.to,
+		type: edge.type,
+		candidates: (scoped.get(edge.to) ?? []).map((candidate) => candidate.id),
+	};
+	if (typeof edge.metadata?.line === "number") {
+		reference.line = edge.metadata.line;
+	}
+	const references =
+		(source.metadata.ambiguousReferences as AmbiguousReference[] | undefined) ??
+		[];
+	graph.addNode({
+		...source,
+		metadata: {
+			...source.metadata,
+			ambiguousReferences: [...references, reference],
+		},
+	});
+}
+
+/**
+ * 충돌하지 않는 "#N" 접미사 ID 찾기 (N은 2부터)
+ */
+function nextAvailableId(graph: SemanticGraph, id: string): string {
+	let index = 2;
+	while (graph.hasNode(`${id}#${index}`)) {
+		index++;
+	}
+	return `${id}#${index}`;
+}
+
+/**
+ * maxFileBytes를 넘는 파일의 건너뛴 사유
+ */
+function fileSizeSkip(size: number, maxFileBytes: number): FileSkip {
+	return {
+		reason: "max-file-bytes",
+		message: `File is ${size} bytes, over the ${maxFileBytes} byte limit`,
+	};
+}
+
+/**
+ * 건너뛴 파일의 추출 결과 (metadata.skipped를 기록한 파일 노드 하나)
+ */
+function createSkippedExtraction(
+	filePath: string,
+	language: string,
+	skipped: FileSkip,
+): FileExtraction {
+	return {
+		filePath,
+		language,
+		nodes: [
+			{
+				id: filePath,
+				fqn: filePath,
+				name: path.posix.basename(filePath),
+				kind: "file",
+				filePath,
+				language,
+				line: 1,
+				semanticTags: [],
+				metadata: { skipped },
+			},
+		],
+		edges: [],
+		partial: true,
+		errors: [],
+		skipped,
+	};
+}
+
+/**
+ * 분석기 팩토리 함수
+ */
+export function createSemanticAnalyzer(
+	options?: SemanticAnalyzerOptions,
+): SemanticAnalyzer {
+	return new SemanticAnalyzer(options);
+}
@@ -0,0 +1,309 @@
+/**
+ * Semantic Graph
+ * 심볼 단위 노드와 관계를 보관하는 인메모리 그래프
+ */
+
+import { type EdgeKind, edgeKindOf } from "./edge-kinds";
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/**
+ * 그래프 스냅샷 핸들
+ *
+ * 스냅샷 이후의 구조 변경(노드/엣지 추가·삭제)은 저널에 기록되며
+ * restore 시 역순으로 되돌린다. 노드 객체 내부 필드의 직접 수정은 기록되지 않는다.
+ */
+export interface GraphSnapshot {
+	readonly id: number;
+	readonly position: number;
+}
+
+/**
+ * 심볼 그래프 클래스
+ */
+export class SemanticGraph {
+	readonly nodes = new Map<string, SemanticNode>();
+	readonly edges: SemanticEdge[] = [];
+
+	/** from -> 나가는 엣지 (edges와 같은 순서) */
+	private outgoing = new Map<string, SemanticEdge[]>();
+	/** to -> 들어오는 엣지 (edges와 같은 순서) */
+	private incoming = new Map<string, SemanticEdge[]>();
+	/** from\0to\0type 키 집합 */
+	private edgeKeys = new Set<string>();
+
+	private journal: Array<() => void> = [];
+	private openSnapshots = new Set<number>();
+	private snapshotSequence = 0;
+	private replaying = false;
+
+	/**
+	 * 노드 추가 (같은 ID가 있으면 교체)
+	 */
+	addNode(node: SemanticNode): void {
+		const previous = this.nodes.get(node.id);
+		this.nodes.set(node.id, node);
+		this.record(() => {
+			if (previous) {
+				this.nodes.set(node.id, previous);
+			} else {
+				this.nodes.delete(node.id);
+			}
+		});
+	}
+
+	/**
+	 * 엣지 추가 (동일한 from/to/type 엣지는 한 번만 저장)
+	 */
+	addEdge(edge: SemanticEdge): boolean {
+		if (this.hasEdge(edge.from, edge.to, edge.type)) {
+			return false;
+		}
+		this.edges.push(edge);
+		this.indexEdge(edge);
+		this.record(() => {
+			this.edges.pop();
+			this.unindexEdge(edge);
+		});
+		return true;
+	}
+
+	/**
+	 * 노드 조회
+	 */
+	getNode(id: string): SemanticNode | undefined {
+		return this.nodes.get(id);
+	}
+
+	/**
+	 * 노드 존재 여부
+	 */
+	hasNode(id: string): boolean {
+		return this.nodes.has(id);
+	}
+
+	/**
+	 * 엣지 존재 여부
+	 */
+	hasEdge(from: string, to: string, type?: string): boolean {
+		if (type !== undefined) {
+			return this.edgeKeys.has(edgeKey(from, to, type));
+		}
+		return this.findEdge(from, to) !== undefined;
+	}
+
+	/**
+	 * 노드에서 나가는 엣지 조회
+	 */
+	getOutgoingEdges(id: string, types?: string[]): SemanticEdge[] {
+		return (this.outgoing.get(id) ?? []).filter(
+			(edge) => !types || types.includes(edge.type),
+		);
+	}
+
+	/**
+	 * 노드로 들어오는 엣지 조회
+	 */
+	getIncomingEdges(id: string, types?: string[]): SemanticEdge[] {
+		return (this.incoming.get(id) ?? []).filter(
+			(edge) => !types || types.includes(edge.type),
+		);
+	}
+
+	/**
+	 * 분류가 kind인 엣지 조회 (edgeKindOf 기준)
+	 */
+	edgesOfKind(kind: EdgeKind): SemanticEdge[] {
+		return this.edges.filter((edge) => edgeKindOf(edge) === kind);
+	}
+
+	/**
+	 * 엣지 제거
+	 */
+	removeEdge(from: string, to: string, type?: string): boolean {
+		const edge = this.findEdge(from, to, type);
+		if (!edge) {
+			return false;
+		}
+		this.spliceEdge(this.edges.indexOf(edge));
+		return true;
+	}
+
+	/**
+	 * 노드와 연결된 엣지 제거
+	 */
+	removeNode(id: string): boolean {
+		const node = this.nodes.get(id);
+		if (!node) {
+			return false;
+		}
+
+		const attached = new Set([
+			...(this.outgoing.get(id) ?? []),
+			...(this.incoming.get(id) ?? []),
+		]);
+		if (attached.size > 0) {
+			for (let i = this.edges.length - 1; i >= 0; i--) {
+				if (attached.has(this.edges[i])) {
+					this.spliceEdge(i);
+				}
+			}
+		}
+
+		const position = Array.from(this.nodes.keys()).indexOf(id);
+		this.nodes.delete(id);
+		this.record(() => {
+			// 원래 순회 순서를 유지하도록 Map을 재구성
+			const entries = Array.from(this.nodes.entries());
+			entries.splice(position, 0, [id, node]);
+			this.nodes.clear();
+			for (const [key, value] of entries) {
+				this.nodes.set(key, value);
+			}
+		});
+		return true;
+	}
+
+	/**
+	 * 현재 상태의 스냅샷 생성
+	 */
+	snapshot(): GraphSnapshot {
+		const snapshot = {
+			id: ++this.snapshotSequence,
+			position: this.journal.length,
+		};
+		this.openSnapshots.add(snapshot.id);
+		return snapshot;
+	}
+
+	/**
+	 * 스냅샷 시점으로 되돌리기
+	 *
+	 * 이 스냅샷 이후에 만든 스냅샷은 무효화된다. 스냅샷 자체는 계속 유효하므로
+	 * 같은 스냅샷으로 여러 번 실험하고 되돌릴 수 있다.
+	 */
+	restore(snapshot: GraphSnapshot): void {
+		if (!this.openSnapshots.has(snapshot.id)) {
+			throw new Error(`Snapshot ${snapshot.id} is not active on this graph`);
+		}
+
+		this.replaying = true;
+		try {
+			while (this.journal.length > snapshot.position) {
+				const undo = this.journal.pop() as () => void;
+				undo();
+			}
+		} finally {
+			this.replaying = false;
+		}
+
+		for (const id of Array.from(this.openSnapshots)) {
+			if (id > snapshot.id) {
+				this.openSnapshots.delete(id);
+			}
+		}
+	}
+
+	/**
+	 * 스냅샷 해제 (열린 스냅샷이 없으면 저널을 비움)
+	 */
+	release(snapshot: GraphSnapshot): void {
+		this.openSnapshots.delete(snapshot.id);
+		if (this.openSnapshots.size === 0) {
+			this.journal = [];
+		}
+	}
+
+	private findEdge(
+		from: string,
+		to: string,
+		type?: string,
+	): SemanticEdge | undefined {
+		if (type !== undefined && !this.edgeKeys.has(edgeKey(from, to, type))) {
+			return undefined;
+		}
+		return this.outgoing
+			.get(from)
+			?.find(
+				(edge) => edge.to === to && (type === undefined || edge.type === type),
+			);
+	}
+
+	private spliceEdge(index: number): void {
+		const [removed] = this.edges.splice(index, 1);
+		const positions = this.unindexEdge(removed);
+		this.record(() => {
+			this.edges.splice(index, 0, removed);
+			this.indexEdge(removed, positions);
+		});
+	}
+
+	/**
+	 * 인덱스에 엣지 등록 (positions가 있으면 제거 전 위치로 되돌림)
+	 */
+	private indexEdge(
+		edge: SemanticEdge,
+		positions?: [outgoing: number, incoming: number],
+	): void {
+		insertAt(this.outgoing, edge.from, edge, positions?.[0]);
+		insertAt(this.incoming, edge.to, edge, positions?.[1]);
+		this.edgeKeys.add(edgeKey(edge.from, edge.to, edge.type));
+	}
+
+	/**
+	 * 인덱스에서 엣지 제거 후 각 목록에서의 위치 반환
+	 */
+	private unindexEdge(edge: SemanticEdge): [number, number] {
+		this.edgeKeys.delete(edgeKey(edge.from, edge.to, edge.type));
+		return [
+			removeFrom(this.outgoing, edge.from, edge),
+			removeFrom(this.incoming, edge.to, edge),
+		];
+	}
+
+	private record(undo: () => void): void {
+		if (this.openSnapshots.size > 0 && !this.replaying) {
+			this.journal.push(undo);
+		}
+	}
+}
+
+function edgeKey(from: string, to: string, type: string): string {
+	return `${from}\0${to}\0${type}`;
+}
+
+function insertAt(
+	index: Map<string, SemanticEdge[]>,
+	id: string,
+	edge: SemanticEdge,
+	position?: number,
+): void {
+	const list = index.get(id);
+	if (!list) {
+		index.set(id, [edge]);
+	} else if (position === undefined) {
+		list.push(edge);
+	} else {
+		list.splice(position, 0, edge);
+	}
+}
+
+function removeFrom(
+	index: Map<string, SemanticEdge[]>,
+	id: string,
+	edge: SemanticEdge,
+): number {
+	const list = index.get(id) ?? [];
+	const position = list.lastIndexOf(edge);
+	list.splice(position, 1);
+	if (list.length === 0) {
+		index.delete(id);
+	}
+	return position;
+}
+
+/**
+ * 심볼 그래프 팩토리 함수
+ */
+export function createSemanticGraph(): SemanticGraph {
+	return new SemanticGraph();
+}
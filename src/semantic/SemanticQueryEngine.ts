@@ -0,0 +1,483 @@
+/**
+ * Semantic Query Engine
+ * 심볼 그래프 조회 API (커서 기반 페이지네이션 지원)
+ */
+
+import {
+	type ClosureEntry,
+	type ClosureOptions,
+	computeClosure,
+} from "./closure";
+import { type ComplexityMetrics, getComplexity } from "./complexity";
+import {
+	type DependencyPath,
+	findShortestPaths,
+	type PathOptions,
+} from "./dependency-path";
+import { globToRegExp } from "./glob";
+import {
+	computeHotspots,
+	type DegreeBreakdown,
+	fanIn,
+	fanOut,
+	type GraphMetricsOptions,
+	type Hotspot,
+	type HotspotOptions,
+} from "./graph-metrics";
+import { computeImpactSet, type ImpactEntry } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { Page, PagedResult, SemanticNode } from "./types";
+
+/** limit 없이 page가 주어졌을 때의 기본 페이지 크기 */
+export const DEFAULT_PAGE_LIMIT = 100;
+
+/** 스트리밍 쿼리가 이벤트 루프에 양보하기 전까지 검사하는 노드 수 */
+export const DEFAULT_STREAM_BATCH_SIZE = 256;
+
+/**
+ * 복합 쿼리 (지정한 조건을 모두 만족하는 노드)
+ */
+export interface SemanticQuery {
+	tag?: string;
+	kind?: string;
+	filePath?: string;
+	/** 이름 또는 FQN 패턴 */
+	pattern?: string | RegExp;
+	owner?: string;
+}
+
+/**
+ * 태그 검색 결과 심볼 참조
+ */
+export interface SymbolRef {
+	id: string;
+	name: string;
+	/** 노드 종류 (struct, interface, function, method 등) */
+	kind: string;
+	filePath: string;
+	line?: number;
+	/** contains 엣지로 이 심볼을 포함하는 노드 ID (예: 메서드의 리시버 타입) */
+	parent?: string;
+	/** 함수/메서드의 크기/복잡도 (sortByComplexity로 정렬 가능) */
+	complexity?: ComplexityMetrics;
+}
+
+/**
+ * 태그 검색 옵션
+ */
+export interface TagMatchOptions {
+	/** 대소문자 구분 없이 비교 (기본: false) */
+	caseInsensitive?: boolean;
+}
+
+/**
+ * 이름 패턴 검색 옵션
+ */
+export interface NameMatchOptions {
+	/** 패턴을 glob 대신 정규식으로 해석 (기본: false) */
+	regex?: boolean;
+	/** 이름 대신 FQN(예: user.UserService.GetUser)과 비교 (기본: false) */
+	fqn?: boolean;
+}
+
+/**
+ * 스트리밍 쿼리 옵션
+ */
+export interface StreamQueryOptions {
+	/** 중단 신호 (중단되면 다음 결과를 내보내기 전에 종료) */
+	signal?: AbortSignal;
+	/** 이벤트 루프에 양보하기 전까지 검사하는 노드 수 */
+	batchSize?: number;
+}
+
+/**
+ * 심볼 그래프 쿼리 엔진
+ */
+export class SemanticQueryEngine {
+	private graph: SemanticGraph;
+
+	constructor(graph: SemanticGraph) {
+		this.graph = graph;
+	}
+
+	/**
+	 * 복합 쿼리로 노드 조회
+	 */
+	query(query: SemanticQuery, page?: Page): PagedResult<SemanticNode> {
+		return paginate(this.collect(createQueryPredicate(query)), page);
+	}
+
+	/**
+	 * 복합 쿼리 결과를 ID 순으로 찾는 대로 스트리밍
+	 *
+	 * batchSize개 노드를 검사할 때마다 이벤트 루프에 양보해 서버가 다른 요청을
+	 * 처리할 수 있게 하며, signal이 중단되면 남은 노드를 검사하지 않고 종료한다.
+	 * 내보낸 결과는 항상 query() 전체 결과의 앞부분과 같다.
+	 */
+	async *streamQuery(
+		query: SemanticQuery,
+		options: StreamQueryOptions = {},
+	): AsyncGenerator<SemanticNode> {
+		const batchSize = options.batchSize ?? DEFAULT_STREAM_BATCH_SIZE;
+		if (!Number.isInteger(batchSize) || batchSize <= 0) {
+			throw new Error(`Invalid batch size: ${batchSize}`);
+		}
+
+		const predicate = createQueryPredicate(query);
+		const ids = Array.from(this.graph.nodes.keys()).sort();
+		for (let i = 0; i < ids.length; i++) {
+			if (options.signal?.aborted) return;
+			if (i > 0 && i % batchSize === 0) {
+				await new Promise((resolve) => setImmediate(resolve));
+				if (options.signal?.aborted) return;
+			}
+
+			const node = this.graph.getNode(ids[i]);
+			if (node && predicate(node)) {
+				yield node;
+			}
+		}
+	}
+
+	/**
+	 * 태그로 노드 조회
+	 */
+	queryByTag(tag: string, page?: Page): PagedResult<SemanticNode> {
+		return this.query({ tag }, page);
+	}
+
+	/**
+	 * 태그가 정확히 일치하는 심볼 찾기 (파일 경로, 라인, ID 순)
+	 */
+	findByTag(tag: string, options: TagMatchOptions = {}): SymbolRef[] {
+		const normalize = (value: string) =>
+			options.caseInsensitive ? value.toLowerCase() : value;
+		const target = normalize(tag);
+
+		return this.toSymbolRefs(
+			this.collect((node) =>
+				node.semanticTags.some((candidate) => normalize(candidate) === target),
+			),
+		);
+	}
+
+	/**
+	 * 이름 패턴과 일치하는 심볼 찾기 (findByTag와 같은 순서와 형태)
+	 *
+	 * 기본은 전체 이름과 일치해야 하는 glob (`Get*`)이며, regex 옵션을 주면
+	 * 이름의 일부와 일치하는 정규식으로 해석한다. 잘못된 정규식은 예외.
+	 */
+	findByName(pattern: string, options: NameMatchOptions = {}): SymbolRef[] {
+		let regex: RegExp;
+		if (options.regex) {
+			try {
+				regex = new RegExp(pattern);
+			} catch (error) {
+				throw new Error(
+					`Invalid name pattern "${pattern}": ${(error as Error).message}`,
+				);
+			}
+		} else {
+			regex = globToRegExp(pattern);
+		}
+
+		return this.toSymbolRefs(
+			this.collect((node) => regex.test(options.fqn ? node.fqn : node.name)),
+		);
+	}
+
+	/**
+	 * 이름 또는 FQN 패턴으로 노드 조회
+	 */
+	queryByPattern(
+		pattern: string | RegExp,
+		page?: Page,
+	): PagedResult<SemanticNode> {
+		return this.query({ pattern }, page);
+	}
+
+	/**
+	 * 노드 종류로 조회
+	 */
+	queryByKind(kind: string, page?: Page): PagedResult<SemanticNode> {
+		return this.query({ kind }, page);
+	}
+
+	/**
+	 * 파일 경로로 조회
+	 */
+	queryByFile(filePath: string, page?: Page): PagedResult<SemanticNode> {
+		return this.query({ filePath }, page);
+	}
+
+	/**
+	 * 소유자로 조회 (assignOwners로 지정된 metadata.owners 기준)
+	 */
+	queryByOwner(owner: string, page?: Page): PagedResult<SemanticNode> {
+		return this.query({ owner }, page);
+	}
+
+	/**
+	 * 지정한 홉 수 이내의 이웃 노드 조회 (엣지 방향 무관, 시작 노드 제외)
+	 */
+	queryNeighbors(
+		id: string,
+		hops = 1,
+		page?: Page,
+	): PagedResult<SemanticNode> {
+		if (!Number.isInteger(hops) || hops < 1) {
+			throw new Error(`Invalid hop count: ${hops}`);
+		}
+
+		const visited = new Set<string>([id]);
+		let frontier = [id];
+		for (let depth = 0; depth < hops && frontier.length > 0; depth++) {
+			const next: string[] = [];
+			for (const current of frontier) {
+				const adjacent = [
+					...this.graph.getOutgoingEdges(current).map((edge) => edge.to),
+					...this.graph.getIncomingEdges(current).map((edge) => edge.from),
+				];
+				for (const neighbor of adjacent) {
+					if (!visited.has(neighbor)) {
+						visited.add(neighbor);
+						next.push(neighbor);
+					}
+				}
+			}
+			frontier = next;
+		}
+
+		visited.delete(id);
+		const neighbors = Array.from(visited)
+			.map((neighborId) => this.graph.getNode(neighborId))
+			.filter((node): node is SemanticNode => node !== undefined);
+		return paginate(neighbors, page);
+	}
+
+	/**
+	 * 심볼에 전이적으로 의존하는 심볼 조회 (깊이, ID 순)
+	 *
+	 * symbol은 노드 ID 또는 FQN이며, 타입처럼 멤버를 contains로 포함하는
+	 * 심볼은 멤버(메서드 등)에 대한 의존도 함께 따라간다. depth 0은 제한 없음.
+	 */
+	reverseDeps(symbol: string, depth = 0): ImpactEntry[] {
+		if (!Number.isInteger(depth) || depth < 0) {
+			throw new Error(`Invalid depth: ${depth}`);
+		}
+		return computeImpactSet(this.graph, this.resolveSeeds(symbol), {
+			maxDepth: depth === 0 ? undefined : depth,
+		});
+	}
+
+	/**
+	 * 심볼이 전이적으로 의존하는 심볼 조회 (깊이, ID 순, 중복 없음)
+	 *
+	 * symbol과 멤버 처리는 reverseDeps와 같다. includePaths를 주면 각
+	 * 심볼에 시작 심볼부터의 최단 경로("X가 왜 Y에 의존하는가")를 담는다.
+	 */
+	closure(symbol: string, options: ClosureOptions = {}): ClosureEntry[] {
+		const { maxDepth } = options;
+		if (
+			maxDepth !== undefined &&
+			(!Number.isInteger(maxDepth) || maxDepth < 0)
+		) {
+			throw new Error(`Invalid depth: ${maxDepth}`);
+		}
+		return computeClosure(this.graph, this.resolveSeeds(symbol), options);
+	}
+
+	/**
+	 * from 심볼에서 to 심볼로 가는 최단 의존 경로 (도달할 수 없으면 undefined)
+	 *
+	 * 두 심볼은 노드 ID 또는 FQN이며, 멤버로의 경로는 따로 찾지 않는다.
+	 */
+	path(
+		from: string,
+		to: string,
+		options: Omit<PathOptions, "all"> = {},
+	): DependencyPath | undefined {
+		return this.paths(from, to, { ...options, all: false })[0];
+	}
+
+	/**
+	 * from 심볼에서 to 심볼로 가는 최단 의존 경로 (all이면 같은 길이 전부)
+	 */
+	paths(from: string, to: string, options: PathOptions = {}): DependencyPath[] {
+		return findShortestPaths(
+			this.graph,
+			this.resolveNode(from).id,
+			this.resolveNode(to).id,
+			options,
+		);
+	}
+
+	/**
+	 * 심볼로 들어오는 의존 차수 (엣지 분류별 내역 포함)
+	 *
+	 * symbol은 노드 ID 또는 FQN이며, 멤버에 대한 의존은 포함하지 않는다.
+	 */
+	fanIn(symbol: string, options?: GraphMetricsOptions): DegreeBreakdown {
+		return fanIn(this.graph, this.resolveNode(symbol).id, options);
+	}
+
+	/**
+	 * 심볼에서 나가는 의존 차수 (엣지 분류별 내역 포함)
+	 */
+	fanOut(symbol: string, options?: GraphMetricsOptions): DegreeBreakdown {
+		return fanOut(this.graph, this.resolveNode(symbol).id, options);
+	}
+
+	/**
+	 * fan-in/fan-out 가중 합 기준 상위 count개 심볼
+	 */
+	hotspots(count: number, options?: HotspotOptions): Hotspot[] {
+		return computeHotspots(this.graph, count, options);
+	}
+
+	/**
+	 * 노드 ID 또는 FQN으로 심볼 찾기
+	 */
+	private resolveNode(symbol: string): SemanticNode {
+		const node =
+			this.graph.getNode(symbol) ??
+			this.collect((candidate) => candidate.fqn === symbol)[0];
+		if (!node) {
+			throw new Error(`Unknown symbol: ${symbol}`);
+		}
+		return node;
+	}
+
+	/**
+	 * 노드 ID 또는 FQN으로 찾은 심볼과 그 멤버 (contains)
+	 */
+	private resolveSeeds(symbol: string): string[] {
+		const node = this.resolveNode(symbol);
+		const members = this.graph
+			.getOutgoingEdges(node.id, ["contains"])
+			.map((edge) => edge.to);
+		return [node.id, ...members];
+	}
+
+	private collect(predicate: (node: SemanticNode) => boolean): SemanticNode[] {
+		return Array.from(this.graph.nodes.values()).filter(predicate);
+	}
+
+	/**
+	 * 노드를 파일 경로, 라인, ID 순으로 정렬해 심볼 참조로 변환
+	 */
+	private toSymbolRefs(nodes: SemanticNode[]): SymbolRef[] {
+		return nodes
+			.sort(
+				(a, b) =>
+					(a.filePath < b.filePath ? -1 : a.filePath > b.filePath ? 1 : 0) ||
+					(a.line ?? 0) - (b.line ?? 0) ||
+					compareById(a, b),
+			)
+			.map((node) => ({
+				id: node.id,
+				name: node.name,
+				kind: node.kind,
+				filePath: node.filePath,
+				line: node.line,
+				parent: this.graph.getIncomingEdges(node.id, ["contains"])[0]?.from,
+				complexity: getComplexity(node),
+			}));
+	}
+}
+
+/**
+ * 복합 쿼리를 노드 판별 함수로 변환
+ */
+function createQueryPredicate(
+	query: SemanticQuery,
+): (node: SemanticNode) => boolean {
+	const regex =
+		typeof query.pattern === "string"
+			? new RegExp(query.pattern)
+			: query.pattern;
+
+	return (node) =>
+		(query.tag === undefined || node.semanticTags.includes(query.tag)) &&
+		(query.kind === undefined || node.kind === query.kind) &&
+		(query.filePath === undefined || node.filePath === query.filePath) &&
+		(regex === undefined || regex.test(node.name) || regex.test(node.fqn)) &&
+		(query.owner === undefined ||
+			((node.metadata.owners as string[] | undefined) ?? []).includes(
+				query.owner,
+			));
+}
+
+/**
+ * 노드 목록을 ID 순으로 정렬해 페이지 단위로 자르기
+ *
+ * 커서는 마지막으로 반환한 노드 ID를 인코딩하므로 페이지 사이에
+ * 노드가 추가/삭제되어도 중복 없이 이어서 조회할 수 있다.
+ * page가 없으면 전체 결과를 한 번에 반환한다.
+ */
+export function paginate<T extends { id: string }>(
+	items: T[],
+	page?: Page,
+): PagedResult<T> {
+	const sorted = [...items].sort(compareById);
+	if (!page) {
+		return { items: sorted };
+	}
+
+	const limit = page.limit ?? DEFAULT_PAGE_LIMIT;
+	if (!Number.isInteger(limit) || limit <= 0) {
+		throw new Error(`Invalid page limit: ${limit}`);
+	}
+
+	let start = 0;
+	if (page.cursor) {
+		const afterId = decodeCursor(page.cursor);
+		start = sorted.findIndex((item) => item.id > afterId);
+		if (start === -1) {
+			return { items: [] };
+		}
+	}
+
+	const pageItems = sorted.slice(start, start + limit);
+	const hasMore = start + limit < sorted.length;
+
+	return {
+		items: pageItems,
+		nextCursor: hasMore
+			? encodeCursor(pageItems[pageItems.length - 1].id)
+			: undefined,
+	};
+}
+
+function compareById(a: { id: string }, b: { id: string }): number {
+	if (a.id < b.id) return -1;
+	if (a.id > b.id) return 1;
+	return 0;
+}
+
+/**
+ * 노드 ID를 페이지 커서로 인코딩
+ */
+export function encodeCursor(id: string): string {
+	return Buffer.from(id, "utf-8").toString("base64url");
+}
+
+/**
+ * 페이지 커서를 노드 ID로 디코딩 (잘못된 커서는 예외)
+ */
+export function decodeCursor(cursor: string): string {
+	const id = Buffer.from(cursor, "base64url").toString("utf-8");
+	if (!id || encodeCursor(id) !== cursor) {
+		throw new Error(`Invalid pagination cursor: ${cursor}`);
+	}
+	return id;
+}
+
+/**
+ * 쿼리 엔진 팩토리 함수
+ */
+export function createSemanticQueryEngine(
+	graph: SemanticGraph,
+): SemanticQueryEngine {
+	return new SemanticQueryEngine(graph);
+}
@@ -0,0 +1,319 @@
+/**
+ * Doc Comment Annotations
+ * 문서 주석의 @directive 파싱 (@semantic-tags, @description 등)
+ */
+
+import type { SemanticNode } from "./types";
+
+/**
+ * 문서 주석에서 추출한 어노테이션
+ */
+export interface DocAnnotations {
+	/** @semantic-tags 목록 */
+	semanticTags: string[];
+	/** @description 텍스트 */
+	description?: string;
+	/** directive 이름 -> 값 목록 (등장 순서) */
+	annotations: Record<string, string[]>;
+	/** 기본 파서 외 어노테이션 파서 이름 -> 결과 (결과가 없으면 undefined) */
+	data?: Record<string, unknown>;
+	/** 문서 주석 원문과 정리된 본문 (parseDocComments, 주석이 없으면 undefined) */
+	comment?: DocComment;
+}
+
+/**
+ * 심볼의 문서 주석 텍스트 (노드 metadata.doc)
+ */
+export interface DocComment {
+	/** 주석 마커를 포함한 원문 (주석 블록 사이는 "\n") */
+	raw: string;
+	/** 주석 마커를 제거하고 앞뒤 빈 줄을 뺀 본문 (directive 라인 포함) */
+	text: string;
+}
+
+/**
+ * 주석 라인의 directive
+ */
+export interface Directive {
+	name: string;
+	value: string;
+}
+
+/**
+ * 어노테이션 파서 입력
+ */
+export interface AnnotationParseContext {
+	/** 주석 마커를 제거한 주석 라인 */
+	lines: string[];
+	/** 라인에서 찾은 directive (등장 순서) */
+	directives: Directive[];
+}
+
+/**
+ * 문서 주석 어노테이션 파서 플러그인
+ *
+ * 분석기마다 등록하며, 결과는 파서 이름을 키로 노드의
+ * metadata.annotationData에 기록된다. 같은 이름의 파서를 등록하면
+ * 기존 파서(기본 파서 포함)를 대체한다.
+ */
+export interface AnnotationParser {
+	/** 파서 이름 (결과 키) */
+	readonly name: string;
+	/** 구조화된 결과 (해당 어노테이션이 없으면 undefined) */
+	parse(context: AnnotationParseContext): unknown;
+}
+
+/** @semantic-tags 기본 파서 (DocAnnotations.semanticTags) */
+export const semanticTagsParser: AnnotationParser = {
+	name: "semantic-tags",
+	parse: ({ lines }) => parseSemanticTags(lines),
+};
+
+/** @description 기본 파서 (DocAnnotations.description) */
+export const descriptionParser: AnnotationParser = {
+	name: "description",
+	parse: ({ directives }) =>
+		directives.find((directive) => directive.name === "description")?.value,
+};
+
+/** 분석기에 항상 먼저 등록되는 기본 파서 */
+export const DEFAULT_ANNOTATION_PARSERS: readonly AnnotationParser[] = [
+	semanticTagsParser,
+	descriptionParser,
+];
+
+/**
+ * `@key: value` directive 하나를 읽는 파서 생성
+ *
+ * 기본 결과는 등장한 값 목록이며, transform으로 원하는 형태로 바꿀 수 있다.
+ * 예: createDirectiveParser("owner", (values) => values[0])
+ */
+export function createDirectiveParser<T = string[]>(
+	directive: string,
+	transform: (values: string[]) => T = (values) => values as T,
+	name = directive,
+): AnnotationParser {
+	return {
+		name,
+		parse: ({ directives }) => {
+			const values = directives
+				.filter((candidate) => candidate.name === directive)
+				.map((candidate) => candidate.value);
+			return values.length > 0 ? transform(values) : undefined;
+		},
+	};
+}
+
+/**
+ * 같은 이름의 파서를 뒤의 것으로 대체해 합친 파서 목록 (처음 등록 순서 유지)
+ */
+export function mergeAnnotationParsers(
+	...groups: ReadonlyArray<readonly AnnotationParser[]>
+): AnnotationParser[] {
+	const byName = new Map<string, AnnotationParser>();
+	for (const parser of groups.flat()) {
+		byName.set(parser.name, parser);
+	}
+	return Array.from(byName.values());
+}
+
+const DIRECTIVE_PATTERN = /(?:^|\s)@([A-Za-z][\w-]*)(:?)/g;
+
+/** 쉼표로 구분된 태그만 있는 라인 (여러 줄로 나눈 태그 목록의 이어지는 줄) */
+const TAG_LIST_PATTERN = /^[\w./-]+(?:\s*,\s*[\w./-]+)*\s*,?$/;
+
+/**
+ * 주석 마커 제거 ("//", "#", "/* *\/", 선행 "*")
+ */
+export function stripCommentMarkers(comment: string): string[] {
+	const trimmed = comment.trim();
+
+	if (trimmed.startsWith("/*")) {
+		return trimmed
+			.replace(/^\/\*+/, "")
+			.replace(/\*+\/$/, "")
+			.split("\n")
+			.map((line) => line.replace(/^\s*\*?\s?/, "").trimEnd());
+	}
+
+	return trimmed
+		.split("\n")
+		.map((line) => line.replace(/^\s*(\/\/|#)\s?/, "").trimEnd());
+}
+
+/**
+ * 주석 라인에서 directive 추출
+ *
+ * `@key: value` 형식은 라인 끝까지를 값으로 사용하고,
+ * `@key value` 형식은 다음 directive 직전까지를 값으로 사용한다.
+ * 예: `@retry 3 @timeout 5s` -> retry: "3", timeout: "5s"
+ */
+export function parseDirectives(lines: string[]): Directive[] {
+	const directives: Directive[] = [];
+
+	for (const line of lines) {
+		const matches = Array.from(line.matchAll(DIRECTIVE_PATTERN));
+		for (let i = 0; i < matches.length; i++) {
+			const match = matches[i];
+			const valueStart = (match.index ?? 0) + match[0].length;
+
+			if (match[2] === ":") {
+				directives.push({
+					name: match[1],
+					value: line.slice(valueStart).trim(),
+				});
+				break;
+			}
+
+			const next = matches[i + 1];
+			const valueEnd = next ? (next.index ?? line.length) : line.length;
+			directives.push({
+				name: match[1],
+				value: line.slice(valueStart, valueEnd).trim(),
+			});
+		}
+	}
+
+	return directives;
+}
+
+/**
+ * 주석 라인에서 어노테이션 파싱
+ *
+ * semanticTags와 description도 "semantic-tags", "description" 파서의 결과로
+ * 채우며, 나머지 파서의 결과는 data에 모은다.
+ */
+export function parseDocAnnotations(
+	lines: string[],
+	parsers: readonly AnnotationParser[] = DEFAULT_ANNOTATION_PARSERS,
+): DocAnnotations {
+	const directives = parseDirectives(lines);
+	const annotations: Record<string, string[]> = {};
+	for (const { name, value } of directives) {
+		if (!annotations[name]) {
+			annotations[name] = [];
+		}
+		annotations[name].push(value);
+	}
+
+	const results: Record<string, unknown> = {};
+	for (const parser of parsers) {
+		const result = parser.parse({ lines, directives });
+		if (result !== undefined) {
+			results[parser.name] = result;
+		}
+	}
+
+	const {
+		[semanticTagsParser.name]: semanticTags,
+		[descriptionParser.name]: description,
+		...data
+	} = results;
+	return {
+		semanticTags: (semanticTags as string[] | undefined) ?? [],
+		description: description as string | undefined,
+		annotations,
+		data: Object.keys(data).length > 0 ? data : undefined,
+	};
+}
+
+/**
+ * 주석 원문에서 어노테이션과 문서 주석 텍스트 파싱
+ *
+ * comments는 선언 위의 주석 노드 텍스트 (마커 포함, 위에서부터)다.
+ */
+export function parseDocComments(
+	comments: string[],
+	parsers: readonly AnnotationParser[] = DEFAULT_ANNOTATION_PARSERS,
+): DocAnnotations {
+	const lines = comments.flatMap(stripCommentMarkers);
+	return {
+		...parseDocAnnotations(lines, parsers),
+		comment: createDocComment(comments, lines),
+	};
+}
+
+/**
+ * 문서 주석 텍스트 생성 (주석이 없으면 undefined)
+ *
+ * lines를 생략하면 comments의 주석 마커를 제거해 본문을 만든다. Python
+ * docstring처럼 마커 규칙이 다른 원문은 lines를 직접 넘긴다.
+ */
+export function createDocComment(
+	comments: string[],
+	lines: string[] = comments.flatMap(stripCommentMarkers),
+): DocComment | undefined {
+	if (comments.length === 0) {
+		return undefined;
+	}
+
+	let start = 0;
+	let end = lines.length;
+	while (start < end && lines[start].trim() === "") start++;
+	while (end > start && lines[end - 1].trim() === "") end--;
+	return {
+		raw: comments.join("\n"),
+		text: lines.slice(start, end).join("\n"),
+	};
+}
+
+/**
+ * 주석 라인에서 @semantic-tags 목록 파싱 (중복 제거, 처음 등장한 순서)
+ *
+ * 여러 @semantic-tags 라인의 태그를 모두 모으며, 태그 라인 바로 다음의
+ * directive 없는 라인이 쉼표로 구분된 태그만 담고 있고 앞 라인이 비었거나
+ * 쉼표로 끝났거나 그 라인에 쉼표가 있으면 같은 목록이 이어지는 것으로 본다.
+ */
+export function parseSemanticTags(lines: string[]): string[] {
+	const tags = new Set<string>();
+	let previous: string | undefined;
+
+	for (const line of lines) {
+		const directives = parseDirectives([line]);
+		const tagDirective = directives.find(
+			(directive) => directive.name === "semantic-tags",
+		);
+
+		let value: string | undefined;
+		if (tagDirective) {
+			value = tagDirective.value;
+		} else if (previous !== undefined && directives.length === 0) {
+			const trimmed = line.trim();
+			if (
+				TAG_LIST_PATTERN.test(trimmed) &&
+				(previous === "" ||
+					previous.endsWith(",") ||
+					trimmed.includes(","))
+			) {
+				value = trimmed;
+			}
+		}
+
+		previous = value;
+		for (const tag of (value ?? "").split(",")) {
+			if (tag.trim().length > 0) tags.add(tag.trim());
+		}
+	}
+
+	return Array.from(tags);
+}
+
+/**
+ * 노드에 기록된 특정 어노테이션 값 조회
+ */
+export function getAnnotationValues(node: SemanticNode, name: string): string[] {
+	const annotations = node.metadata.annotations as
+		| Record<string, string[]>
+		| undefined;
+	return annotations?.[name] ?? [];
+}
+
+/**
+ * 노드에 특정 어노테이션이 있는지 확인
+ */
+export function hasAnnotation(node: SemanticNode, name: string): boolean {
+	const annotations = node.metadata.annotations as
+		| Record<string, string[]>
+		| undefined;
+	return annotations !== undefined && name in annotations;
+}
@@ -0,0 +1,195 @@
+/**
+ * API Change Report
+ * 두 분석 결과의 공개 API 변경(추가/삭제/태그/시그니처) 비교
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 공개 API 비교 옵션
+ */
+export interface ApiChangeOptions {
+	/** 공개 API로 볼 태그 (기본: ["public-api"], 빈 배열이면 모든 심볼) */
+	tags?: string[];
+}
+
+/**
+ * 리포트에 기록하는 심볼 정보
+ */
+export interface ApiSymbol {
+	id: string;
+	name: string;
+	kind: string;
+	filePath: string;
+	line?: number;
+	semanticTags: string[];
+	signature?: string;
+}
+
+/**
+ * @semantic-tags가 바뀐 심볼
+ */
+export interface ApiTagChange {
+	id: string;
+	kind: string;
+	before: string[];
+	after: string[];
+	added: string[];
+	removed: string[];
+}
+
+/**
+ * 시그니처(리시버, 매개변수, 반환 타입)가 바뀐 심볼
+ */
+export interface ApiSignatureChange {
+	id: string;
+	kind: string;
+	before: string;
+	after: string;
+}
+
+/**
+ * 공개 API 변경 리포트 (모든 목록은 ID 순, JSON 직렬화 가능)
+ */
+export interface ApiChangeReport {
+	added: ApiSymbol[];
+	removed: ApiSymbol[];
+	tagsChanged: ApiTagChange[];
+	signatureChanged: ApiSignatureChange[];
+}
+
+/**
+ * 두 그래프의 공개 API 비교
+ *
+ * 변경 전이나 후 어느 한쪽에서라도 공개 태그가 붙은 심볼만 비교하므로
+ * public-api 태그를 뗀 심볼도 tagsChanged에 나타난다. 심볼은 ID로
+ * 대응시키며, 이름이 바뀐 심볼은 삭제와 추가로 보고된다. 시그니처는
+ * 양쪽 모두 metadata.signature가 있는 함수/메서드만 비교한다.
+ */
+export function diffApi(
+	before: SemanticGraph,
+	after: SemanticGraph,
+	options: ApiChangeOptions = {},
+): ApiChangeReport {
+	const tags = options.tags ?? ["public-api"];
+	const isPublic = (node: SemanticNode | undefined) =>
+		node !== undefined &&
+		(tags.length === 0 || tags.some((tag) => node.semanticTags.includes(tag)));
+
+	const report: ApiChangeReport = {
+		added: [],
+		removed: [],
+		tagsChanged: [],
+		signatureChanged: [],
+	};
+
+	const ids = new Set([...before.nodes.keys(), ...after.nodes.keys()]);
+	for (const id of Array.from(ids).sort()) {
+		const previous = before.getNode(id);
+		const current = after.getNode(id);
+		if (!isPublic(previous) && !isPublic(current)) continue;
+
+		if (!previous && current) {
+			report.added.push(toApiSymbol(current));
+			continue;
+		}
+		if (previous && !current) {
+			report.removed.push(toApiSymbol(previous));
+			continue;
+		}
+		if (!previous || !current) continue;
+
+		const beforeTags = [...previous.semanticTags].sort();
+		const afterTags = [...current.semanticTags].sort();
+		if (beforeTags.join("\n") !== afterTags.join("\n")) {
+			report.tagsChanged.push({
+				id,
+				kind: current.kind,
+				before: beforeTags,
+				after: afterTags,
+				added: afterTags.filter((tag) => !beforeTags.includes(tag)),
+				removed: beforeTags.filter((tag) => !afterTags.includes(tag)),
+			});
+		}
+
+		const beforeSignature = previous.metadata.signature as string | undefined;
+		const afterSignature = current.metadata.signature as string | undefined;
+		if (
+			beforeSignature !== undefined &&
+			afterSignature !== undefined &&
+			beforeSignature !== afterSignature
+		) {
+			report.signatureChanged.push({
+				id,
+				kind: current.kind,
+				before: beforeSignature,
+				after: afterSignature,
+			});
+		}
+	}
+
+	return report;
+}
+
+/**
+ * API 변경 리포트를 Markdown으로 렌더링 (릴리스 노트, PR 코멘트용)
+ */
+export function renderApiChangeMarkdown(report: ApiChangeReport): string {
+	const lines: string[] = ["## API changes", ""];
+
+	const total =
+		report.added.length +
+		report.removed.length +
+		report.tagsChanged.length +
+		report.signatureChanged.length;
+	if (total === 0) {
+		lines.push("No public API changes.");
+		return `${lines.join("\n")}\n`;
+	}
+
+	const section = (title: string, items: string[]) => {
+		if (items.length === 0) return;
+		lines.push(`### ${title} (${items.length})`, "", ...items, "");
+	};
+
+	section(
+		"Added",
+		report.added.map((symbol) => `- \`${symbol.id}\` (${symbol.kind})`),
+	);
+	section(
+		"Removed",
+		report.removed.map((symbol) => `- \`${symbol.id}\` (${symbol.kind})`),
+	);
+	section(
+		"Tags changed",
+		report.tagsChanged.map((change) => {
+			const parts = [
+				...change.added.map((tag) => `+${tag}`),
+				...change.removed.map((tag) => `-${tag}`),
+			];
+			return `- \`${change.id}\`: ${parts.join(", ")}`;
+		}),
+	);
+	section(
+		"Signature changed",
+		report.signatureChanged.map(
+			(change) =>
+				`- \`${change.id}\`\n  - before: \`${change.before}\`\n  - after: \`${change.after}\``,
+		),
+	);
+
+	return `${lines.join("\n").trimEnd()}\n`;
+}
+
+function toApiSymbol(node: SemanticNode): ApiSymbol {
+	return {
+		id: node.id,
+		name: node.name,
+		kind: node.kind,
+		filePath: node.filePath,
+		line: node.line,
+		semanticTags: [...node.semanticTags],
+		signature: node.metadata.signature as string | undefined,
+	};
+}
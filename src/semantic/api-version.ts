@@ -0,0 +1,73 @@
+/**
+ * API Version Annotations
+ * @api-version 어노테이션 조회 및 버전별 그룹핑
+ */
+
+import { getAnnotationValues } from "./annotations";
+import type { SemanticGraph } from "./SemanticGraph";
+import { getParentIds } from "./tags";
+import type { SemanticNode } from "./types";
+
+/**
+ * 노드에 선언된 API 버전 목록
+ *
+ * `@api-version v1, v2` 또는 여러 줄의 `@api-version`을 모두 합친다.
+ * 자신에게 선언이 없으면 상위 심볼(리시버 타입 등)의 선언을 상속한다.
+ */
+export function getApiVersions(
+	graph: SemanticGraph,
+	node: SemanticNode,
+): string[] {
+	const own = parseVersions(getAnnotationValues(node, "api-version"));
+	if (own.length > 0) {
+		return own;
+	}
+
+	for (const parentId of getParentIds(graph, node)) {
+		const parent = graph.getNode(parentId);
+		if (!parent) continue;
+		const inherited = parseVersions(getAnnotationValues(parent, "api-version"));
+		if (inherited.length > 0) {
+			return inherited;
+		}
+	}
+
+	return [];
+}
+
+/**
+ * API 버전별 심볼 그룹 (버전 이름 순, 각 그룹은 ID 순)
+ */
+export function groupByApiVersion(
+	graph: SemanticGraph,
+): Map<string, SemanticNode[]> {
+	const groups = new Map<string, SemanticNode[]>();
+
+	for (const node of graph.nodes.values()) {
+		for (const version of getApiVersions(graph, node)) {
+			let group = groups.get(version);
+			if (!group) {
+				group = [];
+				groups.set(version, group);
+			}
+			group.push(node);
+		}
+	}
+
+	const sorted = new Map<string, SemanticNode[]>();
+	for (const version of Array.from(groups.keys()).sort()) {
+		const group = groups.get(version) as SemanticNode[];
+		sorted.set(
+			version,
+			group.sort((a, b) => (a.id < b.id ? -1 : a.id > b.id ? 1 : 0)),
+		);
+	}
+	return sorted;
+}
+
+function parseVersions(values: string[]): string[] {
+	const versions = values.flatMap((value) =>
+		value.split(/[\s,]+/).filter((version) => version.length > 0),
+	);
+	return Array.from(new Set(versions));
+}
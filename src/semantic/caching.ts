@@ -0,0 +1,119 @@
+/**
+ * Cache Annotations
+ * @cached 어노테이션을 캐시 정책으로 변환하고 읽기 메서드의 캐시 사용 현황 집계
+ */
+
+import { parseDuration } from "./resilience";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 심볼에 선언된 캐시 정책
+ */
+export interface CachePolicy {
+	/** key= 값 */
+	key?: string;
+	/** ttl= 원본 값 (예: "60s") */
+	ttl?: string;
+	/** ttl 밀리초 값 (해석 가능한 경우) */
+	ttlMs?: number;
+	/** key/ttl 외의 key=value 옵션 */
+	options: Record<string, string>;
+}
+
+/**
+ * 읽기 메서드 판별 설정
+ */
+export interface CacheReportOptions {
+	/** 읽기 메서드로 볼 이름 접두사 */
+	readPrefixes?: string[];
+	/** 읽기 메서드로 볼 태그 */
+	readTags?: string[];
+}
+
+/**
+ * 캐시 사용 현황
+ */
+export interface CacheReport {
+	cached: SemanticNode[];
+	uncached: SemanticNode[];
+}
+
+const DEFAULT_READ_PREFIXES = [
+	"Get",
+	"Find",
+	"List",
+	"Search",
+	"Load",
+	"Fetch",
+	"Count",
+];
+
+/**
+ * 어노테이션에서 캐시 정책 파싱 (@cached가 없으면 undefined)
+ *
+ * 예: `@cached key=user ttl=60s` -> { key: "user", ttl: "60s", ttlMs: 60000 }
+ */
+export function parseCachePolicy(
+	annotations: Record<string, string[]>,
+): CachePolicy | undefined {
+	const values = annotations.cached;
+	if (!values) {
+		return undefined;
+	}
+
+	const policy: CachePolicy = { options: {} };
+	for (const pair of values.join(" ").split(/\s+/)) {
+		const separator = pair.indexOf("=");
+		if (separator <= 0) continue;
+
+		const name = pair.slice(0, separator);
+		const value = pair.slice(separator + 1);
+		if (name === "key") {
+			policy.key = value;
+		} else if (name === "ttl") {
+			policy.ttl = value;
+			policy.ttlMs = parseDuration(value);
+		} else {
+			policy.options[name] = value;
+		}
+	}
+
+	return policy;
+}
+
+/**
+ * 노드의 캐시 정책 조회 (metadata.cache)
+ */
+export function getCachePolicy(node: SemanticNode): CachePolicy | undefined {
+	return node.metadata.cache as CachePolicy | undefined;
+}
+
+/**
+ * 읽기 메서드를 캐시 사용 여부로 분류 (각 목록은 ID 순)
+ */
+export function reportCacheUsage(
+	graph: SemanticGraph,
+	options: CacheReportOptions = {},
+): CacheReport {
+	const prefixes = options.readPrefixes ?? DEFAULT_READ_PREFIXES;
+	const readTags = options.readTags ?? ["read"];
+	const report: CacheReport = { cached: [], uncached: [] };
+
+	for (const node of graph.nodes.values()) {
+		if (node.kind !== "method" && node.kind !== "function") continue;
+
+		const isRead =
+			prefixes.some((prefix) => node.name.startsWith(prefix)) ||
+			readTags.some((tag) => node.semanticTags.includes(tag));
+		if (!isRead) continue;
+
+		(getCachePolicy(node) ? report.cached : report.uncached).push(node);
+	}
+
+	const byId = (a: SemanticNode, b: SemanticNode) =>
+		a.id < b.id ? -1 : a.id > b.id ? 1 : 0;
+	report.cached.sort(byId);
+	report.uncached.sort(byId);
+	return report;
+}
@@ -0,0 +1,46 @@
+/**
+ * Ambiguous Reference Check
+ * 여러 파일에 같은 ID로 선언된 심볼을 가리키는 참조 검사
+ */
+
+import type { SemanticGraph } from "../SemanticGraph";
+import type { AmbiguousReference, SemanticDiagnostic } from "../types";
+
+/**
+ * 모호한 참조 보고 (file-scoped 충돌 정책이 기록한 metadata.ambiguousReferences)
+ *
+ * 분석기는 이런 참조를 임의의 후보에 연결하지 않으므로, 진단의
+ * metadata.candidates에 후보 노드 ID를 모두 담는다.
+ */
+export function checkAmbiguousReferences(
+	graph: SemanticGraph,
+): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const references =
+			(node.metadata.ambiguousReferences as
+				| AmbiguousReference[]
+				| undefined) ?? [];
+
+		for (const reference of references) {
+			const files = reference.candidates.map(
+				(id) => graph.getNode(id)?.filePath ?? id,
+			);
+			diagnostics.push({
+				ruleId: "ambiguous-reference",
+				severity: "warning",
+				message: `${node.fqn} references ${reference.target}, which is declared in ${files.join(", ")}`,
+				nodeId: node.id,
+				filePath: node.filePath,
+				line: reference.line ?? node.line,
+				metadata: {
+					target: reference.target,
+					candidates: reference.candidates,
+				},
+			});
+		}
+	}
+
+	return diagnostics;
+}
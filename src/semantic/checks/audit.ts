@@ -0,0 +1,88 @@
+/**
+ * Audit Requirement Check
+ * @audit-required 메서드가 감사 로거에 도달하는지 검사
+ */
+
+import { hasAnnotation } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { CallSite, SemanticDiagnostic, SemanticNode } from "../types";
+
+/**
+ * 감사 로거 설정
+ */
+export interface AuditCheckOptions {
+	/** 감사 로깅 호출 대상 또는 노드 ID (기본: ["audit.Log", "audit.Record"]) */
+	auditFunctions?: string[];
+	/** 감사 로거로 보는 시맨틱 태그 (기본: ["audit-logger"]) */
+	auditTags?: string[];
+}
+
+/**
+ * @audit-required 어노테이션이 있는지 확인
+ */
+export function isAuditRequired(node: SemanticNode): boolean {
+	return hasAnnotation(node, "audit-required");
+}
+
+/**
+ * 감사 로거에 도달하지 않는 @audit-required 심볼 탐지
+ *
+ * 심볼 자신과 calls 엣지로 전이적으로 호출하는 함수들 중 하나라도
+ * 감사 함수를 호출하거나 감사 로거 자체이면 통과한다.
+ */
+export function checkAuditRequirements(
+	graph: SemanticGraph,
+	options: AuditCheckOptions = {},
+): SemanticDiagnostic[] {
+	const auditFunctions = options.auditFunctions ?? [
+		"audit.Log",
+		"audit.Record",
+	];
+	const auditTags = options.auditTags ?? ["audit-logger"];
+	const isAuditLogger = (node: SemanticNode) =>
+		auditFunctions.includes(node.id) ||
+		auditTags.some((tag) => node.semanticTags.includes(tag));
+	const callsAudit = (node: SemanticNode) =>
+		((node.metadata.callSites as CallSite[] | undefined) ?? []).some((site) =>
+			auditFunctions.includes(site.callee),
+		);
+
+	const diagnostics: SemanticDiagnostic[] = [];
+	for (const node of graph.nodes.values()) {
+		if (!isAuditRequired(node)) continue;
+
+		const visited = new Set([node.id]);
+		const queue = [node];
+		let audited = false;
+		while (queue.length > 0 && !audited) {
+			const current = queue.shift() as SemanticNode;
+			if (callsAudit(current)) {
+				audited = true;
+				break;
+			}
+
+			for (const edge of graph.getOutgoingEdges(current.id, ["calls"])) {
+				const callee = graph.getNode(edge.to);
+				if (!callee || visited.has(callee.id)) continue;
+				if (isAuditLogger(callee)) {
+					audited = true;
+					break;
+				}
+				visited.add(callee.id);
+				queue.push(callee);
+			}
+		}
+		if (audited) continue;
+
+		diagnostics.push({
+			ruleId: "audit-required",
+			severity: "error",
+			message: `${node.fqn} is @audit-required but never reaches an audit logger`,
+			nodeId: node.id,
+			filePath: node.filePath,
+			line: node.line,
+		});
+	}
+
+	return diagnostics;
+}
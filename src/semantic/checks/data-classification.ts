@@ -0,0 +1,136 @@
+/**
+ * Data Classification Flow Check
+ * 민감 등급으로 분류된 타입의 값이 로그나 외부 HTTP 호출로 전달되는지 검사
+ */
+
+import {
+	DEFAULT_SENSITIVE_CLASSIFICATIONS,
+	getClassification,
+} from "../classification";
+import { getGoPackageId } from "../extractors/GoExtractor";
+import type { SemanticGraph } from "../SemanticGraph";
+import {
+	DEFAULT_HTTP_CLIENT_CONFIG,
+	type HttpClientConfig,
+} from "../service-calls";
+import type { CallSite, SemanticDiagnostic, SemanticNode } from "../types";
+import {
+	DEFAULT_LOGGERS,
+	type LoggerConfig,
+	resolveLogLevel,
+} from "./log-statements";
+
+/**
+ * 데이터 분류 흐름 검사 옵션
+ */
+export interface DataClassificationOptions {
+	/** 싱크로 흘러가면 안 되는 분류 등급 (기본: confidential, restricted) */
+	classifications?: string[];
+	/** 로그 싱크로 보는 로거 */
+	loggers?: LoggerConfig;
+	/** 외부 호출 싱크로 보는 HTTP 클라이언트 */
+	httpClients?: HttpClientConfig;
+}
+
+/**
+ * 민감 타입 매개변수가 로그/HTTP 호출 인자로 전달되는 위치 탐지 (휴리스틱)
+ *
+ * 함수 매개변수의 선언 타입만 추적하며, 인자가 매개변수 자체이거나
+ * 그 필드/주소(`u`, `u.Email`, `&u`)일 때 흐름으로 본다.
+ * 지역 변수 대입이나 다른 함수를 거친 흐름은 추적하지 않는다.
+ */
+export function checkClassifiedDataFlows(
+	graph: SemanticGraph,
+	options: DataClassificationOptions = {},
+): SemanticDiagnostic[] {
+	const sensitive = new Set(
+		options.classifications ?? DEFAULT_SENSITIVE_CLASSIFICATIONS,
+	);
+	const loggers = options.loggers ?? DEFAULT_LOGGERS;
+	const httpClients = options.httpClients ?? DEFAULT_HTTP_CLIENT_CONFIG;
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const classified = findClassifiedParameters(graph, node, sensitive);
+		if (classified.size === 0) continue;
+
+		const callSites = (node.metadata.callSites as CallSite[] | undefined) ?? [];
+		for (const site of callSites) {
+			const sink = resolveSink(site.callee, loggers, httpClients);
+			if (!sink) continue;
+
+			for (const argument of site.arguments) {
+				const root = argument.match(/^[&*]?([A-Za-z_]\w*)/)?.[1];
+				const type = root ? classified.get(root) : undefined;
+				if (!type) continue;
+
+				const classification = getClassification(type);
+				diagnostics.push({
+					ruleId: "data-classification",
+					severity: "error",
+					message: `${node.fqn} passes ${classification} ${type.fqn} to ${sink} sink ${site.callee}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: site.line,
+					metadata: {
+						sink,
+						callee: site.callee,
+						argument,
+						type: type.id,
+						classification,
+					},
+				});
+			}
+		}
+	}
+
+	return diagnostics;
+}
+
+/**
+ * 호출 대상의 싱크 종류 (싱크가 아니면 undefined)
+ */
+function resolveSink(
+	callee: string,
+	loggers: LoggerConfig,
+	httpClients: HttpClientConfig,
+): "log" | "http" | undefined {
+	if (resolveLogLevel(callee, loggers)) {
+		return "log";
+	}
+	if (Object.prototype.hasOwnProperty.call(httpClients.functions, callee)) {
+		return "http";
+	}
+	return undefined;
+}
+
+/**
+ * 민감 등급 타입으로 선언된 매개변수 (매개변수 이름 → 타입 노드)
+ */
+function findClassifiedParameters(
+	graph: SemanticGraph,
+	node: SemanticNode,
+	sensitive: Set<string>,
+): Map<string, SemanticNode> {
+	const parameters =
+		(node.metadata.parameters as
+			| Array<{ name: string; type: string }>
+			| undefined) ?? [];
+	const classified = new Map<string, SemanticNode>();
+
+	for (const parameter of parameters) {
+		if (!parameter.name) continue;
+
+		const typeName = parameter.type.replace(/^(\.\.\.|\*|\[\])+/, "");
+		const id = typeName.includes(".")
+			? typeName
+			: `${getGoPackageId(node)}.${typeName}`;
+		const type = graph.getNode(id);
+		const classification = type ? getClassification(type) : undefined;
+		if (type && classification && sensitive.has(classification)) {
+			classified.set(parameter.name, type);
+		}
+	}
+
+	return classified;
+}
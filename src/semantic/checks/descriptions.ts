@@ -0,0 +1,43 @@
+/**
+ * Description Check
+ * 공개 API 심볼의 @description 누락 검사
+ */
+
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 설명 검사 옵션
+ */
+export interface DescriptionCheckOptions {
+	/** @description을 요구하는 태그 (기본: ["public-api"]) */
+	tags?: string[];
+}
+
+/**
+ * 요구 태그가 붙은 심볼 중 @description이 없거나 비어 있는 심볼 탐지
+ */
+export function checkDescriptions(
+	graph: SemanticGraph,
+	options: DescriptionCheckOptions = {},
+): SemanticDiagnostic[] {
+	const tags = options.tags ?? ["public-api"];
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const tag = tags.find((candidate) => node.semanticTags.includes(candidate));
+		if (!tag || node.description?.trim()) continue;
+
+		diagnostics.push({
+			ruleId: "missing-description",
+			severity: "error",
+			message: `${node.fqn} is tagged ${tag} but has no @description`,
+			nodeId: node.id,
+			filePath: node.filePath,
+			line: node.line,
+			metadata: { symbol: node.name, tag },
+		});
+	}
+
+	return diagnostics;
+}
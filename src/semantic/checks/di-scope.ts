@@ -0,0 +1,69 @@
+/**
+ * DI Scope Check
+ * 오래 사는 프로바이더가 더 짧은 스코프의 프로바이더에 의존하는지 검사
+ */
+
+import { DI_SCOPE_LIFETIMES, getScope } from "../di-scopes";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/** 프로바이더 의존으로 보는 엣지 타입 */
+const DEPENDENCY_EDGE_TYPES = ["calls", "depends_on"];
+
+/**
+ * 잘못된 스코프 의존 탐지
+ *
+ * 예: singleton 프로바이더가 request 스코프 프로바이더를 호출하면 첫 요청의
+ * 인스턴스가 싱글톤에 붙잡혀 이후 요청에서도 재사용된다.
+ * transient 의존은 허용하며, 알 수 없는 스코프 값은 warning으로 보고한다.
+ */
+export function checkDIScopes(graph: SemanticGraph): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const scope = getScope(node);
+		if (scope === undefined) continue;
+
+		const lifetime = DI_SCOPE_LIFETIMES[scope];
+		if (lifetime === undefined) {
+			diagnostics.push({
+				ruleId: "di-scope",
+				severity: "warning",
+				message: `${node.fqn} declares unknown scope: ${scope}`,
+				nodeId: node.id,
+				filePath: node.filePath,
+				line: node.line,
+				metadata: { scope },
+			});
+			continue;
+		}
+
+		const edges = graph.getOutgoingEdges(node.id, DEPENDENCY_EDGE_TYPES);
+		for (const edge of edges) {
+			const dependency = graph.getNode(edge.to);
+			const dependencyScope = dependency ? getScope(dependency) : undefined;
+			if (!dependency || dependencyScope === undefined) continue;
+
+			const dependencyLifetime = DI_SCOPE_LIFETIMES[dependencyScope];
+			if (
+				dependencyLifetime === undefined ||
+				dependencyScope === "transient" ||
+				dependencyLifetime >= lifetime
+			) {
+				continue;
+			}
+
+			diagnostics.push({
+				ruleId: "di-scope",
+				severity: "error",
+				message: `${scope} ${node.fqn} depends on ${dependencyScope}-scoped ${dependency.fqn}`,
+				nodeId: node.id,
+				filePath: node.filePath,
+				line: (edge.metadata?.line as number | undefined) ?? node.line,
+				metadata: { scope, dependency: dependency.id, dependencyScope },
+			});
+		}
+	}
+
+	return diagnostics;
+}
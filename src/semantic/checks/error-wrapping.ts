@@ -0,0 +1,50 @@
+/**
+ * Error Wrapping Check
+ * 지정한 태그의 함수가 원인 에러를 감싸지 않고 반환하는지 검사
+ */
+
+import { getErrorHandling } from "../error-handling";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 에러 감싸기 검사 옵션
+ */
+export interface ErrorWrappingOptions {
+	/** 에러를 감싸야 하는 심볼의 태그 (기본: public-api) */
+	tag?: string;
+}
+
+/**
+ * 태그가 붙은 함수/메서드 중 에러를 그대로 반환하는 함수 탐지
+ *
+ * 심볼에 직접 붙은 태그만 본다 (패키지 태그를 상속한 모든 함수를
+ * 보고하지 않도록). 기존 코드 대부분이 에러를 그대로 반환하므로
+ * DEFAULT_CHECKS에는 포함하지 않는 선택 검사이다.
+ */
+export function checkErrorWrapping(
+	graph: SemanticGraph,
+	options: ErrorWrappingOptions = {},
+): SemanticDiagnostic[] {
+	const tag = options.tag ?? "public-api";
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const metrics = getErrorHandling(node);
+		if (!metrics || metrics.bare === 0 || !node.semanticTags.includes(tag)) {
+			continue;
+		}
+
+		diagnostics.push({
+			ruleId: "unwrapped-error",
+			severity: "warning",
+			message: `${node.fqn} is ${tag} but returns ${metrics.bare} unwrapped error(s); wrap them with %w`,
+			nodeId: node.id,
+			filePath: node.filePath,
+			line: node.line,
+			metadata: { ...metrics },
+		});
+	}
+
+	return diagnostics;
+}
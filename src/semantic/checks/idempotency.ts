@@ -0,0 +1,47 @@
+/**
+ * Idempotency Check
+ * @idempotent 메서드가 재실행 시 중복 행을 만드는 INSERT를 수행하는지 검사
+ */
+
+import { hasAnnotation } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import { extractSqlStatements } from "../sql";
+import type { SemanticDiagnostic, SemanticNode } from "../types";
+
+/**
+ * @idempotent 어노테이션이 있는지 확인
+ */
+export function isIdempotent(node: SemanticNode): boolean {
+	return hasAnnotation(node, "idempotent");
+}
+
+/**
+ * upsert 없이 INSERT를 수행하는 @idempotent 메서드 탐지 (휴리스틱)
+ *
+ * 함수 본문에서 직접 전달한 SQL 문자열만 검사하며, 호출한 다른 함수의
+ * SQL은 따라가지 않는다.
+ */
+export function checkIdempotency(graph: SemanticGraph): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		if (!isIdempotent(node)) continue;
+
+		for (const statement of extractSqlStatements(graph, node)) {
+			if (statement.verb !== "INSERT" || statement.upsert) continue;
+
+			const target = statement.table ? ` into ${statement.table}` : "";
+			diagnostics.push({
+				ruleId: "idempotency",
+				severity: "warning",
+				message: `${node.fqn} is @idempotent but performs a plain INSERT${target}`,
+				nodeId: node.id,
+				filePath: node.filePath,
+				line: statement.line,
+				metadata: { table: statement.table, callee: statement.callee },
+			});
+		}
+	}
+
+	return diagnostics;
+}
@@ -0,0 +1,136 @@
+/**
+ * Layering Check
+ * 태그로 선언한 계층 간 의존 방향 위반 검사
+ */
+
+import { matchesGlob } from "../glob";
+import { isDependencyEdge } from "../impact";
+import { RuleEngine, type TagRule } from "../rule-engine";
+import type { SemanticGraph } from "../SemanticGraph";
+import { getEffectiveTags } from "../tags";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 계층 의존 규칙 (from 태그 심볼이 to 태그 심볼에 의존해도 되는지)
+ */
+export interface LayerRule {
+	/** 의존하는 쪽 태그 패턴 (glob, 예: "handler", "*-struct", "*") */
+	from: string;
+	/** 의존 대상 태그 패턴 */
+	to: string;
+	allow: boolean;
+}
+
+/**
+ * 계층 검사 설정
+ */
+export interface LayeringConfig {
+	/** 위에서부터 처음 일치하는 규칙을 적용 */
+	rules: LayerRule[];
+	/** 일치하는 규칙이 없는 태그 쌍 처리 (기본: "allow") */
+	defaultPolicy?: "allow" | "deny";
+	/** 계층으로 보는 태그 패턴 (기본: 모든 태그) */
+	layers?: string[];
+	/** 같은 계층 태그끼리의 의존 허용 (기본: true) */
+	allowSameLayer?: boolean;
+	/** 검사할 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+}
+
+/**
+ * 계층 규칙을 어긴 의존 엣지 탐지
+ *
+ * 양쪽 심볼의 유효 태그(상위 심볼에서 상속된 태그 포함) 중 계층 태그의
+ * 모든 쌍을 규칙으로 판정하며, 한 쌍이라도 거부되면 엣지 하나에 진단
+ * 하나를 만든다. 계층 태그가 없는 심볼이 낀 엣지는 검사하지 않는다.
+ */
+export function checkLayering(
+	graph: SemanticGraph,
+	config: LayeringConfig,
+): SemanticDiagnostic[] {
+	return new RuleEngine([createLayeringRule(config)]).run(graph);
+}
+
+/**
+ * 계층 규칙 생성 (RuleEngine 등록용)
+ */
+export function createLayeringRule(config: LayeringConfig): TagRule {
+	const follows = (type: string) =>
+		config.edgeTypes
+			? config.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+	const isLayer = (tag: string) =>
+		!config.layers ||
+		config.layers.some((pattern) => matchesGlob(tag, pattern));
+	const layerTags = (tags: Map<string, string>) =>
+		Array.from(tags.keys()).filter(isLayer);
+
+	return {
+		id: "layering",
+		check(node, { graph, tags }) {
+			const fromTags = layerTags(tags);
+			if (fromTags.length === 0) return [];
+
+			const diagnostics: SemanticDiagnostic[] = [];
+			for (const edge of graph.getOutgoingEdges(node.id)) {
+				const target = graph.getNode(edge.to);
+				if (!target || target.id === node.id || !follows(edge.type)) {
+					continue;
+				}
+
+				const denied = findDeniedPair(
+					config,
+					fromTags,
+					layerTags(getEffectiveTags(graph, target)),
+				);
+				if (!denied) continue;
+
+				diagnostics.push({
+					ruleId: "layering",
+					severity: "error",
+					message: `${node.fqn} (${denied.fromTag}) must not depend on ${target.fqn} (${denied.toTag}) via ${edge.type}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: edge.metadata?.line ?? node.line,
+					metadata: {
+						from: node.id,
+						to: target.id,
+						edgeType: edge.type,
+						fromTag: denied.fromTag,
+						toTag: denied.toTag,
+						rule: denied.rule,
+					},
+				});
+			}
+			return diagnostics;
+		},
+	};
+}
+
+/**
+ * 거부되는 첫 태그 쌍 (rule은 일치한 규칙, 기본 정책이면 undefined)
+ */
+function findDeniedPair(
+	config: LayeringConfig,
+	fromTags: string[],
+	toTags: string[],
+): { fromTag: string; toTag: string; rule?: LayerRule } | undefined {
+	for (const fromTag of fromTags) {
+		for (const toTag of toTags) {
+			if (fromTag === toTag && config.allowSameLayer !== false) continue;
+
+			const rule = config.rules.find(
+				(candidate) =>
+					matchesGlob(fromTag, candidate.from) &&
+					matchesGlob(toTag, candidate.to),
+			);
+			const allowed = rule
+				? rule.allow
+				: (config.defaultPolicy ?? "allow") === "allow";
+			if (!allowed) {
+				return { fromTag, toTag, rule };
+			}
+		}
+	}
+	return undefined;
+}
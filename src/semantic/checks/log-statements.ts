@@ -0,0 +1,114 @@
+/**
+ * Log Statement Check
+ * 로깅 호출 위치와 로그 레벨 수집
+ */
+
+import type { SemanticGraph } from "../SemanticGraph";
+import type { CallSite, SemanticDiagnostic, SemanticNode } from "../types";
+
+export type LogLevel = "debug" | "info" | "warn" | "error" | "fatal" | "panic";
+
+/**
+ * 로거 함수 -> 로그 레벨 매핑
+ *
+ * 키는 호출 대상 원본 텍스트(예: "slog.Error")이며,
+ * "*.Error"처럼 리시버를 "*"로 두면 모든 리시버의 같은 메서드와 일치한다.
+ */
+export type LoggerConfig = Record<string, LogLevel>;
+
+/** 표준 라이브러리 log / log/slog 기본 매핑 */
+export const DEFAULT_LOGGERS: LoggerConfig = {
+	"slog.Debug": "debug",
+	"slog.DebugContext": "debug",
+	"slog.Info": "info",
+	"slog.InfoContext": "info",
+	"slog.Warn": "warn",
+	"slog.WarnContext": "warn",
+	"slog.Error": "error",
+	"slog.ErrorContext": "error",
+	"log.Print": "info",
+	"log.Printf": "info",
+	"log.Println": "info",
+	"log.Fatal": "fatal",
+	"log.Fatalf": "fatal",
+	"log.Fatalln": "fatal",
+	"log.Panic": "panic",
+	"log.Panicf": "panic",
+	"log.Panicln": "panic",
+};
+
+/**
+ * 함수 안의 로깅 호출
+ */
+export interface LogCall {
+	callee: string;
+	level: LogLevel;
+	line: number;
+}
+
+/**
+ * 노드의 호출 위치(metadata.callSites) 중 로깅 호출 찾기
+ */
+export function findLogCalls(
+	node: SemanticNode,
+	loggers: LoggerConfig = DEFAULT_LOGGERS,
+): LogCall[] {
+	const callSites = (node.metadata.callSites as CallSite[] | undefined) ?? [];
+	const calls: LogCall[] = [];
+
+	for (const site of callSites) {
+		const level = resolveLogLevel(site.callee, loggers);
+		if (level) {
+			calls.push({ callee: site.callee, level, line: site.line });
+		}
+	}
+
+	return calls;
+}
+
+/**
+ * 로깅 호출마다 "logs" 진단 생성
+ *
+ * 진단은 로그를 남기는 함수(nodeId)와 호출 라인을 가리키며,
+ * metadata에 로그 레벨과 호출 대상을 기록한다.
+ */
+export function checkLogStatements(
+	graph: SemanticGraph,
+	loggers: LoggerConfig = DEFAULT_LOGGERS,
+): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		for (const call of findLogCalls(node, loggers)) {
+			diagnostics.push({
+				ruleId: "logs",
+				severity: "info",
+				message: `${node.fqn} logs at ${call.level} level via ${call.callee}`,
+				nodeId: node.id,
+				filePath: node.filePath,
+				line: call.line,
+				metadata: { level: call.level, callee: call.callee },
+			});
+		}
+	}
+
+	return diagnostics;
+}
+
+/**
+ * 호출 대상의 로그 레벨 (로깅 호출이 아니면 undefined)
+ */
+export function resolveLogLevel(
+	callee: string,
+	loggers: LoggerConfig,
+): LogLevel | undefined {
+	if (loggers[callee]) {
+		return loggers[callee];
+	}
+
+	const dot = callee.lastIndexOf(".");
+	if (dot === -1) {
+		return undefined;
+	}
+	return loggers[`*${callee.slice(dot)}`];
+}
@@ -0,0 +1,60 @@
+/**
+ * Panic Flow Check
+ * panic/recover 사용 흐름 검사
+ */
+
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic, SemanticNode } from "../types";
+
+/**
+ * recover 없이 panic할 수 있는 함수 탐지
+ *
+ * recover는 호출 스택 위쪽에서만 효과가 있으므로, 함수 자신과
+ * calls 엣지를 거슬러 도달 가능한 모든 호출자 중 recover하는 함수가
+ * 하나도 없을 때 "panics" 진단을 보고한다.
+ */
+export function checkPanicFlows(graph: SemanticGraph): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		if (!node.metadata.panics) continue;
+		if (hasRecoveringCaller(graph, node)) continue;
+
+		diagnostics.push({
+			ruleId: "panics",
+			severity: "warning",
+			message: `${node.fqn} can panic without a recover in any of its callers`,
+			nodeId: node.id,
+			filePath: node.filePath,
+			line: node.line,
+		});
+	}
+
+	return diagnostics;
+}
+
+/**
+ * 함수 자신 또는 전이적 호출자 중 recover하는 함수가 있는지 확인
+ */
+function hasRecoveringCaller(
+	graph: SemanticGraph,
+	start: SemanticNode,
+): boolean {
+	const visited = new Set<string>([start.id]);
+	const queue = [start.id];
+
+	while (queue.length > 0) {
+		const id = queue.shift() as string;
+		if (graph.getNode(id)?.metadata.recovers) {
+			return true;
+		}
+		for (const edge of graph.getIncomingEdges(id, ["calls"])) {
+			if (!visited.has(edge.from)) {
+				visited.add(edge.from);
+				queue.push(edge.from);
+			}
+		}
+	}
+
+	return false;
+}
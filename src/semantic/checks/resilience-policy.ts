@@ -0,0 +1,50 @@
+/**
+ * Resilience Policy Check
+ * 복원력 정책(@retry/@timeout)이 없는 메서드 보고
+ */
+
+import { getResiliencePolicy } from "../resilience";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 정책 검사 대상 설정
+ */
+export interface ResiliencePolicyCheckOptions {
+	/** 검사할 노드 종류 (기본: ["method"]) */
+	kinds?: string[];
+	/** 지정 시 이 태그 중 하나를 가진 노드만 검사 */
+	tags?: string[];
+}
+
+/**
+ * 복원력 정책이 하나도 선언되지 않은 메서드 보고
+ */
+export function checkResiliencePolicies(
+	graph: SemanticGraph,
+	options: ResiliencePolicyCheckOptions = {},
+): SemanticDiagnostic[] {
+	const kinds = options.kinds ?? ["method"];
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		if (!kinds.includes(node.kind)) continue;
+		if (getResiliencePolicy(node)) continue;
+
+		const tagged =
+			!options.tags ||
+			options.tags.some((tag) => node.semanticTags.includes(tag));
+		if (!tagged) continue;
+
+		diagnostics.push({
+			ruleId: "missing-resilience-policy",
+			severity: "info",
+			message: `${node.fqn} declares no @retry or @timeout policy`,
+			nodeId: node.id,
+			filePath: node.filePath,
+			line: node.line,
+		});
+	}
+
+	return diagnostics;
+}
@@ -0,0 +1,87 @@
+/**
+ * Resource Ownership Check
+ * @owns 함수가 반환한 자원을 호출자가 닫지 않는지 검사
+ */
+
+import { hasAnnotation } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { CallSite, SemanticDiagnostic, SemanticNode } from "../types";
+
+/**
+ * 자원 소유권 검사 옵션
+ */
+export interface ResourceOwnershipOptions {
+	/** 자원을 정리하는 메서드 이름 (기본: ["Close"]) */
+	closeMethods?: string[];
+}
+
+/**
+ * 반환값의 소유권을 호출자에게 넘기는지 확인 (@owns)
+ */
+export function transfersOwnership(node: SemanticNode): boolean {
+	return hasAnnotation(node, "owns");
+}
+
+/**
+ * @owns 함수가 반환한 자원을 정리하지 않는 호출자 탐지 (휴리스틱)
+ *
+ * 다음 중 하나면 자원을 처리한 것으로 본다.
+ * - 반환값을 대입한 변수의 정리 메서드를 호출(defer 포함)
+ * - 변수를 다른 호출의 인자로 넘김 (소유권 이전)
+ * - 호출 결과를 그대로 return 하거나 호출자 자신이 @owns
+ * 반환값을 버리거나 `_`에 대입하면 항상 보고한다.
+ */
+export function checkResourceOwnership(
+	graph: SemanticGraph,
+	options: ResourceOwnershipOptions = {},
+): SemanticDiagnostic[] {
+	const closeMethods = options.closeMethods ?? ["Close"];
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const caller of graph.nodes.values()) {
+		if (transfersOwnership(caller)) continue;
+		const callSites =
+			(caller.metadata.callSites as CallSite[] | undefined) ?? [];
+
+		for (const edge of graph.getOutgoingEdges(caller.id, ["calls"])) {
+			const callee = graph.getNode(edge.to);
+			if (!callee || !transfersOwnership(callee)) continue;
+
+			const site = callSites.find(
+				(candidate) =>
+					candidate.line === edge.metadata?.line &&
+					(candidate.callee === callee.name ||
+						candidate.callee.endsWith(`.${callee.name}`)),
+			);
+			if (!site || site.returned) continue;
+
+			const resource = site.assignedTo?.[0];
+			const discarded = resource === undefined || resource === "_";
+			const handled =
+				!discarded &&
+				callSites.some(
+					(other) =>
+						closeMethods.some(
+							(method) => other.callee === `${resource}.${method}`,
+						) ||
+						(other !== site && other.arguments.includes(resource as string)),
+				);
+			if (handled) continue;
+
+			const reason = discarded ? "discarded" : "not-closed";
+			diagnostics.push({
+				ruleId: "resource-ownership",
+				severity: "warning",
+				message: discarded
+					? `${caller.fqn} discards the owned resource returned by ${callee.fqn}`
+					: `${caller.fqn} never closes ${resource} returned by ${callee.fqn}`,
+				nodeId: caller.id,
+				filePath: caller.filePath,
+				line: site.line,
+				metadata: { callee: callee.id, reason },
+			});
+		}
+	}
+
+	return diagnostics;
+}
@@ -0,0 +1,77 @@
+/**
+ * Check Runner
+ * 기본 그래프 검사를 한 번에 실행
+ */
+
+import { applySuppressions } from "../diagnostics";
+import { RuleEngine, type TagRule } from "../rule-engine";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+import { checkAmbiguousReferences } from "./ambiguous-references";
+import { checkAuditRequirements } from "./audit";
+import { checkClassifiedDataFlows } from "./data-classification";
+import { checkDescriptions } from "./descriptions";
+import { checkDIScopes } from "./di-scope";
+import { checkIdempotency } from "./idempotency";
+import { checkPanicFlows } from "./panic-flow";
+import { checkResourceOwnership } from "./resource-ownership";
+import { checkSkippedFiles } from "./skipped-files";
+import { checkSLAConsistency } from "./sla-consistency";
+import { createTagCombinationRule } from "./tag-combinations";
+import { createTagExclusivityRule } from "./tag-exclusivity";
+import { createTagKindRule } from "./tag-kinds";
+import { checkTransactionBoundaries } from "./transaction-boundary";
+import { checkUniqueTags } from "./unique-tags";
+import { checkVersionConsistency } from "./version-consistency";
+
+export type SemanticCheck = (graph: SemanticGraph) => SemanticDiagnostic[];
+
+/**
+ * 기본 검사 목록 (검사 이름 -> 검사 함수)
+ *
+ * 정보성 리포트(logs, missing-resilience-policy)는 위반이 아니므로 포함하지 않는다.
+ */
+export const DEFAULT_CHECKS: Record<string, SemanticCheck> = {
+	panics: (graph) => checkPanicFlows(graph),
+	"transaction-boundary": (graph) => checkTransactionBoundaries(graph),
+	"api-version": (graph) => checkVersionConsistency(graph),
+	"di-scope": (graph) => checkDIScopes(graph),
+	idempotency: (graph) => checkIdempotency(graph),
+	"data-classification": (graph) => checkClassifiedDataFlows(graph),
+	"sla-consistency": (graph) => checkSLAConsistency(graph),
+	"audit-required": (graph) => checkAuditRequirements(graph),
+	"resource-ownership": (graph) => checkResourceOwnership(graph),
+	"missing-description": (graph) => checkDescriptions(graph),
+	"unique-tag": (graph) => checkUniqueTags(graph),
+	"ambiguous-reference": (graph) => checkAmbiguousReferences(graph),
+	"skipped-file": (graph) => checkSkippedFiles(graph),
+};
+
+/**
+ * 기본 태그 규칙 (규칙 ID -> 규칙)
+ *
+ * runChecks가 하나의 RuleEngine에 등록해 그래프를 한 번만 순회한다.
+ */
+export const DEFAULT_TAG_RULES: Record<string, TagRule> = {
+	"tag-exclusivity": createTagExclusivityRule(),
+	"tag-combination": createTagCombinationRule(),
+	"tag-kind": createTagKindRule(),
+};
+
+/**
+ * 검사 실행 후 @suppress 적용
+ *
+ * 태그 규칙은 심볼 순회 한 번으로 모두 실행하고, 그 뒤에 검사 함수를
+ * 차례로 실행한다.
+ */
+export function runChecks(
+	graph: SemanticGraph,
+	checks: Record<string, SemanticCheck> = DEFAULT_CHECKS,
+	tagRules: Record<string, TagRule> = DEFAULT_TAG_RULES,
+): SemanticDiagnostic[] {
+	const diagnostics = [
+		...new RuleEngine(Object.values(tagRules)).run(graph),
+		...Object.values(checks).flatMap((check) => check(graph)),
+	];
+	return applySuppressions(graph, diagnostics);
+}
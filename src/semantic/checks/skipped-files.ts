@@ -0,0 +1,35 @@
+/**
+ * Skipped File Check
+ * 크기/파싱 시간 제한으로 분석하지 못한 파일 보고
+ */
+
+import type { FileSkip } from "../extractors/LanguageExtractor";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 건너뛴 파일마다 "skipped-file" 진단 생성 (metadata.skipped)
+ *
+ * 건너뛴 파일의 심볼과 관계는 그래프에 없으므로 다른 검사 결과가
+ * 불완전할 수 있음을 알린다. 진단 metadata에 건너뛴 이유를 기록한다.
+ */
+export function checkSkippedFiles(graph: SemanticGraph): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const skipped = node.metadata.skipped as FileSkip | undefined;
+		if (node.kind !== "file" || !skipped) continue;
+
+		diagnostics.push({
+			ruleId: "skipped-file",
+			severity: "warning",
+			message: `${node.filePath} was not analyzed: ${skipped.message}`,
+			nodeId: node.id,
+			filePath: node.filePath,
+			line: node.line,
+			metadata: { reason: skipped.reason },
+		});
+	}
+
+	return diagnostics;
+}
@@ -0,0 +1,93 @@
+/**
+ * SLA Consistency Check
+ * 높은 SLA 등급의 심볼이 더 낮은 등급의 심볼에 의존하는지 검사
+ */
+
+import type { SemanticGraph } from "../SemanticGraph";
+import { DEFAULT_SLA_TIERS, getSlaPolicy } from "../sla";
+import type { SemanticDiagnostic } from "../types";
+
+/** SLA 기대치가 전파되는 의존 엣지 타입 */
+const DEPENDENCY_EDGE_TYPES = ["calls", "depends_on"];
+
+/**
+ * SLA 일관성 검사 옵션
+ */
+export interface SlaConsistencyOptions {
+	/** SLA 등급 (낮은 등급 → 높은 등급 순) */
+	tiers?: string[];
+}
+
+/**
+ * 자신보다 낮은 SLA 등급에 의존하는 심볼 탐지
+ *
+ * 등급이 없는 중간 심볼을 거쳐 전이적으로 의존하는 경우도 찾으며,
+ * 등급이 선언된 심볼에 도달하면 그 너머는 그 심볼의 검사에 맡긴다.
+ * 알 수 없는 등급은 warning으로 보고한다.
+ */
+export function checkSLAConsistency(
+	graph: SemanticGraph,
+	options: SlaConsistencyOptions = {},
+): SemanticDiagnostic[] {
+	const tiers = options.tiers ?? DEFAULT_SLA_TIERS;
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const tier = getSlaPolicy(node)?.tier;
+		if (tier === undefined) continue;
+
+		const rank = tiers.indexOf(tier);
+		if (rank === -1) {
+			diagnostics.push({
+				ruleId: "sla-consistency",
+				severity: "warning",
+				message: `${node.fqn} declares unknown SLA tier: ${tier}`,
+				nodeId: node.id,
+				filePath: node.filePath,
+				line: node.line,
+				metadata: { tier },
+			});
+			continue;
+		}
+
+		const visited = new Set([node.id]);
+		const queue: Array<{ id: string; path: string[] }> = [
+			{ id: node.id, path: [] },
+		];
+		while (queue.length > 0) {
+			const { id, path } = queue.shift() as { id: string; path: string[] };
+
+			for (const edge of graph.getOutgoingEdges(id, DEPENDENCY_EDGE_TYPES)) {
+				const dependency = graph.getNode(edge.to);
+				if (!dependency || visited.has(dependency.id)) continue;
+				visited.add(dependency.id);
+
+				const dependencyTier = getSlaPolicy(dependency)?.tier;
+				if (dependencyTier === undefined) {
+					queue.push({ id: dependency.id, path: [...path, dependency.id] });
+					continue;
+				}
+
+				const dependencyRank = tiers.indexOf(dependencyTier);
+				if (dependencyRank === -1 || dependencyRank >= rank) continue;
+
+				diagnostics.push({
+					ruleId: "sla-consistency",
+					severity: "error",
+					message: `${tier} ${node.fqn} depends on ${dependencyTier} ${dependency.fqn}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: node.line,
+					metadata: {
+						tier,
+						dependency: dependency.id,
+						dependencyTier,
+						via: path,
+					},
+				});
+			}
+		}
+	}
+
+	return diagnostics;
+}
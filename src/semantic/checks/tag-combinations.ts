@@ -0,0 +1,68 @@
+/**
+ * Tag Combination Check
+ * 특정 태그에 반드시 함께 붙어야 하는 태그가 빠졌는지 검사
+ */
+
+import { RuleEngine, type TagRule } from "../rule-engine";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 필수 동반 태그 설정
+ */
+export interface TagCombinationConfig {
+	/** 태그 → 그 태그가 있을 때 함께 있어야 하는 태그 목록 */
+	requires: Record<string, string[]>;
+}
+
+/** 기본 필수 동반 태그 규칙 */
+export const DEFAULT_REQUIRED_TAG_COMPANIONS: Record<string, string[]> = {
+	pii: ["security-reviewed"],
+};
+
+/**
+ * 필수 동반 태그 없이 태그가 붙은 심볼 탐지
+ *
+ * 동반 태그는 상위 심볼(포함 관계, 메서드 리시버)에서 상속된 것을 포함해
+ * 판단한다. 위반은 태그를 직접 선언한 심볼에서만 보고하므로 상속받은 하위
+ * 심볼마다 같은 진단이 반복되지 않는다.
+ */
+export function checkTagCombinations(
+	graph: SemanticGraph,
+	config?: TagCombinationConfig,
+): SemanticDiagnostic[] {
+	return new RuleEngine([createTagCombinationRule(config)]).run(graph);
+}
+
+/**
+ * 필수 동반 태그 규칙 생성 (RuleEngine 등록용)
+ */
+export function createTagCombinationRule(
+	config: TagCombinationConfig = { requires: DEFAULT_REQUIRED_TAG_COMPANIONS },
+): TagRule {
+	return {
+		id: "tag-combination",
+		check(node, { tags }) {
+			const diagnostics: SemanticDiagnostic[] = [];
+
+			for (const [tag, companions] of Object.entries(config.requires)) {
+				if (tags.get(tag) !== node.id) continue;
+
+				const missing = companions.filter((companion) => !tags.has(companion));
+				if (missing.length === 0) continue;
+
+				diagnostics.push({
+					ruleId: "tag-combination",
+					severity: "error",
+					message: `${node.fqn} is tagged ${tag} but lacks required tags: ${missing.join(", ")}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: node.line,
+					metadata: { tag, missing },
+				});
+			}
+
+			return diagnostics;
+		},
+	};
+}
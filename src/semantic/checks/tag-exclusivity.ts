@@ -0,0 +1,72 @@
+/**
+ * Tag Exclusivity Check
+ * 서로 배타적인 시맨틱 태그가 한 심볼에 함께 붙었는지 검사
+ */
+
+import { RuleEngine, type TagRule } from "../rule-engine";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 배타적 태그 그룹 설정
+ */
+export interface TagExclusivityConfig {
+	/** 한 심볼에 최대 하나만 붙을 수 있는 태그 그룹 목록 */
+	groups: string[][];
+}
+
+/** 기본 배타적 태그 그룹 */
+export const DEFAULT_EXCLUSIVE_TAG_GROUPS: string[][] = [
+	["public-api", "internal"],
+];
+
+/**
+ * 배타적 그룹의 태그를 둘 이상 가진 심볼 탐지
+ *
+ * 상위 심볼(포함 관계, 메서드 리시버)에서 상속된 태그도 함께 검사하며,
+ * 상속된 태그는 메시지에 출처 노드를 표시한다. 충돌하는 태그를 하나 이상
+ * 직접 선언한 심볼에서만 보고하므로, 상위 심볼의 충돌이 하위 심볼마다
+ * 반복되지 않는다.
+ */
+export function checkTagExclusivity(
+	graph: SemanticGraph,
+	config?: TagExclusivityConfig,
+): SemanticDiagnostic[] {
+	return new RuleEngine([createTagExclusivityRule(config)]).run(graph);
+}
+
+/**
+ * 배타적 태그 규칙 생성 (RuleEngine 등록용)
+ */
+export function createTagExclusivityRule(
+	config: TagExclusivityConfig = { groups: DEFAULT_EXCLUSIVE_TAG_GROUPS },
+): TagRule {
+	return {
+		id: "tag-exclusivity",
+		check(node, { tags }) {
+			const diagnostics: SemanticDiagnostic[] = [];
+
+			for (const group of config.groups) {
+				const present = group.filter((tag) => tags.has(tag));
+				if (present.length < 2) continue;
+				if (!present.some((tag) => tags.get(tag) === node.id)) continue;
+
+				const described = present.map((tag) => {
+					const source = tags.get(tag);
+					return source === node.id ? tag : `${tag} (from ${source})`;
+				});
+
+				diagnostics.push({
+					ruleId: "tag-exclusivity",
+					severity: "error",
+					message: `${node.fqn} has mutually exclusive tags: ${described.join(", ")}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: node.line,
+				});
+			}
+
+			return diagnostics;
+		},
+	};
+}
@@ -0,0 +1,62 @@
+/**
+ * Tag Kind Check
+ * 태그가 뜻하는 종류와 추출된 노드 종류의 충돌 검사
+ */
+
+import {
+	DEFAULT_TAG_KIND_CONFIG,
+	resolveTagKind,
+	type TagKindConfig,
+} from "../kind-inference";
+import { RuleEngine, type TagRule } from "../rule-engine";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 태그가 뜻하는 종류가 추출된 종류와 맞지 않는 심볼 탐지
+ *
+ * 종류를 뜻하는 태그가 서로 다른 종류를 가리키거나, 추출된 종류의
+ * 구체화가 아닌 종류를 가리키면 진단한다. applyTagKinds는 이런 노드의
+ * 종류를 바꾸지 않는다.
+ */
+export function checkTagKinds(
+	graph: SemanticGraph,
+	config?: TagKindConfig,
+): SemanticDiagnostic[] {
+	return new RuleEngine([createTagKindRule(config)]).run(graph);
+}
+
+/**
+ * 태그 종류 규칙 생성 (RuleEngine 등록용)
+ */
+export function createTagKindRule(
+	config: TagKindConfig = DEFAULT_TAG_KIND_CONFIG,
+): TagRule {
+	return {
+		id: "tag-kind",
+		check(node) {
+			const { astKind, implied, conflict } = resolveTagKind(node, config);
+			if (!conflict) return [];
+
+			const described = implied.map(({ tag, kind }) => `${tag} (${kind})`);
+			const impliedKinds = Array.from(
+				new Set(implied.map((entry) => entry.kind)),
+			);
+			return [
+				{
+					ruleId: "tag-kind",
+					severity: "warning",
+					message: `${node.fqn} is a ${astKind} but its tags imply ${described.join(", ")}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: node.line,
+					metadata: {
+						astKind,
+						impliedKinds,
+						tags: implied.map((entry) => entry.tag),
+					},
+				},
+			];
+		},
+	};
+}
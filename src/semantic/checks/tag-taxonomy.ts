@@ -0,0 +1,126 @@
+/**
+ * Tag Taxonomy Check
+ * 택소노미 밖의 태그와 필수 분류 태그 개수 위반 검사
+ */
+
+import { RuleEngine, type TagRule } from "../rule-engine";
+import type { SemanticGraph } from "../SemanticGraph";
+import { getTaxonomyVocabulary, type TagTaxonomy } from "../tag-taxonomy";
+import { TEST_TAG } from "../test-files";
+import type { SemanticDiagnostic } from "../types";
+
+/** 오타 제안으로 인정하는 최대 편집 거리 */
+const MAX_SUGGESTION_DISTANCE = 2;
+
+/**
+ * 택소노미 위반 심볼 탐지
+ *
+ * - unknown-tag: 심볼에 직접 붙은 태그가 어휘에 없음 (비슷한 태그를 제안)
+ * - tag-category: required 분류의 태그가 정확히 하나가 아님. 상위 심볼에서
+ *   상속된 태그를 포함해 세며, kinds가 없으면 태그가 하나라도 직접 붙은
+ *   심볼만 대상으로 한다.
+ *
+ * 분석기가 붙이는 TEST_TAG는 항상 허용한다.
+ */
+export function checkTagTaxonomy(
+	graph: SemanticGraph,
+	taxonomy: TagTaxonomy,
+): SemanticDiagnostic[] {
+	return new RuleEngine([createTagTaxonomyRule(taxonomy)]).run(graph);
+}
+
+/**
+ * 택소노미 규칙 생성 (RuleEngine 등록용)
+ */
+export function createTagTaxonomyRule(taxonomy: TagTaxonomy): TagRule {
+	const vocabulary = getTaxonomyVocabulary(taxonomy);
+	vocabulary.add(TEST_TAG);
+
+	return {
+		id: "tag-taxonomy",
+		check(node, { tags }) {
+			const diagnostics: SemanticDiagnostic[] = [];
+
+			for (const tag of new Set(node.semanticTags)) {
+				if (vocabulary.has(tag)) continue;
+
+				const suggestion = suggestTag(tag, vocabulary);
+				diagnostics.push({
+					ruleId: "unknown-tag",
+					severity: "error",
+					message: `${node.fqn} uses tag ${tag} which is not in the taxonomy${suggestion ? ` (did you mean ${suggestion}?)` : ""}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: node.line,
+					metadata: suggestion ? { tag, suggestion } : { tag },
+				});
+			}
+
+			for (const category of taxonomy.categories) {
+				if (!category.required) continue;
+				const applies = category.kinds
+					? category.kinds.includes(node.kind)
+					: node.semanticTags.length > 0;
+				if (!applies) continue;
+
+				const present = category.tags.filter((tag) => tags.has(tag));
+				if (present.length === 1) continue;
+
+				diagnostics.push({
+					ruleId: "tag-category",
+					severity: "error",
+					message:
+						present.length === 0
+							? `${node.fqn} needs one ${category.name} tag (${category.tags.join(", ")})`
+							: `${node.fqn} has ${present.length} ${category.name} tags but needs exactly one: ${present.join(", ")}`,
+					nodeId: node.id,
+					filePath: node.filePath,
+					line: node.line,
+					metadata: { category: category.name, tags: present },
+				});
+			}
+
+			return diagnostics;
+		},
+	};
+}
+
+/**
+ * 어휘에서 가장 비슷한 태그 (구분자/복수형만 다르거나 편집 거리 2 이하)
+ */
+function suggestTag(tag: string, vocabulary: Set<string>): string | undefined {
+	const normalize = (value: string) =>
+		value.toLowerCase().replace(/[_\s]/g, "-").replace(/s$/, "");
+	let best: string | undefined;
+	let bestDistance = MAX_SUGGESTION_DISTANCE + 1;
+
+	for (const candidate of Array.from(vocabulary).sort()) {
+		const distance =
+			normalize(candidate) === normalize(tag)
+				? 0
+				: editDistance(candidate, tag);
+		if (distance < bestDistance) {
+			best = candidate;
+			bestDistance = distance;
+		}
+	}
+	return best;
+}
+
+function editDistance(a: string, b: string): number {
+	let previous = Array.from({ length: b.length + 1 }, (_, index) => index);
+	for (let i = 1; i <= a.length; i++) {
+		const current = [i];
+		for (let j = 1; j <= b.length; j++) {
+			current.push(
+				Math.min(
+					previous[j] + 1,
+					current[j - 1] + 1,
+					previous[j - 1] + (a[i - 1] === b[j - 1] ? 0 : 1),
+				),
+			);
+		}
+		previous = current;
+	}
+	return previous[b.length];
+}
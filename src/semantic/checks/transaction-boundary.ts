@@ -0,0 +1,68 @@
+/**
+ * Transaction Boundary Check
+ * @transactional 메서드가 트랜잭션 컨텍스트 밖에서 호출되는지 검사
+ */
+
+import { hasAnnotation } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic, SemanticNode } from "../types";
+
+/**
+ * 트랜잭션 경계 설정
+ */
+export interface TransactionBoundaryOptions {
+	/**
+	 * 트랜잭션을 시작하는 것으로 보는 어노테이션 (기본: ["transaction-boundary"])
+	 *
+	 * 이 어노테이션이 붙은 호출자는 @transactional이 아니어도 트랜잭션
+	 * 컨텍스트를 제공하는 것으로 간주한다.
+	 */
+	boundaryAnnotations?: string[];
+}
+
+/**
+ * @transactional 어노테이션이 있는지 확인
+ */
+export function isTransactional(node: SemanticNode): boolean {
+	return hasAnnotation(node, "transactional");
+}
+
+/**
+ * 트랜잭션 컨텍스트 없이 @transactional 메서드를 호출하는 호출자 탐지
+ *
+ * 호출자 자신이 @transactional이거나 경계 어노테이션을 가지고 있으면
+ * 트랜잭션 컨텍스트가 있는 것으로 본다. 호출자가 없는 진입점은 검사하지 않는다.
+ */
+export function checkTransactionBoundaries(
+	graph: SemanticGraph,
+	options: TransactionBoundaryOptions = {},
+): SemanticDiagnostic[] {
+	const boundaries = options.boundaryAnnotations ?? ["transaction-boundary"];
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		if (!isTransactional(node)) continue;
+
+		for (const edge of graph.getIncomingEdges(node.id, ["calls"])) {
+			const caller = graph.getNode(edge.from);
+			if (!caller) continue;
+
+			const providesTransaction =
+				isTransactional(caller) ||
+				boundaries.some((name) => hasAnnotation(caller, name));
+			if (providesTransaction) continue;
+
+			diagnostics.push({
+				ruleId: "transaction-boundary",
+				severity: "error",
+				message: `${caller.fqn} calls transactional ${node.fqn} outside a transaction`,
+				nodeId: caller.id,
+				filePath: caller.filePath,
+				line: (edge.metadata?.line as number | undefined) ?? caller.line,
+				metadata: { callee: node.id },
+			});
+		}
+	}
+
+	return diagnostics;
+}
@@ -0,0 +1,73 @@
+/**
+ * Unique Tag Check
+ * 한 심볼에만 붙어야 하는 시맨틱 태그의 중복 사용 검사
+ */
+
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic, SemanticNode } from "../types";
+
+/**
+ * 고유 태그 검사 옵션
+ */
+export interface UniqueTagOptions {
+	/** 고유해야 하는 태그 (문자열은 정확히 일치, 정규식은 패턴 일치) */
+	tags?: Array<string | RegExp>;
+}
+
+/** 기본 고유 태그 (패키지 단위 태그, 예: user-package) */
+export const DEFAULT_UNIQUE_TAGS: Array<string | RegExp> = [/-package$/];
+
+/**
+ * 고유 태그가 둘 이상의 심볼에 붙은 경우 탐지
+ *
+ * 중복된 심볼마다 진단을 하나씩 만들고, 메시지에는 같은 태그가 붙은
+ * 모든 위치를 나열한다 (한 곳만 @suppress 할 수 있도록).
+ */
+export function checkUniqueTags(
+	graph: SemanticGraph,
+	options: UniqueTagOptions = {},
+): SemanticDiagnostic[] {
+	const patterns = options.tags ?? DEFAULT_UNIQUE_TAGS;
+	const isUnique = (tag: string) =>
+		patterns.some((pattern) =>
+			typeof pattern === "string" ? pattern === tag : pattern.test(tag),
+		);
+
+	const owners = new Map<string, SemanticNode[]>();
+	for (const node of graph.nodes.values()) {
+		for (const tag of new Set(node.semanticTags)) {
+			if (!isUnique(tag)) continue;
+			const nodes = owners.get(tag) ?? [];
+			nodes.push(node);
+			owners.set(tag, nodes);
+		}
+	}
+
+	const diagnostics: SemanticDiagnostic[] = [];
+	for (const tag of Array.from(owners.keys()).sort()) {
+		const nodes = (owners.get(tag) ?? []).sort(
+			(a, b) =>
+				(a.filePath < b.filePath ? -1 : a.filePath > b.filePath ? 1 : 0) ||
+				(a.line ?? 0) - (b.line ?? 0) ||
+				(a.id < b.id ? -1 : a.id > b.id ? 1 : 0),
+		);
+		if (nodes.length < 2) continue;
+
+		const locations = nodes.map(
+			(node) => `${node.fqn} (${node.filePath}:${node.line ?? "?"})`,
+		);
+		for (const node of nodes) {
+			diagnostics.push({
+				ruleId: "unique-tag",
+				severity: "error",
+				message: `${tag} must be unique but is on ${nodes.length} symbols: ${locations.join(", ")}`,
+				nodeId: node.id,
+				filePath: node.filePath,
+				line: node.line,
+				metadata: { tag, nodeIds: nodes.map((other) => other.id) },
+			});
+		}
+	}
+
+	return diagnostics;
+}
@@ -0,0 +1,63 @@
+/**
+ * Unused Receiver Check
+ * 리시버를 쓰지 않아 패키지 함수로 바꿀 수 있는 메서드 검사
+ */
+
+import { collectInterfaceMethods } from "../extractors/GoExtractor";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic, SemanticNode } from "../types";
+
+/**
+ * 본문에서 리시버를 참조하지 않는 메서드 탐지 (metadata.usesReceiver)
+ *
+ * 리시버 이름이 없거나 `_`인 메서드는 의도적으로 쓰지 않는 것이므로
+ * 보고하지 않는다. 리시버 타입이 구현하는 인터페이스가 요구하는
+ * 메서드도 함수로 바꿀 수 없으므로 제외한다. 위반이 아닌 제안이므로
+ * DEFAULT_CHECKS에는 포함하지 않고, check 명령의 --unused-receivers로
+ * 켠다.
+ */
+export function checkUnusedReceivers(
+	graph: SemanticGraph,
+): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const node of graph.nodes.values()) {
+		if (node.kind !== "method" || node.metadata.usesReceiver !== false) {
+			continue;
+		}
+		if (isInterfaceMethod(graph, node)) continue;
+
+		const receiver = node.metadata.receiverName as string;
+		diagnostics.push({
+			ruleId: "unused-receiver",
+			severity: "info",
+			message: `${node.fqn} never uses its receiver ${receiver}; consider making it a package function`,
+			nodeId: node.id,
+			filePath: node.filePath,
+			line: node.line,
+			metadata: {
+				receiver,
+				receiverType: node.metadata.receiverType,
+			},
+		});
+	}
+
+	return diagnostics;
+}
+
+/**
+ * 리시버 타입이 구현하는 인터페이스가 요구하는 메서드인지 확인
+ */
+function isInterfaceMethod(graph: SemanticGraph, method: SemanticNode): boolean {
+	const shape = method.metadata.methodShape as string | undefined;
+	const owner = graph.getIncomingEdges(method.id, ["contains"])[0]?.from;
+	if (shape === undefined || !owner) return false;
+
+	return graph.getOutgoingEdges(owner, ["implements"]).some((edge) => {
+		const iface = graph.getNode(edge.to);
+		return (
+			iface !== undefined &&
+			collectInterfaceMethods(graph, iface, new Set()).has(shape)
+		);
+	});
+}
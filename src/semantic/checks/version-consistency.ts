@@ -0,0 +1,48 @@
+/**
+ * API Version Consistency Check
+ * 상위 버전 핸들러가 이전 버전 전용 심볼에 의존하는지 검사
+ */
+
+import { getApiVersions } from "../api-version";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticDiagnostic } from "../types";
+
+/**
+ * 호출자의 API 버전을 지원하지 않는 심볼 호출 탐지
+ *
+ * 호출자와 호출 대상 모두 @api-version이 선언되어 있고, 호출자의 버전 중
+ * 하나도 호출 대상이 지원하지 않으면 보고한다. 버전이 없는 심볼은
+ * 모든 버전에서 사용할 수 있는 것으로 본다.
+ */
+export function checkVersionConsistency(
+	graph: SemanticGraph,
+): SemanticDiagnostic[] {
+	const diagnostics: SemanticDiagnostic[] = [];
+
+	for (const edge of graph.edges) {
+		if (edge.type !== "calls") continue;
+
+		const caller = graph.getNode(edge.from);
+		const callee = graph.getNode(edge.to);
+		if (!caller || !callee) continue;
+
+		const callerVersions = getApiVersions(graph, caller);
+		const calleeVersions = getApiVersions(graph, callee);
+		if (callerVersions.length === 0 || calleeVersions.length === 0) continue;
+		if (callerVersions.some((version) => calleeVersions.includes(version))) {
+			continue;
+		}
+
+		diagnostics.push({
+			ruleId: "api-version",
+			severity: "warning",
+			message: `${caller.fqn} (${callerVersions.join(", ")}) calls ${callee.fqn} which only supports ${calleeVersions.join(", ")}`,
+			nodeId: caller.id,
+			filePath: caller.filePath,
+			line: (edge.metadata?.line as number | undefined) ?? caller.line,
+			metadata: { callee: callee.id, callerVersions, calleeVersions },
+		});
+	}
+
+	return diagnostics;
+}
@@ -0,0 +1,26 @@
+/**
+ * Data Classification Labels
+ * @classification 어노테이션으로 선언된 데이터 분류 등급
+ */
+
+import type { SemanticNode } from "./types";
+
+/** 로그/외부 호출로 흘러가면 안 되는 기본 분류 등급 */
+export const DEFAULT_SENSITIVE_CLASSIFICATIONS = ["confidential", "restricted"];
+
+/**
+ * 어노테이션에서 분류 등급 파싱 (소문자로 정규화, 없으면 undefined)
+ */
+export function parseClassification(
+	annotations: Record<string, string[]>,
+): string | undefined {
+	const classification = annotations.classification?.[0]?.trim().toLowerCase();
+	return classification ? classification : undefined;
+}
+
+/**
+ * 노드의 분류 등급 조회 (metadata.classification)
+ */
+export function getClassification(node: SemanticNode): string | undefined {
+	return node.metadata.classification as string | undefined;
+}
@@ -0,0 +1,91 @@
+/**
+ * Dependency Closure
+ * 심볼이 전이적으로 의존하는 심볼 전체와 각 심볼까지의 최단 경로
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 의존 폐포 계산 옵션
+ */
+export interface ClosureOptions {
+	/** 따라갈 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+	/** 최대 탐색 깊이 (기본: 제한 없음) */
+	maxDepth?: number;
+	/** 시작 심볼에서 각 심볼까지의 최단 경로 포함 (기본: false) */
+	includePaths?: boolean;
+}
+
+/**
+ * 전이적으로 의존하는 심볼
+ */
+export interface ClosureEntry {
+	node: SemanticNode;
+	/** 가장 가까운 시작 심볼로부터의 거리 (직접 의존 = 1) */
+	depth: number;
+	/** 시작 심볼부터 이 심볼까지의 최단 경로 (includePaths일 때, 양 끝 포함) */
+	path?: string[];
+}
+
+/**
+ * 시작 심볼들이 전이적으로 의존하는 심볼 집합 (시작 심볼 제외)
+ *
+ * 나가는 엣지를 따라 반복 BFS하므로 깊은 그래프에서도 호출 스택이
+ * 늘지 않고, 방문한 노드는 다시 넣지 않아 순환이 있어도 종료한다.
+ * 각 노드는 처음 도달한 경로(최단 경로)의 부모만 기억하며, 결과는
+ * 깊이, ID 순이다.
+ */
+export function computeClosure(
+	graph: SemanticGraph,
+	seeds: string[],
+	options: ClosureOptions = {},
+): ClosureEntry[] {
+	const follows = (type: string) =>
+		options.edgeTypes
+			? options.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+	const maxDepth = options.maxDepth ?? Number.POSITIVE_INFINITY;
+
+	const depths = new Map<string, number>(seeds.map((id) => [id, 0]));
+	const parents = new Map<string, string>();
+	const queue = [...seeds];
+
+	// shift() 대신 읽기 위치를 옮겨 큐 복사 비용을 피한다
+	for (let head = 0; head < queue.length; head++) {
+		const id = queue[head];
+		const depth = depths.get(id) as number;
+		if (depth >= maxDepth) continue;
+
+		for (const edge of graph.getOutgoingEdges(id)) {
+			if (!follows(edge.type) || depths.has(edge.to)) continue;
+			depths.set(edge.to, depth + 1);
+			parents.set(edge.to, id);
+			queue.push(edge.to);
+		}
+	}
+
+	const entries: ClosureEntry[] = [];
+	for (const [id, depth] of depths) {
+		const node = graph.getNode(id);
+		if (depth === 0 || !node) continue;
+
+		const entry: ClosureEntry = { node, depth };
+		if (options.includePaths) {
+			const path = [id];
+			for (let parent = parents.get(id); parent; parent = parents.get(parent)) {
+				path.push(parent);
+			}
+			entry.path = path.reverse();
+		}
+		entries.push(entry);
+	}
+
+	return entries.sort(
+		(a, b) =>
+			a.depth - b.depth ||
+			(a.node.id < b.node.id ? -1 : a.node.id > b.node.id ? 1 : 0),
+	);
+}
@@ -0,0 +1,104 @@
+/**
+ * CODEOWNERS Support
+ * CODEOWNERS 파일의 경로 패턴으로 심볼 소유자 지정
+ */
+
+import { promises as fs } from "node:fs";
+import { matchesGlob } from "./glob";
+import type { SemanticGraph } from "./SemanticGraph";
+
+/**
+ * CODEOWNERS 규칙 한 줄
+ */
+export interface CodeOwnersRule {
+	/** 원본 패턴 */
+	pattern: string;
+	/** 소유자 목록 (예: "@org/team", "user@example.com") */
+	owners: string[];
+	/** 규칙 라인 번호 */
+	line: number;
+}
+
+/**
+ * CODEOWNERS 내용 파싱
+ */
+export function parseCodeOwners(content: string): CodeOwnersRule[] {
+	const rules: CodeOwnersRule[] = [];
+
+	content.split("\n").forEach((rawLine, index) => {
+		const line = rawLine.replace(/(^|\s)#.*$/, "").trim();
+		if (!line) return;
+
+		const [pattern, ...owners] = line.split(/\s+/);
+		rules.push({ pattern, owners, line: index + 1 });
+	});
+
+	return rules;
+}
+
+/**
+ * CODEOWNERS 파일 로드
+ */
+export async function loadCodeOwners(filePath: string): Promise<CodeOwnersRule[]> {
+	const content = await fs.readFile(filePath, "utf-8");
+	return parseCodeOwners(content);
+}
+
+/**
+ * 파일 경로의 소유자 조회
+ *
+ * GitHub 규칙과 같이 마지막으로 일치한 규칙이 우선한다.
+ * 소유자가 비어 있는 규칙이 마지막으로 일치하면 소유자가 없는 것으로 본다.
+ */
+export function resolveOwners(
+	filePath: string,
+	rules: CodeOwnersRule[],
+): string[] {
+	const normalized = filePath.replace(/\\/g, "/").replace(/^\.?\//, "");
+
+	for (let i = rules.length - 1; i >= 0; i--) {
+		const globs = toGlobs(rules[i].pattern);
+		if (globs.some((glob) => matchesGlob(normalized, glob))) {
+			return [...rules[i].owners];
+		}
+	}
+
+	return [];
+}
+
+/**
+ * 그래프의 모든 노드에 소유자 지정 (metadata.owners)
+ */
+export function assignOwners(
+	graph: SemanticGraph,
+	rules: CodeOwnersRule[],
+): void {
+	const cache = new Map<string, string[]>();
+
+	for (const node of graph.nodes.values()) {
+		if (!node.filePath) continue;
+
+		let owners = cache.get(node.filePath);
+		if (!owners) {
+			owners = resolveOwners(node.filePath, rules);
+			cache.set(node.filePath, owners);
+		}
+		node.metadata.owners = owners;
+	}
+}
+
+/**
+ * CODEOWNERS 패턴을 glob 목록으로 변환
+ *
+ * - 선행 "/" 또는 중간 "/"가 있으면 루트 기준, 아니면 모든 깊이에서 매칭
+ * - 후행 "/"는 디렉토리 하위 전체
+ * - 그 외 패턴은 파일 자체 또는 같은 이름의 디렉토리 하위 전체
+ */
+function toGlobs(pattern: string): string[] {
+	const anchored = pattern.startsWith("/") || pattern.slice(0, -1).includes("/");
+	const directoryOnly = pattern.endsWith("/");
+	const body = pattern.replace(/^\//, "").replace(/\/$/, "");
+	const base = anchored ? body : `**/${body}`;
+
+	return directoryOnly ? [`${base}/**`] : [base, `${base}/**`];
+}
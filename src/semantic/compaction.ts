@@ -0,0 +1,124 @@
+/**
+ * Graph Compaction
+ * re-export/alias 체인으로 생긴 중복 노드를 정규 노드 하나로 병합
+ */
+
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** 기본 병합 대상 엣지 (별칭 -> 원본 방향) */
+export const DEFAULT_COMPACTION_EDGE_TYPES = ["re-exports", "aliasOf"];
+
+/**
+ * 컴팩션 옵션
+ */
+export interface CompactionOptions {
+	/** 같은 심볼로 볼 엣지 타입 */
+	edgeTypes?: string[];
+}
+
+/**
+ * 별칭 엣지로 연결된 노드를 병합한 새 그래프 생성
+ *
+ * 연결된 노드 묶음마다 별칭 엣지가 더 이상 나가지 않는 원본 노드를
+ * 정규 노드로 삼고(external 자리표시 노드는 후순위), 나머지 노드를 가리키던
+ * 엣지는 정규 노드로 다시 연결한다. 병합된 노드 ID는 metadata.aliases에,
+ * 태그는 합집합으로 기록된다. 별칭 엣지 자체와 병합으로 생긴 자기 참조는 버린다.
+ */
+export function compactGraph(
+	graph: SemanticGraph,
+	options: CompactionOptions = {},
+): SemanticGraph {
+	const edgeTypes = options.edgeTypes ?? DEFAULT_COMPACTION_EDGE_TYPES;
+	const parent = new Map<string, string>();
+	const find = (id: string): string => {
+		let root = id;
+		while (parent.has(root) && parent.get(root) !== root) {
+			root = parent.get(root) as string;
+		}
+		parent.set(id, root);
+		return root;
+	};
+
+	const aliasEdges = graph.edges.filter(
+		(edge) =>
+			edgeTypes.includes(edge.type) &&
+			graph.hasNode(edge.from) &&
+			graph.hasNode(edge.to),
+	);
+	for (const edge of aliasEdges) {
+		const a = find(edge.from);
+		const b = find(edge.to);
+		if (a !== b) parent.set(a, b);
+	}
+
+	const aliasIds = new Set(aliasEdges.map((edge) => edge.from));
+	const groups = new Map<string, SemanticNode[]>();
+	for (const node of graph.nodes.values()) {
+		const root = find(node.id);
+		const group = groups.get(root) ?? [];
+		group.push(node);
+		groups.set(root, group);
+	}
+
+	const canonicalOf = new Map<string, string>();
+	const result = new SemanticGraph();
+
+	for (const group of groups.values()) {
+		const canonical = pickCanonical(group, aliasIds);
+		const aliases = group
+			.filter((node) => node.id !== canonical.id)
+			.map((node) => node.id)
+			.sort();
+
+		for (const node of group) {
+			canonicalOf.set(node.id, canonical.id);
+		}
+
+		if (aliases.length === 0) {
+			result.addNode(canonical);
+			continue;
+		}
+
+		result.addNode({
+			...canonical,
+			semanticTags: Array.from(
+				new Set(group.flatMap((node) => node.semanticTags)),
+			),
+			metadata: { ...canonical.metadata, aliases },
+		});
+	}
+
+	for (const edge of graph.edges) {
+		if (edgeTypes.includes(edge.type)) continue;
+
+		const from = canonicalOf.get(edge.from) ?? edge.from;
+		const to = canonicalOf.get(edge.to) ?? edge.to;
+		// 병합으로 생긴 자기 참조만 버리고, 원래 있던 자기 참조(재귀 호출 등)는 유지
+		if (from === to && (from !== edge.from || to !== edge.to)) continue;
+
+		result.addEdge(
+			from === edge.from && to === edge.to ? edge : { ...edge, from, to },
+		);
+	}
+
+	return result;
+}
+
+/**
+ * 묶음의 정규 노드 선택
+ *
+ * 별칭 엣지가 나가지 않는 노드 > external이 아닌 노드 > ID 순
+ */
+function pickCanonical(
+	group: SemanticNode[],
+	aliasIds: Set<string>,
+): SemanticNode {
+	const rank = (node: SemanticNode) =>
+		(aliasIds.has(node.id) ? 2 : 0) + (node.kind === "external" ? 1 : 0);
+
+	return [...group].sort(
+		(a, b) =>
+			rank(a) - rank(b) || (a.id < b.id ? -1 : a.id > b.id ? 1 : 0),
+	)[0];
+}
@@ -0,0 +1,178 @@
+/**
+ * Symbol Complexity
+ * 함수/메서드 구문 트리에서 크기와 순환 복잡도 계산
+ */
+
+import type Parser from "tree-sitter";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 함수/메서드 복잡도 지표
+ */
+export interface ComplexityMetrics {
+	/** 선언 전체 라인 수 (시그니처 포함) */
+	lines: number;
+	/** 본문 문장 수 (중첩 블록 포함) */
+	statements: number;
+	/** 순환 복잡도 (1 + 분기 지점 수) */
+	cyclomatic: number;
+}
+
+export type ComplexityMetric = keyof ComplexityMetrics;
+
+/**
+ * 언어별 구문 노드 분류 (새 언어는 이 설정만 추가하면 됨)
+ */
+export interface ComplexityGrammar {
+	/** 문장으로 세는 노드 타입 */
+	statementTypes: string[];
+	/** 분기 지점으로 세는 노드 타입 (if, for, case 등) */
+	branchTypes: string[];
+	/** 노드 타입 -> 분기 지점으로 세는 연산자 (단축 평가 &&, || 등) */
+	logicalOperators: Record<string, string[]>;
+}
+
+export const GO_COMPLEXITY_GRAMMAR: ComplexityGrammar = {
+	statementTypes: [
+		"assignment_statement",
+		"break_statement",
+		"const_declaration",
+		"continue_statement",
+		"dec_statement",
+		"defer_statement",
+		"expression_statement",
+		"expression_switch_statement",
+		"fallthrough_statement",
+		"for_statement",
+		"go_statement",
+		"goto_statement",
+		"if_statement",
+		"inc_statement",
+		"labeled_statement",
+		"return_statement",
+		"select_statement",
+		"send_statement",
+		"short_var_declaration",
+		"type_declaration",
+		"type_switch_statement",
+		"var_declaration",
+	],
+	branchTypes: [
+		"communication_case",
+		"expression_case",
+		"for_statement",
+		"if_statement",
+		"type_case",
+	],
+	logicalOperators: { binary_expression: ["&&", "||"] },
+};
+
+export const PYTHON_COMPLEXITY_GRAMMAR: ComplexityGrammar = {
+	statementTypes: [
+		"assert_statement",
+		"break_statement",
+		"class_definition",
+		"continue_statement",
+		"delete_statement",
+		"expression_statement",
+		"for_statement",
+		"function_definition",
+		"global_statement",
+		"if_statement",
+		"import_from_statement",
+		"import_statement",
+		"match_statement",
+		"nonlocal_statement",
+		"pass_statement",
+		"raise_statement",
+		"return_statement",
+		"try_statement",
+		"while_statement",
+		"with_statement",
+	],
+	branchTypes: [
+		"case_clause",
+		"conditional_expression",
+		"elif_clause",
+		"except_clause",
+		"for_statement",
+		"if_statement",
+		"while_statement",
+	],
+	logicalOperators: { boolean_operator: ["and", "or"] },
+};
+
+/**
+ * 선언 노드와 본문으로 복잡도 계산
+ *
+ * 본문 안의 함수 리터럴/람다도 바깥 함수의 복잡도에 포함한다.
+ */
+export function computeComplexity(
+	declaration: Parser.SyntaxNode,
+	body: Parser.SyntaxNode,
+	grammar: ComplexityGrammar,
+): ComplexityMetrics {
+	const statementTypes = new Set(grammar.statementTypes);
+	const branchTypes = new Set(grammar.branchTypes);
+	let statements = 0;
+	let branches = 0;
+
+	const stack: Parser.SyntaxNode[] = [...body.namedChildren];
+	while (stack.length > 0) {
+		const node = stack.pop() as Parser.SyntaxNode;
+		if (statementTypes.has(node.type)) statements++;
+		if (branchTypes.has(node.type)) branches++;
+
+		const operators = grammar.logicalOperators[node.type];
+		const operator = node.childForFieldName("operator")?.type;
+		if (operators && operator !== undefined && operators.includes(operator)) {
+			branches++;
+		}
+		stack.push(...node.namedChildren);
+	}
+
+	return {
+		lines: declaration.endPosition.row - declaration.startPosition.row + 1,
+		statements,
+		cyclomatic: 1 + branches,
+	};
+}
+
+/**
+ * 노드에 기록된 복잡도 (함수/메서드가 아니면 undefined)
+ */
+export function getComplexity(
+	node: SemanticNode,
+): ComplexityMetrics | undefined {
+	return node.metadata.complexity as ComplexityMetrics | undefined;
+}
+
+/**
+ * 복잡도 지표 내림차순 정렬 (같으면 ID 순, 지표가 없으면 뒤로)
+ */
+export function sortByComplexity<
+	T extends { id: string; complexity?: ComplexityMetrics },
+>(items: T[], metric: ComplexityMetric = "cyclomatic"): T[] {
+	return [...items].sort(
+		(a, b) =>
+			(b.complexity?.[metric] ?? -1) - (a.complexity?.[metric] ?? -1) ||
+			(a.id < b.id ? -1 : a.id > b.id ? 1 : 0),
+	);
+}
+
+/**
+ * 복잡도가 기록된 심볼을 지표 내림차순으로 나열 (리팩터링 우선순위)
+ */
+export function rankByComplexity(
+	graph: SemanticGraph,
+	metric: ComplexityMetric = "cyclomatic",
+	limit?: number,
+): Array<{ id: string; node: SemanticNode; complexity: ComplexityMetrics }> {
+	const entries = [];
+	for (const node of graph.nodes.values()) {
+		const complexity = getComplexity(node);
+		if (complexity) entries.push({ id: node.id, node, complexity });
+	}
+	return sortByComplexity(entries, metric).slice(0, limit);
+}
@@ -0,0 +1,140 @@
+/**
+ * Component Grouping
+ * 여러 물리 패키지를 논리 컴포넌트(virtual package) 단위로 묶는 그래프 뷰
+ */
+
+import path from "node:path";
+import { matchesGlob } from "./glob";
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 컴포넌트 그룹핑 설정
+ */
+export interface ComponentConfig {
+	/** 컴포넌트 이름 -> 패키지 glob 목록 (먼저 선언된 컴포넌트가 우선) */
+	components: Record<string, string[]>;
+	/** 어떤 컴포넌트에도 속하지 않는 패키지를 자체 컴포넌트로 포함할지 여부 (기본: true) */
+	includeUnmapped?: boolean;
+}
+
+/**
+ * 노드가 속한 패키지 경로
+ *
+ * 선언 파일의 디렉토리를 패키지 경로로 사용한다 (루트 파일은 ".").
+ */
+export function getNodePackage(node: SemanticNode): string {
+	return path.posix.dirname(node.filePath.replace(/\\/g, "/"));
+}
+
+/**
+ * 노드가 속한 컴포넌트 이름 (매핑이 없으면 undefined)
+ *
+ * glob은 패키지 경로와 선언된 패키지 이름(metadata.package) 모두에 대해 검사한다.
+ */
+export function resolveComponent(
+	node: SemanticNode,
+	config: ComponentConfig,
+): string | undefined {
+	const packagePath = getNodePackage(node);
+	const packageName = node.metadata.package as string | undefined;
+
+	for (const [component, patterns] of Object.entries(config.components)) {
+		const matched = patterns.some(
+			(pattern) =>
+				matchesGlob(packagePath, pattern) ||
+				(packageName !== undefined && matchesGlob(packageName, pattern)),
+		);
+		if (matched) {
+			return component;
+		}
+	}
+
+	return undefined;
+}
+
+/**
+ * 그래프를 컴포넌트 단위로 집계
+ *
+ * 각 컴포넌트는 kind "component" 노드가 되고, 컴포넌트 사이의 엣지는
+ * "depends_on" 엣지 하나로 합쳐진다. 합쳐진 엣지 수는 metadata.count,
+ * 원래 관계 타입별 개수는 metadata.types에 기록된다.
+ * 같은 컴포넌트 내부 엣지는 노드의 metadata.internalEdges로만 집계된다.
+ */
+export function groupByComponent(
+	graph: SemanticGraph,
+	config: ComponentConfig,
+): SemanticGraph {
+	const includeUnmapped = config.includeUnmapped ?? true;
+	const componentOf = new Map<string, string>();
+	const result = new SemanticGraph();
+
+	for (const node of graph.nodes.values()) {
+		const component =
+			resolveComponent(node, config) ??
+			(includeUnmapped ? getNodePackage(node) : undefined);
+		if (component === undefined) continue;
+
+		componentOf.set(node.id, component);
+
+		let componentNode = result.getNode(component);
+		if (!componentNode) {
+			componentNode = {
+				id: component,
+				fqn: component,
+				name: component,
+				kind: "component",
+				filePath: "",
+				semanticTags: [],
+				metadata: {
+					packages: [],
+					nodeIds: [],
+					internalEdges: 0,
+					mapped: component in config.components,
+				},
+			};
+			result.addNode(componentNode);
+		}
+
+		const packagePath = getNodePackage(node);
+		if (!componentNode.metadata.packages.includes(packagePath)) {
+			componentNode.metadata.packages.push(packagePath);
+			componentNode.metadata.packages.sort();
+		}
+		componentNode.metadata.nodeIds.push(node.id);
+	}
+
+	for (const edge of graph.edges) {
+		const from = componentOf.get(edge.from);
+		const to = componentOf.get(edge.to);
+		if (from === undefined || to === undefined) continue;
+
+		if (from === to) {
+			const node = result.getNode(from);
+			if (node) node.metadata.internalEdges++;
+			continue;
+		}
+
+		let aggregated = result.edges.find(
+			(candidate) => candidate.from === from && candidate.to === to,
+		);
+		if (!aggregated) {
+			aggregated = {
+				from,
+				to,
+				type: "depends_on",
+				metadata: { count: 0, types: {} },
+			};
+			result.addEdge(aggregated);
+		}
+
+		const metadata = aggregated.metadata as {
+			count: number;
+			types: Record<string, number>;
+		};
+		metadata.count++;
+		metadata.types[edge.type] = (metadata.types[edge.type] || 0) + 1;
+	}
+
+	return result;
+}
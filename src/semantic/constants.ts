@@ -0,0 +1,62 @@
+/**
+ * Constant Propagation
+ * 상수를 통해 간접 참조된 문자열 키를 실제 값으로 해석
+ */
+
+import { getGoPackageId } from "./extractors/GoExtractor";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** 상수 체인을 따라가는 최대 깊이 (순환 방지) */
+const MAX_CONSTANT_DEPTH = 8;
+
+/**
+ * 호출 인자 식을 문자열 값으로 해석
+ *
+ * - 문자열 리터럴: `"new-ui"`, `` `new-ui` ``
+ * - 같은 패키지 상수: `FlagNewUI` (packageId는 호출한 노드의 패키지 노드 ID)
+ * - 다른 패키지 상수: `flags.FlagNewUI` (import 이름 = 패키지 이름으로 가정)
+ * - 다른 상수를 값으로 가진 상수는 체인을 따라간다.
+ *
+ * 해석할 수 없으면 undefined를 반환한다.
+ */
+export function resolveStringValue(
+	graph: SemanticGraph,
+	expression: string,
+	packageId: string | undefined,
+	depth = 0,
+): string | undefined {
+	const text = expression.trim();
+
+	const literal = text.match(/^"((?:[^"\\]|\\.)*)"$|^`([^`]*)`$/);
+	if (literal) {
+		if (literal[2] !== undefined) return literal[2];
+		try {
+			return JSON.parse(text) as string;
+		} catch {
+			return literal[1];
+		}
+	}
+
+	if (depth >= MAX_CONSTANT_DEPTH) {
+		return undefined;
+	}
+
+	let constant: SemanticNode | undefined;
+	if (/^[A-Za-z_]\w*$/.test(text) && packageId) {
+		constant = graph.getNode(`${packageId}.${text}`);
+	} else if (/^[A-Za-z_]\w*\.[A-Za-z_]\w*$/.test(text)) {
+		constant = graph.getNode(text);
+	}
+	if (!constant || constant.kind !== "constant") {
+		return undefined;
+	}
+
+	if (typeof constant.metadata.value === "string") {
+		return constant.metadata.value;
+	}
+	const next = constant.metadata.valueExpression as string | undefined;
+	return next === undefined
+		? undefined
+		: resolveStringValue(graph, next, getGoPackageId(constant), depth + 1);
+}
@@ -0,0 +1,273 @@
+/**
+ * Cycle Detection
+ * 구조적 관계 타입으로 필터링한 순환 의존 탐지
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge } from "./types";
+
+/** 기본 구조적 관계 타입 (tests 같은 부가 관계는 제외) */
+export const DEFAULT_STRUCTURAL_EDGE_TYPES = [
+	"imports",
+	"depends_on",
+	"calls",
+	"references",
+];
+
+/**
+ * 순환 탐지 옵션
+ */
+export interface CycleDetectionOptions {
+	/** 순환을 이루는 것으로 보는 구조적 관계 타입 */
+	structuralTypes?: string[];
+	/**
+	 * - all: 구조적 엣지만으로 이루어진 순환만 보고 (기본값)
+	 * - any: 구조적 엣지를 하나 이상 포함한 순환 보고 (다른 의존 엣지도 경로에 사용)
+	 */
+	match?: "all" | "any";
+}
+
+/**
+ * 순환 (강한 연결 요소 단위)
+ */
+export interface DependencyCycle {
+	/** 순환에 속한 노드 (ID 순) */
+	nodes: string[];
+	/** 순환 노드 사이의 엣지 */
+	edges: SemanticEdge[];
+}
+
+/**
+ * 순환 의존 탐지
+ *
+ * 강한 연결 요소마다 하나의 순환으로 보고하며, 자기 자신을 가리키는
+ * 엣지도 순환이다. 강한 연결 요소 안의 모든 엣지는 어떤 순환 위에 있으므로
+ * "any" 모드는 요소 안에 구조적 엣지가 하나라도 있는지로 판단한다.
+ * 결과는 첫 노드 ID 순이다.
+ */
+export function detectCycles(
+	graph: SemanticGraph,
+	options: CycleDetectionOptions = {},
+): DependencyCycle[] {
+	const structural = new Set(
+		options.structuralTypes ?? DEFAULT_STRUCTURAL_EDGE_TYPES,
+	);
+	const candidates = graph.edges.filter((edge) =>
+		options.match === "any"
+			? isDependencyEdge(edge.type)
+			: structural.has(edge.type),
+	);
+
+	const cycles: DependencyCycle[] = [];
+	for (const members of stronglyConnectedComponents(candidates)) {
+		const inside = new Set(members);
+		const edges = candidates.filter(
+			(edge) => inside.has(edge.from) && inside.has(edge.to),
+		);
+		if (members.length === 1 && edges.length === 0) continue;
+		if (!edges.some((edge) => structural.has(edge.type))) continue;
+
+		cycles.push({ nodes: members.sort(), edges });
+	}
+
+	return cycles.sort((a, b) =>
+		a.nodes[0] < b.nodes[0] ? -1 : a.nodes[0] > b.nodes[0] ? 1 : 0,
+	);
+}
+
+/** findImportCycles가 기본으로 나열하는 최대 순환 수 */
+export const DEFAULT_MAX_IMPORT_CYCLES = 1000;
+
+/**
+ * import 순환 탐지 옵션
+ */
+export interface ImportCycleOptions {
+	/** 순환으로 볼 엣지 타입 (기본: ["imports"]) */
+	edgeTypes?: string[];
+	/** 나열할 최대 순환 수 (기본: DEFAULT_MAX_IMPORT_CYCLES) */
+	maxCycles?: number;
+	/** 나열할 순환의 최대 노드 수 (기본: 제한 없음) */
+	maxLength?: number;
+}
+
+/**
+ * import 순환 리포트
+ */
+export interface ImportCycleReport {
+	/**
+	 * 둘 이상의 노드로 이루어진 기본 순환 (가장 작은 ID에서 시작해 엣지를
+	 * 따라가는 순서, 마지막 노드가 첫 노드로 돌아감)
+	 */
+	cycles: string[][];
+	/** 자기 자신을 import하는 노드 (ID 순) */
+	selfLoops: string[];
+	/** maxCycles에 도달해 나열을 멈췄는지 */
+	truncated: boolean;
+}
+
+/**
+ * 패키지/파일 간 import 순환을 모두 찾기
+ *
+ * Tarjan으로 강한 연결 요소를 구한 뒤 요소 안에서만 기본 순환을 나열한다.
+ * 각 순환은 가장 작은 ID의 노드에서 시작하고 그보다 큰 ID의 노드만
+ * 거치도록 탐색하므로 같은 순환이 한 번만 보고되며, 결과는 사전 순이다.
+ * 기본 순환 수는 요소 크기에 대해 지수적으로 늘 수 있으므로 maxCycles개를
+ * 찾으면 멈추고 truncated로 표시한다. maxLength보다 긴 순환은 찾지 않는다.
+ */
+export function findImportCycles(
+	graph: SemanticGraph,
+	options: ImportCycleOptions = {},
+): ImportCycleReport {
+	const types = new Set(options.edgeTypes ?? ["imports"]);
+	const maxCycles = options.maxCycles ?? DEFAULT_MAX_IMPORT_CYCLES;
+	const maxLength = options.maxLength ?? Number.POSITIVE_INFINITY;
+	const candidates = graph.edges.filter((edge) => types.has(edge.type));
+
+	const selfLoops = new Set<string>();
+	const successors = new Map<string, Set<string>>();
+	for (const edge of candidates) {
+		if (edge.from === edge.to) {
+			selfLoops.add(edge.from);
+			continue;
+		}
+		const targets = successors.get(edge.from) ?? new Set<string>();
+		targets.add(edge.to);
+		successors.set(edge.from, targets);
+	}
+
+	const cycles: string[][] = [];
+	let truncated = false;
+	const looping = candidates.filter((edge) => edge.from !== edge.to);
+	for (const members of stronglyConnectedComponents(looping)) {
+		if (members.length < 2 || truncated) continue;
+		const inside = new Set(members);
+		const within = new Map(
+			members.map((id) => [
+				id,
+				Array.from(successors.get(id) ?? [])
+					.filter((next) => inside.has(next))
+					.sort(),
+			]),
+		);
+
+		for (const start of [...members].sort()) {
+			const path = [start];
+			const onPath = new Set(path);
+			// 재귀 대신 명시적 스택 (큰 요소에서 호출 스택 초과 방지)
+			const frames = [{ targets: within.get(start) as string[], next: 0 }];
+			while (frames.length > 0 && !truncated) {
+				const frame = frames[frames.length - 1];
+				if (frame.next === frame.targets.length) {
+					frames.pop();
+					onPath.delete(path.pop() as string);
+					continue;
+				}
+
+				const next = frame.targets[frame.next++];
+				if (next < start) continue;
+				if (next === start) {
+					if (cycles.length === maxCycles) {
+						truncated = true;
+					} else {
+						cycles.push([...path]);
+					}
+				} else if (!onPath.has(next) && path.length < maxLength) {
+					path.push(next);
+					onPath.add(next);
+					frames.push({ targets: within.get(next) as string[], next: 0 });
+				}
+			}
+			if (truncated) break;
+		}
+	}
+
+	return {
+		cycles: cycles.sort(compareCycles),
+		selfLoops: Array.from(selfLoops).sort(),
+		truncated,
+	};
+}
+
+function compareCycles(a: string[], b: string[]): number {
+	for (let i = 0; i < Math.min(a.length, b.length); i++) {
+		if (a[i] !== b[i]) return a[i] < b[i] ? -1 : 1;
+	}
+	return a.length - b.length;
+}
+
+/**
+ * 강한 연결 요소 계산 (Tarjan)
+ *
+ * 엣지에 등장하는 노드만 포함하며, 요소는 역위상 순서(의존 대상이 먼저)다.
+ */
+export function stronglyConnectedComponents(edges: SemanticEdge[]): string[][] {
+	const adjacency = new Map<string, string[]>();
+	for (const edge of edges) {
+		const targets = adjacency.get(edge.from);
+		if (targets) {
+			targets.push(edge.to);
+		} else {
+			adjacency.set(edge.from, [edge.to]);
+		}
+		if (!adjacency.has(edge.to)) adjacency.set(edge.to, []);
+	}
+
+	const index = new Map<string, number>();
+	const lowlink = new Map<string, number>();
+	const stack: string[] = [];
+	const onStack = new Set<string>();
+	const components: string[][] = [];
+
+	const visit = (id: string) => {
+		index.set(id, index.size);
+		lowlink.set(id, index.get(id) as number);
+		stack.push(id);
+		onStack.add(id);
+	};
+
+	for (const root of adjacency.keys()) {
+		if (index.has(root)) continue;
+		visit(root);
+		// 재귀 대신 명시적 스택 (긴 의존 체인에서 호출 스택 초과 방지)
+		const frames = [{ id: root, next: 0 }];
+		while (frames.length > 0) {
+			const frame = frames[frames.length - 1];
+			const targets = adjacency.get(frame.id) as string[];
+			if (frame.next < targets.length) {
+				const next = targets[frame.next++];
+				if (!index.has(next)) {
+					visit(next);
+					frames.push({ id: next, next: 0 });
+				} else if (onStack.has(next)) {
+					lowlink.set(
+						frame.id,
+						Math.min(lowlink.get(frame.id) as number, index.get(next) as number),
+					);
+				}
+				continue;
+			}
+
+			frames.pop();
+			const id = frame.id;
+			const parent = frames[frames.length - 1];
+			if (parent) {
+				lowlink.set(
+					parent.id,
+					Math.min(lowlink.get(parent.id) as number, lowlink.get(id) as number),
+				);
+			}
+			if (lowlink.get(id) === index.get(id)) {
+				const component: string[] = [];
+				let member: string;
+				do {
+					member = stack.pop() as string;
+					onStack.delete(member);
+					component.push(member);
+				} while (member !== id);
+				components.push(component);
+			}
+		}
+	}
+	return components;
+}
@@ -0,0 +1,143 @@
+/**
+ * Dependency Paths
+ * 두 심볼 사이의 최단 의존 경로 ("A가 어떻게 B에 의존하게 되는가")
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge } from "./types";
+
+/**
+ * 경로 탐색 옵션
+ */
+export interface PathOptions {
+	/** 따라갈 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+	/** 길이가 같은 최단 경로를 모두 반환 (기본: false, 하나만) */
+	all?: boolean;
+}
+
+/**
+ * 방향 엣지를 따라가는 의존 경로
+ */
+export interface DependencyPath {
+	/** 시작 심볼부터 끝 심볼까지의 노드 ID (양 끝 포함) */
+	nodes: string[];
+	/** nodes[i] -> nodes[i + 1] 엣지의 타입 */
+	edgeTypes: string[];
+}
+
+/**
+ * from에서 to로 가는 최단 경로 (도달할 수 없으면 빈 배열)
+ *
+ * 나가는 엣지를 따라 BFS하며 방문한 노드는 다시 넣지 않으므로 순환이
+ * 있어도 종료한다. all이면 같은 길이의 경로를 모두 담고, 같은 두 노드
+ * 사이의 타입이 다른 엣지는 서로 다른 경로로 센다. 결과는 노드 ID,
+ * 엣지 타입 순이다.
+ */
+export function findShortestPaths(
+	graph: SemanticGraph,
+	from: string,
+	to: string,
+	options: PathOptions = {},
+): DependencyPath[] {
+	if (!graph.getNode(from) || !graph.getNode(to)) {
+		return [];
+	}
+	if (from === to) {
+		return [{ nodes: [from], edgeTypes: [] }];
+	}
+
+	const follows = (type: string) =>
+		options.edgeTypes
+			? options.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+
+	// 노드 -> 최단 거리로 들어오는 엣지 (all이 아니면 처음 찾은 하나)
+	const depths = new Map<string, number>([[from, 0]]);
+	const parents = new Map<string, SemanticEdge[]>();
+	let frontier = [from];
+
+	while (frontier.length > 0 && !depths.has(to)) {
+		const depth = (depths.get(frontier[0]) as number) + 1;
+		const next: string[] = [];
+
+		for (const id of frontier) {
+			for (const edge of sortEdges(graph.getOutgoingEdges(id))) {
+				if (!follows(edge.type)) continue;
+
+				const known = depths.get(edge.to);
+				if (known === undefined) {
+					depths.set(edge.to, depth);
+					parents.set(edge.to, [edge]);
+					next.push(edge.to);
+				} else if (known === depth && options.all) {
+					const incoming = parents.get(edge.to) as SemanticEdge[];
+					if (!incoming.some((other) => sameEdge(other, edge))) {
+						incoming.push(edge);
+					}
+				}
+			}
+		}
+		frontier = next;
+	}
+
+	if (!depths.has(to)) {
+		return [];
+	}
+
+	const paths: DependencyPath[] = [];
+	const walk = (id: string, nodes: string[], edgeTypes: string[]) => {
+		if (id === from) {
+			paths.push({
+				nodes: [from, ...nodes],
+				edgeTypes: [...edgeTypes],
+			});
+			return;
+		}
+		for (const edge of parents.get(id) ?? []) {
+			walk(edge.from, [id, ...nodes], [edge.type, ...edgeTypes]);
+		}
+	};
+	walk(to, [], []);
+
+	return paths.sort(
+		(a, b) =>
+			compareLists(a.nodes, b.nodes) || compareLists(a.edgeTypes, b.edgeTypes),
+	);
+}
+
+/**
+ * from에서 to로 가는 최단 경로 하나 (도달할 수 없으면 undefined)
+ */
+export function findShortestPath(
+	graph: SemanticGraph,
+	from: string,
+	to: string,
+	options: Omit<PathOptions, "all"> = {},
+): DependencyPath | undefined {
+	return findShortestPaths(graph, from, to, { ...options, all: false })[0];
+}
+
+/** 같은 그래프에서 항상 같은 경로를 고르도록 끝 노드, 타입 순 정렬 */
+function sortEdges(edges: SemanticEdge[]): SemanticEdge[] {
+	return [...edges].sort(
+		(a, b) => compareStrings(a.to, b.to) || compareStrings(a.type, b.type),
+	);
+}
+
+function sameEdge(a: SemanticEdge, b: SemanticEdge): boolean {
+	return a.from === b.from && a.to === b.to && a.type === b.type;
+}
+
+function compareLists(a: string[], b: string[]): number {
+	for (let i = 0; i < Math.min(a.length, b.length); i++) {
+		const order = compareStrings(a[i], b[i]);
+		if (order !== 0) return order;
+	}
+	return a.length - b.length;
+}
+
+function compareStrings(a: string, b: string): number {
+	return a < b ? -1 : a > b ? 1 : 0;
+}
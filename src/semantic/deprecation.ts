@@ -0,0 +1,112 @@
+/**
+ * Deprecation Timelines
+ * @deprecated since=/remove= 어노테이션 파싱 및 제거 기한이 지난 심볼 리포트
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 심볼의 폐기 일정
+ */
+export interface DeprecationTimeline {
+	/** 폐기된 버전 (since=) */
+	since?: string;
+	/** 제거 예정 버전 (remove=) */
+	remove?: string;
+	/** key=value 외의 설명 텍스트 */
+	message?: string;
+}
+
+/**
+ * 제거 기한이 지난 심볼
+ */
+export interface OverdueDeprecation {
+	node: SemanticNode;
+	deprecation: DeprecationTimeline;
+}
+
+/**
+ * 어노테이션에서 폐기 일정 파싱 (@deprecated가 없으면 undefined)
+ *
+ * 예: `@deprecated since=v1.2 remove=v2.0 use CreateUserV2`
+ */
+export function parseDeprecation(
+	annotations: Record<string, string[]>,
+): DeprecationTimeline | undefined {
+	const values = annotations.deprecated;
+	if (!values) {
+		return undefined;
+	}
+
+	const timeline: DeprecationTimeline = {};
+	const words: string[] = [];
+	for (const word of values.join(" ").split(/\s+/)) {
+		const match = word.match(/^(since|remove)=(.+)$/);
+		if (match) {
+			timeline[match[1] as "since" | "remove"] = match[2];
+		} else if (word.length > 0) {
+			words.push(word);
+		}
+	}
+	if (words.length > 0) {
+		timeline.message = words.join(" ");
+	}
+
+	return timeline;
+}
+
+/**
+ * 노드의 폐기 일정 조회 (metadata.deprecation)
+ */
+export function getDeprecation(
+	node: SemanticNode,
+): DeprecationTimeline | undefined {
+	return node.metadata.deprecation as DeprecationTimeline | undefined;
+}
+
+/**
+ * 버전 비교 ("v" 접두사와 "-" 뒤 pre-release 표기는 무시, 빠진 자리는 0)
+ *
+ * a < b면 음수, 같으면 0, a > b면 양수를 반환한다.
+ */
+export function compareVersions(a: string, b: string): number {
+	const parse = (version: string) =>
+		version
+			.trim()
+			.replace(/^v/i, "")
+			.split("-")[0]
+			.split(".")
+			.map((part) => Number.parseInt(part, 10) || 0);
+
+	const left = parse(a);
+	const right = parse(b);
+	for (let i = 0; i < Math.max(left.length, right.length); i++) {
+		const diff = (left[i] ?? 0) - (right[i] ?? 0);
+		if (diff !== 0) return diff;
+	}
+	return 0;
+}
+
+/**
+ * 현재 버전이 제거 예정 버전 이상인 폐기 심볼 찾기 (노드 ID 순)
+ */
+export function findOverdueDeprecations(
+	graph: SemanticGraph,
+	currentVersion: string,
+): OverdueDeprecation[] {
+	const results: OverdueDeprecation[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const deprecation = getDeprecation(node);
+		if (!deprecation?.remove) continue;
+
+		if (compareVersions(currentVersion, deprecation.remove) >= 0) {
+			results.push({ node, deprecation });
+		}
+	}
+
+	return results.sort((a, b) =>
+		a.node.id < b.node.id ? -1 : a.node.id > b.node.id ? 1 : 0,
+	);
+}
@@ -0,0 +1,107 @@
+/**
+ * Deterministic JSON
+ * 골든 파일 비교용으로 같은 그래프를 항상 같은 바이트로 직렬화
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/** 소수 메트릭의 기본 자릿수 */
+export const DEFAULT_FLOAT_PRECISION = 6;
+
+/**
+ * 결정적 직렬화 옵션
+ */
+export interface DeterministicJsonOptions {
+	/** 정수가 아닌 숫자를 반올림할 소수 자릿수 (기본: 6) */
+	precision?: number;
+}
+
+/**
+ * 그래프를 삽입 순서와 무관한 JSON 문자열로 직렬화
+ *
+ * 노드는 파일 경로, 이름, ID 순, 엣지는 시작/끝 노드, 타입 순이고 시맨틱
+ * 태그는 알파벳 순이다. 모든 객체 키를 정렬하고, 메타데이터의 Map은 키 순
+ * 객체로, Set은 정렬한 배열로 바꾼다. 그 밖의 배열(경로 등)은 순서에 뜻이
+ * 있으므로 그대로 둔다. 정수가 아닌 숫자는 precision 자리로 반올림한다.
+ */
+export function marshalDeterministic(
+	graph: SemanticGraph,
+	options: DeterministicJsonOptions = {},
+): string {
+	const precision = options.precision ?? DEFAULT_FLOAT_PRECISION;
+	if (!Number.isInteger(precision) || precision < 0 || precision > 20) {
+		throw new Error(`Invalid precision: ${precision}`);
+	}
+
+	const nodes = Array.from(graph.nodes.values())
+		.sort(compareNodes)
+		.map((node) => ({
+			...node,
+			semanticTags: [...node.semanticTags].sort(compareStrings),
+		}));
+	const edges = [...graph.edges].sort(compareEdges);
+
+	const data = normalize({ nodes, edges }, precision);
+	return `${JSON.stringify(data, null, 2)}\n`;
+}
+
+function compareNodes(a: SemanticNode, b: SemanticNode): number {
+	return (
+		compareStrings(a.filePath, b.filePath) ||
+		compareStrings(a.name, b.name) ||
+		compareStrings(a.id, b.id)
+	);
+}
+
+function compareEdges(a: SemanticEdge, b: SemanticEdge): number {
+	return (
+		compareStrings(a.from, b.from) ||
+		compareStrings(a.to, b.to) ||
+		compareStrings(a.type, b.type)
+	);
+}
+
+function compareStrings(a: string, b: string): number {
+	return a < b ? -1 : a > b ? 1 : 0;
+}
+
+/**
+ * 키 정렬, Map/Set 변환, 숫자 반올림을 적용한 JSON 값
+ */
+function normalize(value: unknown, precision: number): unknown {
+	if (typeof value === "number") {
+		if (Number.isInteger(value) || !Number.isFinite(value)) {
+			return Object.is(value, -0) ? 0 : value;
+		}
+		const rounded = Number(value.toFixed(precision));
+		return Object.is(rounded, -0) ? 0 : rounded;
+	}
+	if (Array.isArray(value)) {
+		return value.map((item) => normalize(item, precision));
+	}
+	if (value instanceof Set) {
+		return Array.from(value)
+			.map((item) => normalize(item, precision))
+			.sort((a, b) => compareStrings(JSON.stringify(a), JSON.stringify(b)));
+	}
+	if (value instanceof Map) {
+		return normalize(
+			Object.fromEntries(
+				Array.from(value.entries()).map(([key, item]) => [String(key), item]),
+			),
+			precision,
+		);
+	}
+	if (value !== null && typeof value === "object") {
+		const result: Record<string, unknown> = {};
+		for (const key of Object.keys(value).sort(compareStrings)) {
+			result[key] = normalize(
+				(value as Record<string, unknown>)[key],
+				precision,
+			);
+		}
+		return result;
+	}
+	return value;
+}
@@ -0,0 +1,35 @@
+/**
+ * Dependency Injection Scopes
+ * @scope 어노테이션으로 선언된 프로바이더 수명 범위
+ */
+
+import type { SemanticNode } from "./types";
+
+/**
+ * 스코프별 상대 수명 (값이 클수록 오래 유지)
+ *
+ * transient는 요청마다 새로 만들어지므로 어느 스코프에서 의존해도 안전하다.
+ */
+export const DI_SCOPE_LIFETIMES: Record<string, number> = {
+	singleton: 3,
+	session: 2,
+	request: 1,
+	transient: 0,
+};
+
+/**
+ * 어노테이션에서 스코프 파싱 (@scope 값을 소문자로 정규화, 없으면 undefined)
+ */
+export function parseScope(
+	annotations: Record<string, string[]>,
+): string | undefined {
+	const scope = annotations.scope?.[0]?.trim().toLowerCase();
+	return scope ? scope : undefined;
+}
+
+/**
+ * 노드의 스코프 조회 (metadata.scope)
+ */
+export function getScope(node: SemanticNode): string | undefined {
+	return node.metadata.scope as string | undefined;
+}
@@ -0,0 +1,168 @@
+/**
+ * Diagnostic Formats
+ * CI용 진단 출력 형식 (GitHub Actions 워크플로 명령, SARIF 2.1.0)
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import type { DiagnosticSeverity, SemanticDiagnostic } from "./types";
+
+/**
+ * 진단 출력 옵션
+ */
+export interface DiagnosticFormatOptions {
+	/** 진단 노드의 이름 위치로 컬럼을 채울 그래프 (생략 시 컬럼 없음) */
+	graph?: SemanticGraph;
+}
+
+/**
+ * SARIF 출력 옵션
+ */
+export interface SarifOptions extends DiagnosticFormatOptions {
+	toolName?: string;
+	toolVersion?: string;
+}
+
+const GITHUB_COMMANDS: Record<DiagnosticSeverity, string> = {
+	error: "error",
+	warning: "warning",
+	info: "notice",
+};
+
+const SARIF_LEVELS: Record<DiagnosticSeverity, string> = {
+	error: "error",
+	warning: "warning",
+	info: "note",
+};
+
+/**
+ * GitHub Actions 워크플로 명령 (`::error file=...,line=...::message`) 출력
+ *
+ * 억제된 진단은 주석으로 남기지 않으며, 마지막 줄은 심각도별 개수 요약이다.
+ * 메시지의 %, 줄바꿈과 속성 값의 :, ,는 워크플로 명령 규칙대로 이스케이프한다.
+ */
+export function formatGitHubAnnotations(
+	diagnostics: SemanticDiagnostic[],
+	options: DiagnosticFormatOptions = {},
+): string {
+	const active = diagnostics.filter((diagnostic) => !diagnostic.suppressed);
+	const lines = active.map((diagnostic) => {
+		const properties: string[] = [];
+		if (diagnostic.filePath) {
+			properties.push(`file=${escapeProperty(diagnostic.filePath)}`);
+			if (diagnostic.line !== undefined) {
+				properties.push(`line=${diagnostic.line}`);
+				const column = findColumn(diagnostic, options.graph);
+				if (column !== undefined) properties.push(`col=${column}`);
+			}
+		}
+		properties.push(`title=${escapeProperty(diagnostic.ruleId)}`);
+		return `::${GITHUB_COMMANDS[diagnostic.severity]} ${properties.join(",")}::${escapeData(diagnostic.message)}`;
+	});
+
+	const count = (severity: DiagnosticSeverity) =>
+		active.filter((diagnostic) => diagnostic.severity === severity).length;
+	const suppressed = diagnostics.length - active.length;
+	lines.push(
+		`${active.length} diagnostics: ${count("error")} errors, ${count("warning")} warnings, ${count("info")} notices (${suppressed} suppressed)`,
+	);
+	return `${lines.join("\n")}\n`;
+}
+
+/**
+ * SARIF 2.1.0 로그 생성 (GitHub 코드 스캐닝 업로드용)
+ *
+ * 규칙은 진단에 나온 ruleId로 만들고, 억제된 진단은 inSource 억제로
+ * 표시한다. 파일 경로는 저장소 루트 기준 상대 URI로 기록한다.
+ */
+export function formatSarif(
+	diagnostics: SemanticDiagnostic[],
+	options: SarifOptions = {},
+): Record<string, unknown> {
+	const ruleIds = Array.from(
+		new Set(diagnostics.map((diagnostic) => diagnostic.ruleId)),
+	).sort();
+
+	return {
+		$schema: "https://json.schemastore.org/sarif-2.1.0.json",
+		version: "2.1.0",
+		runs: [
+			{
+				tool: {
+					driver: {
+						name: options.toolName ?? "dependency-linker",
+						...(options.toolVersion ? { version: options.toolVersion } : {}),
+						rules: ruleIds.map((id) => ({ id })),
+					},
+				},
+				results: diagnostics.map((diagnostic) =>
+					toSarifResult(diagnostic, ruleIds, options.graph),
+				),
+			},
+		],
+	};
+}
+
+function toSarifResult(
+	diagnostic: SemanticDiagnostic,
+	ruleIds: string[],
+	graph?: SemanticGraph,
+): Record<string, unknown> {
+	const result: Record<string, unknown> = {
+		ruleId: diagnostic.ruleId,
+		ruleIndex: ruleIds.indexOf(diagnostic.ruleId),
+		level: SARIF_LEVELS[diagnostic.severity],
+		message: { text: diagnostic.message },
+	};
+
+	if (diagnostic.filePath) {
+		const physicalLocation: Record<string, unknown> = {
+			artifactLocation: { uri: toArtifactUri(diagnostic.filePath) },
+		};
+		if (diagnostic.line !== undefined) {
+			const column = findColumn(diagnostic, graph);
+			physicalLocation.region =
+				column === undefined
+					? { startLine: diagnostic.line }
+					: { startLine: diagnostic.line, startColumn: column };
+		}
+		result.locations = [{ physicalLocation }];
+	}
+	if (diagnostic.suppressed) {
+		result.suppressions = [{ kind: "inSource" }];
+	}
+	return result;
+}
+
+/**
+ * 진단 노드의 이름 토큰 시작 컬럼 (진단 라인과 같은 라인일 때만)
+ */
+function findColumn(
+	diagnostic: SemanticDiagnostic,
+	graph?: SemanticGraph,
+): number | undefined {
+	if (!graph || !diagnostic.nodeId) return undefined;
+	const range = graph.getNode(diagnostic.nodeId)?.nameRange;
+	return range && range.startLine === diagnostic.line
+		? range.startColumn
+		: undefined;
+}
+
+function escapeData(value: string): string {
+	return value
+		.replace(/%/g, "%25")
+		.replace(/\r/g, "%0D")
+		.replace(/\n/g, "%0A");
+}
+
+function escapeProperty(value: string): string {
+	return escapeData(value).replace(/:/g, "%3A").replace(/,/g, "%2C");
+}
+
+function toArtifactUri(filePath: string): string {
+	return filePath
+		.replace(/\\/g, "/")
+		.replace(/^\.?\//, "")
+		.split("/")
+		.map((segment) => encodeURIComponent(segment))
+		.join("/");
+}
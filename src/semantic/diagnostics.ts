@@ -0,0 +1,73 @@
+/**
+ * Diagnostic Utilities
+ * 진단 심각도 비교, 억제(@suppress) 처리, CI 실패 판정
+ */
+
+import { getAnnotationValues, hasAnnotation } from "./annotations";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { DiagnosticSeverity, SemanticDiagnostic } from "./types";
+
+/** 심각도 순위 (높을수록 심각) */
+export const SEVERITY_RANK: Record<DiagnosticSeverity, number> = {
+	info: 0,
+	warning: 1,
+	error: 2,
+};
+
+/**
+ * 문자열이 유효한 심각도인지 확인
+ */
+export function isDiagnosticSeverity(
+	value: string,
+): value is DiagnosticSeverity {
+	return Object.prototype.hasOwnProperty.call(SEVERITY_RANK, value);
+}
+
+/**
+ * 심각도가 기준 이상인지 확인
+ */
+export function isAtLeast(
+	severity: DiagnosticSeverity,
+	level: DiagnosticSeverity,
+): boolean {
+	return SEVERITY_RANK[severity] >= SEVERITY_RANK[level];
+}
+
+/**
+ * 노드의 @suppress 어노테이션에 따라 진단을 억제 표시
+ *
+ * `@suppress panics, tag-exclusivity`는 해당 규칙만, 값이 없는 `@suppress`는
+ * 그 노드의 모든 규칙을 억제한다. 억제된 진단도 결과에 남으며 suppressed가 true가 된다.
+ */
+export function applySuppressions(
+	graph: SemanticGraph,
+	diagnostics: SemanticDiagnostic[],
+): SemanticDiagnostic[] {
+	return diagnostics.map((diagnostic) => {
+		const node = diagnostic.nodeId
+			? graph.getNode(diagnostic.nodeId)
+			: undefined;
+		if (!node || !hasAnnotation(node, "suppress")) {
+			return diagnostic;
+		}
+
+		const rules = getAnnotationValues(node, "suppress").flatMap((value) =>
+			value.split(/[\s,]+/).filter((rule) => rule.length > 0),
+		);
+		const suppressed = rules.length === 0 || rules.includes(diagnostic.ruleId);
+		return suppressed ? { ...diagnostic, suppressed: true } : diagnostic;
+	});
+}
+
+/**
+ * 실패로 집계되는 진단 수 (억제되지 않았고 기준 심각도 이상)
+ */
+export function countFailures(
+	diagnostics: SemanticDiagnostic[],
+	failLevel: DiagnosticSeverity,
+): number {
+	return diagnostics.filter(
+		(diagnostic) =>
+			!diagnostic.suppressed && isAtLeast(diagnostic.severity, failLevel),
+	).length;
+}
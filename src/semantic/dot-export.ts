@@ -0,0 +1,153 @@
+/**
+ * DOT Export
+ * 심볼 그래프를 Graphviz DOT 형식으로 내보내기
+ */
+
+import { EDGE_KIND_COLORS, type EdgeKind, edgeKindOf } from "./edge-kinds";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/** 기본으로 내보내는 관계 (import, 호출, 타입 참조) */
+export const DEFAULT_DOT_EDGE_TYPES = ["imports", "calls", "references"];
+
+/**
+ * DOT 내보내기 옵션
+ */
+export interface DotExportOptions {
+	/** 노드 단위: 심볼마다 하나 또는 파일마다 하나 (기본: "symbol") */
+	granularity?: "symbol" | "file";
+	/** 내보낼 엣지 타입 (기본: kinds가 없으면 DEFAULT_DOT_EDGE_TYPES) */
+	edgeTypes?: string[];
+	/** 내보낼 엣지 분류 (예: [EdgeKind.Call]이면 호출 그래프만) */
+	kinds?: EdgeKind[];
+	/** digraph 이름 (기본: "dependencies") */
+	name?: string;
+}
+
+/**
+ * 문자열 출력 대상 (fs.WriteStream, process.stdout 등)
+ */
+export interface DotWriter {
+	write(chunk: string): unknown;
+}
+
+interface DotNode {
+	id: string;
+	label: string;
+	tags: string[];
+}
+
+/**
+ * 그래프를 DOT 문자열로 렌더링
+ *
+ * 모든 ID와 속성 값을 따옴표로 감싸 이스케이프하므로 `*`, `.`, `/` 같은 문자가
+ * 있어도 유효한 DOT가 된다. 노드와 엣지는 ID 순으로 출력하며, 같은 쌍의
+ * 엣지는 타입별로 한 번만 그린다. 파일 단위에서는 같은 파일 안의 엣지를 생략한다.
+ * 엣지는 분류(EdgeKind)별 색으로 그린다.
+ */
+export function renderDot(
+	graph: SemanticGraph,
+	options: DotExportOptions = {},
+): string {
+	const byFile = options.granularity === "file";
+	const includes = createEdgeFilter(options);
+	const keyOf = (node: SemanticNode) => (byFile ? node.filePath : node.id);
+
+	const nodes = new Map<string, DotNode>();
+	for (const node of graph.nodes.values()) {
+		const key = keyOf(node);
+		const entry = nodes.get(key) ?? {
+			id: key,
+			label: byFile ? node.filePath : node.name,
+			tags: [],
+		};
+		for (const tag of node.semanticTags) {
+			if (!entry.tags.includes(tag)) entry.tags.push(tag);
+		}
+		nodes.set(key, entry);
+	}
+
+	const edges = new Map<
+		string,
+		{ from: string; to: string; type: string; kind: EdgeKind }
+	>();
+	for (const edge of graph.edges) {
+		if (!includes(edge)) continue;
+		const from = graph.getNode(edge.from);
+		const to = graph.getNode(edge.to);
+		if (!from || !to) continue;
+
+		const fromKey = keyOf(from);
+		const toKey = keyOf(to);
+		if (byFile && fromKey === toKey) continue;
+		edges.set(JSON.stringify([fromKey, toKey, edge.type]), {
+			from: fromKey,
+			to: toKey,
+			type: edge.type,
+			kind: edgeKindOf(edge),
+		});
+	}
+
+	const lines = [`digraph ${quote(options.name ?? "dependencies")} {`];
+	for (const node of Array.from(nodes.values()).sort(compareIds)) {
+		const attributes = [`label=${quote(node.label)}`];
+		if (node.tags.length > 0) {
+			attributes.push(`tooltip=${quote(node.tags.join(", "))}`);
+		}
+		lines.push(`\t${quote(node.id)} [${attributes.join(", ")}];`);
+	}
+	const sortedEdges = Array.from(edges.entries())
+		.sort(([a], [b]) => (a < b ? -1 : a > b ? 1 : 0))
+		.map(([, edge]) => edge);
+	for (const edge of sortedEdges) {
+		const attributes = `label=${quote(edge.type)}, color=${quote(EDGE_KIND_COLORS[edge.kind])}`;
+		lines.push(`\t${quote(edge.from)} -> ${quote(edge.to)} [${attributes}];`);
+	}
+	lines.push("}");
+
+	return `${lines.join("\n")}\n`;
+}
+
+/**
+ * 내보내기 옵션의 엣지 선택 조건
+ *
+ * edgeTypes와 kinds를 모두 만족해야 하며, 둘 다 없으면 기본 타입만 고른다.
+ */
+export function createEdgeFilter(options: {
+	edgeTypes?: string[];
+	kinds?: EdgeKind[];
+}): (edge: SemanticEdge) => boolean {
+	const kinds = options.kinds ? new Set(options.kinds) : undefined;
+	const types =
+		options.edgeTypes ?? (kinds ? undefined : DEFAULT_DOT_EDGE_TYPES);
+	const edgeTypes = types ? new Set(types) : undefined;
+	return (edge) =>
+		(!edgeTypes || edgeTypes.has(edge.type)) &&
+		(!kinds || kinds.has(edgeKindOf(edge)));
+}
+
+/**
+ * 그래프를 DOT 형식으로 출력 대상에 쓰기
+ */
+export function exportDot(
+	graph: SemanticGraph,
+	writer: DotWriter,
+	options?: DotExportOptions,
+): void {
+	writer.write(renderDot(graph, options));
+}
+
+/**
+ * DOT 큰따옴표 ID로 이스케이프
+ */
+function quote(value: string): string {
+	const escaped = value
+		.replace(/\\/g, "\\\\")
+		.replace(/"/g, '\\"')
+		.replace(/\r?\n/g, "\\n");
+	return `"${escaped}"`;
+}
+
+function compareIds(a: { id: string }, b: { id: string }): number {
+	return a.id < b.id ? -1 : a.id > b.id ? 1 : 0;
+}
@@ -0,0 +1,75 @@
+/**
+ * Edge Kinds
+ * 엣지 타입(type)을 의미별 분류(EdgeKind)로 묶는 택소노미
+ */
+
+import type { SemanticEdge } from "./types";
+
+/**
+ * 엣지 의미 분류
+ */
+export enum EdgeKind {
+	/** 파일/모듈 import (imports, imports_type) */
+	Import = "import",
+	/** 함수/메서드/서비스 호출 (calls, calls-service) */
+	Call = "call",
+	/** 시그니처나 생성식의 타입 참조 (references) */
+	TypeRef = "type-ref",
+	/** 인터페이스 구현 (implements) */
+	Implements = "implements",
+	/** 상속 (extends) */
+	Extends = "extends",
+	/** 구조적 포함 (contains, declares) */
+	Contains = "contains",
+	/** 그 밖의 관계 (uses_table, generates 등) */
+	Other = "other",
+}
+
+/** 추출기와 분석 단계가 만드는 엣지 타입 -> 분류 */
+export const EDGE_TYPE_KINDS: Readonly<Record<string, EdgeKind>> = {
+	imports: EdgeKind.Import,
+	imports_type: EdgeKind.Import,
+	calls: EdgeKind.Call,
+	"calls-service": EdgeKind.Call,
+	references: EdgeKind.TypeRef,
+	implements: EdgeKind.Implements,
+	extends: EdgeKind.Extends,
+	contains: EdgeKind.Contains,
+	declares: EdgeKind.Contains,
+};
+
+/** 내보내기에서 분류별 엣지 색 */
+export const EDGE_KIND_COLORS: Readonly<Record<EdgeKind, string>> = {
+	[EdgeKind.Import]: "#1f77b4",
+	[EdgeKind.Call]: "#2ca02c",
+	[EdgeKind.TypeRef]: "#9467bd",
+	[EdgeKind.Implements]: "#ff7f0e",
+	[EdgeKind.Extends]: "#d62728",
+	[EdgeKind.Contains]: "#7f7f7f",
+	[EdgeKind.Other]: "#8c564b",
+};
+
+/**
+ * 엣지의 분류
+ *
+ * metadata.kind가 EdgeKind 값이면 그 값을, 아니면 타입으로 EDGE_TYPE_KINDS를
+ * 찾고, 등록되지 않은 타입은 Other로 본다. 새 엣지 타입을 만드는 확장은
+ * metadata.kind로 분류를 지정할 수 있다.
+ */
+export function edgeKindOf(edge: SemanticEdge): EdgeKind {
+	const declared = edge.metadata?.kind;
+	if (isEdgeKind(declared)) {
+		return declared;
+	}
+	return EDGE_TYPE_KINDS[edge.type] ?? EdgeKind.Other;
+}
+
+/**
+ * EdgeKind 값인지 확인 (CLI 인자 검증 등)
+ */
+export function isEdgeKind(value: unknown): value is EdgeKind {
+	return (
+		typeof value === "string" &&
+		(Object.values(EdgeKind) as string[]).includes(value)
+	);
+}
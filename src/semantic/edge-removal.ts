@@ -0,0 +1,131 @@
+/**
+ * Edge Removal Simulation
+ * 엣지 하나를 제거했을 때의 영향을 그래프 변경 없이 미리 계산
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge } from "./types";
+
+/**
+ * 엣지 제거 영향
+ */
+export interface RemovalImpact {
+	edge: SemanticEdge;
+	/** 제거 후 시작 노드에서 더 이상 도달할 수 없는 노드 (ID 순) */
+	unreachable: string[];
+	/** 제거로 끊어진 순환 (제거 전 강한 연결 요소, ID 순) */
+	resolvedCycle?: string[];
+	/** 끊어진 순환의 노드들 사이에 남아 있는 순환 */
+	remainingCycles: string[][];
+	/** 제거 후 의존하는 노드가 하나도 없게 되는 노드 */
+	orphans: string[];
+}
+
+type Adjacency = Map<string, string[]>;
+
+interface DependencyAdjacency {
+	outgoing: Adjacency;
+	incoming: Adjacency;
+}
+
+/**
+ * 엣지 제거 시뮬레이션 (그래프는 변경하지 않음)
+ *
+ * 의존 관계 엣지(contains/declares 제외)만 따라가며, 같은 두 노드 사이에
+ * 다른 타입의 엣지가 남아 있으면 연결은 유지되는 것으로 본다.
+ */
+export function simulateRemoveEdge(
+	graph: SemanticGraph,
+	edge: Pick<SemanticEdge, "from" | "to" | "type">,
+): RemovalImpact {
+	const target = graph.edges.find(
+		(e) => e.from === edge.from && e.to === edge.to && e.type === edge.type,
+	);
+	if (!target) {
+		throw new Error(`Edge not found: ${edge.from} -${edge.type}-> ${edge.to}`);
+	}
+
+	const dependencies = graph.edges.filter((e) => isDependencyEdge(e.type));
+	const remaining = dependencies.filter((e) => e !== target);
+	const before = buildAdjacency(dependencies);
+	const after = buildAdjacency(remaining);
+
+	const reachableAfter = reach(after.outgoing, target.from);
+	const unreachable = Array.from(reach(before.outgoing, target.from))
+		.filter((id) => id !== target.from && !reachableAfter.has(id))
+		.sort();
+
+	// 엣지의 두 끝이 같은 순환 위에 있는지
+	const onCycle = (graphAdjacency: DependencyAdjacency) =>
+		target.from === target.to
+			? (graphAdjacency.outgoing.get(target.from) ?? []).includes(target.from)
+			: component(graphAdjacency, target.from).has(target.to);
+
+	let resolvedCycle: string[] | undefined;
+	const remainingCycles: string[][] = [];
+	if (onCycle(before) && !onCycle(after)) {
+		resolvedCycle = Array.from(component(before, target.from)).sort();
+
+		const assigned = new Set<string>();
+		for (const id of resolvedCycle) {
+			if (assigned.has(id)) continue;
+			const members = Array.from(component(after, id)).sort();
+			for (const member of members) assigned.add(member);
+			if (members.length > 1 || after.outgoing.get(id)?.includes(id)) {
+				remainingCycles.push(members);
+			}
+		}
+	}
+
+	const orphans =
+		isDependencyEdge(target.type) &&
+		(after.incoming.get(target.to) ?? []).length === 0
+			? [target.to]
+			: [];
+
+	return {
+		edge: target,
+		unreachable,
+		resolvedCycle,
+		remainingCycles,
+		orphans,
+	};
+}
+
+function buildAdjacency(edges: SemanticEdge[]): DependencyAdjacency {
+	const outgoing: Adjacency = new Map();
+	const incoming: Adjacency = new Map();
+	for (const edge of edges) {
+		outgoing.set(edge.from, [...(outgoing.get(edge.from) ?? []), edge.to]);
+		incoming.set(edge.to, [...(incoming.get(edge.to) ?? []), edge.from]);
+	}
+	return { outgoing, incoming };
+}
+
+/**
+ * 시작 노드에서 도달 가능한 노드 (시작 노드는 순환으로 되돌아올 때만 포함)
+ */
+function reach(adjacency: Adjacency, start: string): Set<string> {
+	const visited = new Set<string>();
+	const stack = [...(adjacency.get(start) ?? [])];
+	while (stack.length > 0) {
+		const id = stack.pop() as string;
+		if (visited.has(id)) continue;
+		visited.add(id);
+		stack.push(...(adjacency.get(id) ?? []));
+	}
+	return visited;
+}
+
+/**
+ * 노드가 속한 강한 연결 요소 (양방향으로 도달 가능한 노드 + 자기 자신)
+ */
+function component(adjacency: DependencyAdjacency, id: string): Set<string> {
+	const backward = reach(adjacency.incoming, id);
+	const members = new Set(
+		Array.from(reach(adjacency.outgoing, id)).filter((n) => backward.has(n)),
+	);
+	members.add(id);
+	return members;
+}
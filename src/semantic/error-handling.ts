@@ -0,0 +1,121 @@
+/**
+ * Error Handling Patterns
+ * Go 함수의 에러 반환 방식(감싸기/그대로 반환)과 무시한 에러 집계
+ */
+
+import type Parser from "tree-sitter";
+import type { CallSite, SemanticNode } from "./types";
+
+/**
+ * 함수/메서드의 에러 처리 지표
+ */
+export interface ErrorHandlingMetrics {
+	/** 에러 변수를 반환하는 return 문 수 (wrapped + bare) */
+	errorReturns: number;
+	/** %w 등으로 원인 에러를 감싸 반환하는 return 문 수 */
+	wrapped: number;
+	/** 원인 에러를 그대로(또는 %w 없이) 반환하는 return 문 수 */
+	bare: number;
+	/** 마지막 반환값을 `_`에 대입해 버린 호출 수 */
+	ignored: number;
+}
+
+/** 원인 에러를 감싸는 것으로 보는 호출 (fmt.Errorf는 %w가 있을 때만) */
+const WRAPPING_CALLS = new Set([
+	"errors.Wrap",
+	"errors.Wrapf",
+	"errors.WithMessage",
+	"errors.WithMessagef",
+	"errors.WithStack",
+]);
+
+/** 에러 변수로 보는 이름 (err, readErr 등; ErrNotFound 같은 센티널 제외) */
+const ERROR_NAME = /^(err|[a-z]\w*Err)$/;
+
+/**
+ * Go 함수 본문의 에러 처리 지표 계산
+ *
+ * return 문의 마지막 값만 보며 (Go 관례상 에러는 마지막 반환값),
+ * 본문 안 함수 리터럴의 return 문은 제외한다. `errors.New`처럼 새 에러를
+ * 만드는 return은 세지 않는다.
+ */
+export function analyzeErrorHandling(
+	body: Parser.SyntaxNode,
+	callSites: CallSite[],
+): ErrorHandlingMetrics {
+	const ignored = callSites.filter(
+		(site) => site.assignedTo?.[site.assignedTo.length - 1] === "_",
+	);
+	const metrics: ErrorHandlingMetrics = {
+		errorReturns: 0,
+		wrapped: 0,
+		bare: 0,
+		ignored: ignored.length,
+	};
+
+	for (const statement of body.descendantsOfType("return_statement")) {
+		if (isInFunctionLiteral(statement, body)) continue;
+
+		const values = statement.namedChildren.find(
+			(child) => child.type === "expression_list",
+		);
+		const last = values?.namedChildren[values.namedChildCount - 1];
+		if (!last) continue;
+
+		if (last.type === "identifier" && ERROR_NAME.test(last.text)) {
+			metrics.errorReturns++;
+			metrics.bare++;
+		} else if (last.type === "call_expression") {
+			const style = classifyErrorCall(last);
+			if (style) {
+				metrics.errorReturns++;
+				metrics[style]++;
+			}
+		}
+	}
+
+	return metrics;
+}
+
+/**
+ * 노드에 기록된 에러 처리 지표 (본문 없는 선언이나 Go 외 언어는 undefined)
+ */
+export function getErrorHandling(
+	node: SemanticNode,
+): ErrorHandlingMetrics | undefined {
+	return node.metadata.errorHandling as ErrorHandlingMetrics | undefined;
+}
+
+/**
+ * 에러 변수를 인자로 받는 호출이 원인을 감싸는지 분류 (에러 인자가 없으면 undefined)
+ */
+function classifyErrorCall(
+	call: Parser.SyntaxNode,
+): "wrapped" | "bare" | undefined {
+	const callee = call.childForFieldName("function")?.text ?? "";
+	const args = call.childForFieldName("arguments")?.namedChildren ?? [];
+	const passesError = args.some(
+		(arg) => arg.type === "identifier" && ERROR_NAME.test(arg.text),
+	);
+	if (!passesError) return undefined;
+
+	if (WRAPPING_CALLS.has(callee)) return "wrapped";
+	if (callee === "fmt.Errorf") {
+		return args[0]?.text.includes("%w") ? "wrapped" : "bare";
+	}
+	return undefined;
+}
+
+function isInFunctionLiteral(
+	node: Parser.SyntaxNode,
+	body: Parser.SyntaxNode,
+): boolean {
+	for (
+		let current = node.parent;
+		current && current.id !== body.id;
+		current = current.parent
+	) {
+		if (current.type === "func_literal") return true;
+	}
+	return false;
+}
@@ -0,0 +1,142 @@
+/**
+ * Experiment Annotations
+ * @experiment 어노테이션 파싱 및 만료된 실험 정리 리포트
+ */
+
+import { flagNodeId } from "./feature-flags";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 심볼에 선언된 실험
+ */
+export interface Experiment {
+	/** name= 값 */
+	name: string;
+	/** expires= 원본 값 ("2024-06" 또는 "2024-06-30") */
+	expires?: string;
+}
+
+/**
+ * 만료된 실험과 아직 그 실험으로 보호되는 심볼
+ */
+export interface ExpiredExperiment {
+	name: string;
+	expires: string;
+	/** @experiment 선언 또는 같은 이름의 플래그 확인으로 보호되는 심볼 (ID 순) */
+	guarded: SemanticNode[];
+}
+
+/**
+ * 어노테이션에서 실험 파싱 (@experiment name=...가 없으면 undefined)
+ *
+ * 예: `@experiment name=new-checkout expires=2024-06`
+ */
+export function parseExperiment(
+	annotations: Record<string, string[]>,
+): Experiment | undefined {
+	const value = annotations.experiment?.[0];
+	if (value === undefined) {
+		return undefined;
+	}
+
+	const options: Record<string, string> = {};
+	for (const pair of value.split(/\s+/)) {
+		const separator = pair.indexOf("=");
+		if (separator > 0) {
+			options[pair.slice(0, separator)] = pair.slice(separator + 1);
+		}
+	}
+
+	return options.name
+		? { name: options.name, expires: options.expires }
+		: undefined;
+}
+
+/**
+ * 노드의 실험 조회 (metadata.experiment)
+ */
+export function getExperiment(node: SemanticNode): Experiment | undefined {
+	return node.metadata.experiment as Experiment | undefined;
+}
+
+/**
+ * 만료 시각 (UTC 밀리초, 형식이 잘못되면 undefined)
+ *
+ * "2024-06"은 그 달이 끝난 뒤, "2024-06-30"은 그 날이 끝난 뒤 만료된다.
+ */
+export function parseExpiry(expires: string): number | undefined {
+	const match = expires.trim().match(/^(\d{4})-(\d{2})(?:-(\d{2}))?$/);
+	if (!match) return undefined;
+
+	const year = Number(match[1]);
+	const month = Number(match[2]) - 1;
+	if (month < 0 || month > 11) return undefined;
+
+	return match[3] === undefined
+		? Date.UTC(year, month + 1, 1)
+		: Date.UTC(year, month, Number(match[3]) + 1);
+}
+
+/**
+ * 만료된 실험 찾기 (실험 이름 순)
+ *
+ * 같은 이름의 실험이 여러 심볼에 선언되면 가장 늦은 만료일을 사용한다.
+ * 보호되는 심볼에는 실험 이름과 같은 키의 플래그를 확인하는
+ * 심볼(uses_flag 엣지)도 포함된다.
+ */
+export function findExpiredExperiments(
+	graph: SemanticGraph,
+	now: Date = new Date(),
+): ExpiredExperiment[] {
+	const experiments = new Map<
+		string,
+		{ expires?: string; expiresAt?: number; guarded: Set<SemanticNode> }
+	>();
+
+	for (const node of graph.nodes.values()) {
+		const experiment = getExperiment(node);
+		if (!experiment) continue;
+
+		const entry = experiments.get(experiment.name) ?? { guarded: new Set() };
+		entry.guarded.add(node);
+		const expiresAt =
+			experiment.expires !== undefined
+				? parseExpiry(experiment.expires)
+				: undefined;
+		if (
+			expiresAt !== undefined &&
+			(entry.expiresAt === undefined || expiresAt > entry.expiresAt)
+		) {
+			entry.expires = experiment.expires;
+			entry.expiresAt = expiresAt;
+		}
+		experiments.set(experiment.name, entry);
+	}
+
+	const expired: ExpiredExperiment[] = [];
+	for (const [name, entry] of experiments) {
+		if (entry.expiresAt === undefined || now.getTime() < entry.expiresAt) {
+			continue;
+		}
+
+		for (const edge of graph.getIncomingEdges(flagNodeId(name), [
+			"uses_flag",
+		])) {
+			const node = graph.getNode(edge.from);
+			if (node) entry.guarded.add(node);
+		}
+
+		expired.push({
+			name,
+			expires: entry.expires as string,
+			guarded: Array.from(entry.guarded).sort((a, b) =>
+				a.id < b.id ? -1 : a.id > b.id ? 1 : 0,
+			),
+		});
+	}
+
+	return expired.sort((a, b) =>
+		a.name < b.name ? -1 : a.name > b.name ? 1 : 0,
+	);
+}
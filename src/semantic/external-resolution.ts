@@ -0,0 +1,146 @@
+/**
+ * External Resolution
+ * 분석 범위 밖 import(외부 의존성)를 사용자 콜백으로 보강
+ *
+ * 예: 레지스트리 API로 패키지의 저장소 URL, 라이선스 조회
+ *
+ *   const analyzer = new SemanticAnalyzer({
+ *     externalResolver: async (importPath) => {
+ *       const info = await registry.lookup(importPath);
+ *       return { repository: info.repo, license: info.license };
+ *     },
+ *     externalConcurrency: 2,
+ *   });
+ */
+
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** 외부 조회 콜백의 기본 동시 호출 수 */
+export const DEFAULT_EXTERNAL_CONCURRENCY = 4;
+
+/**
+ * 외부 의존성 보강 정보 (metadata.external에 기록)
+ */
+export interface ExternalInfo {
+	/** 저장소 URL */
+	repository?: string;
+	/** 라이선스 식별자 (예: "MIT") */
+	license?: string;
+	[key: string]: unknown;
+}
+
+/**
+ * import 경로 하나를 조회하는 콜백
+ */
+export type ExternalResolver = (
+	importPath: string,
+) => ExternalInfo | Promise<ExternalInfo>;
+
+/**
+ * 조회 실패 표시 (metadata.externalError에 기록)
+ */
+export interface ExternalResolutionError {
+	importPath: string;
+	message: string;
+}
+
+/**
+ * 메모이즈와 동시 호출 제한을 적용한 조회 함수 생성
+ *
+ * 같은 import 경로는 성공/실패와 관계없이 한 번만 콜백을 호출하고, 동시에
+ * 진행 중인 콜백은 concurrency개를 넘지 않는다. 나머지 호출은 들어온
+ * 순서대로 기다린다.
+ */
+export function createExternalResolver(
+	resolver: ExternalResolver,
+	concurrency = DEFAULT_EXTERNAL_CONCURRENCY,
+): (importPath: string) => Promise<ExternalInfo> {
+	if (!Number.isInteger(concurrency) || concurrency < 1) {
+		throw new Error(`Invalid concurrency: ${concurrency}`);
+	}
+
+	const results = new Map<string, Promise<ExternalInfo>>();
+	const waiting: Array<() => void> = [];
+	let active = 0;
+
+	const run = async (importPath: string): Promise<ExternalInfo> => {
+		// 끝난 호출의 자리를 기다리던 호출에 바로 넘겨 새 호출이 끼어들지 않게 한다
+		if (active < concurrency) {
+			active++;
+		} else {
+			await new Promise<void>((resolve) => waiting.push(resolve));
+		}
+		try {
+			return await resolver(importPath);
+		} finally {
+			const next = waiting.shift();
+			if (next) {
+				next();
+			} else {
+				active--;
+			}
+		}
+	};
+
+	return (importPath) => {
+		let result = results.get(importPath);
+		if (!result) {
+			result = run(importPath);
+			results.set(importPath, result);
+		}
+		return result;
+	};
+}
+
+/**
+ * 외부 노드마다 조회 결과를 붙인 새 그래프 생성
+ *
+ * metadata.importPath(없으면 노드 ID)로 조회해 성공하면 metadata.external에,
+ * 실패하면 metadata.externalError에 기록한다. 실패해도 분석은 계속된다.
+ */
+export async function resolveExternals(
+	graph: SemanticGraph,
+	resolve: (importPath: string) => Promise<ExternalInfo>,
+): Promise<SemanticGraph> {
+	const externals = Array.from(graph.nodes.values()).filter(
+		(node) => node.kind === "external",
+	);
+	const resolved = new Map<string, SemanticNode>();
+	await Promise.all(
+		externals.map(async (node) => {
+			const importPath = getImportPath(node);
+			try {
+				const external = await resolve(importPath);
+				resolved.set(node.id, {
+					...node,
+					metadata: { ...node.metadata, external },
+				});
+			} catch (error) {
+				const externalError: ExternalResolutionError = {
+					importPath,
+					message: error instanceof Error ? error.message : String(error),
+				};
+				resolved.set(node.id, {
+					...node,
+					metadata: { ...node.metadata, externalError },
+				});
+			}
+		}),
+	);
+
+	const result = new SemanticGraph();
+	for (const node of graph.nodes.values()) {
+		result.addNode(resolved.get(node.id) ?? node);
+	}
+	for (const edge of graph.edges) {
+		result.addEdge(edge);
+	}
+	return result;
+}
+
+function getImportPath(node: SemanticNode): string {
+	return typeof node.metadata.importPath === "string"
+		? node.metadata.importPath
+		: node.id;
+}
@@ -0,0 +1,277 @@
+/**
+ * Extraction Cache
+ * 파일 내용 해시 기반 추출 결과 캐시 (증분 재분석용)
+ *
+ * ExtractionCache는 파일 경로별 항목을 JSON 파일 하나에 저장하는 로컬
+ * 캐시이고, CacheBackend는 CI 작업끼리 공유할 수 있는 키 기반 저장소
+ * (Redis, S3 등)를 붙이는 확장점이다. 기본 구현은 FileCacheBackend다.
+ *
+ * 사용자 정의 백엔드 예:
+ *
+ *   const backend: CacheBackend = {
+ *     async get(key) {
+ *       const value = await redis.get(`linker:${key}`);
+ *       return value ? JSON.parse(value) : undefined;
+ *     },
+ *     async put(key, extraction) {
+ *       await redis.set(`linker:${key}`, JSON.stringify(extraction));
+ *     },
+ *     async prune() {}, // 만료 시간(TTL)에 맡김
+ *   };
+ *   new SemanticAnalyzer({ cacheBackend: backend });
+ */
+
+import * as crypto from "node:crypto";
+import { type Dirent, promises as fs } from "node:fs";
+import * as path from "node:path";
+import type { FileExtraction } from "./extractors/LanguageExtractor";
+
+/** 캐시 파일 형식 버전 (추출 결과 형식이 바뀌면 올림) */
+export const EXTRACTION_CACHE_VERSION = 10;
+
+/**
+ * 캐시 항목
+ */
+export interface ExtractionCacheEntry {
+	/** 파일 내용 sha256 */
+	hash: string;
+	/** 추출 당시 분석기 버전 (형식 버전 + 추출기 목록) */
+	analyzerVersion: string;
+	extraction: FileExtraction;
+}
+
+/**
+ * 캐시 적중 통계
+ */
+export interface ExtractionCacheStats {
+	hits: number;
+	misses: number;
+	/** 삭제된 파일이라 제거한 항목 수 */
+	pruned: number;
+}
+
+interface ExtractionCacheFile {
+	version: number;
+	entries: Record<string, ExtractionCacheEntry>;
+}
+
+/**
+ * 파일 내용 sha256 해시
+ */
+export function hashContent(content: string): string {
+	return crypto.createHash("sha256").update(content).digest("hex");
+}
+
+/**
+ * 추출 결과 캐시 키 (파일 경로 + 내용 해시 + 분석기 버전의 sha256)
+ *
+ * 노드 ID와 파일 경로가 추출 결과에 들어가므로 내용이 같아도 경로가 다르면
+ * 다른 키다.
+ */
+export function extractionCacheKey(
+	filePath: string,
+	contentHash: string,
+	analyzerVersion: string,
+): string {
+	return crypto
+		.createHash("sha256")
+		.update([filePath, contentHash, analyzerVersion].join("\0"))
+		.digest("hex");
+}
+
+/**
+ * 키 기반 추출 결과 저장소
+ *
+ * 분석기는 파일마다 get으로 조회하고, 미스면 파싱한 결과의 복사본을 put
+ * 한다. 워커 풀의 여러 파일에 대해 get/put이 동시에 호출될 수 있으므로
+ * 동시성 안전은 구현이 책임진다 (같은 키를 동시에 put해도 결과는 같다).
+ * prune은 analyzeDirectory가 중단 없이 끝난 뒤 모든 put이 끝난 상태에서
+ * 그 실행이 사용한 키로 한 번 호출한다. 여러 작업이 나눠 쓰는 저장소는
+ * 다른 작업의 항목을 지우지 않도록 아무것도 하지 않아도 된다.
+ * 예외는 분석 실패로 전파되므로, 장애를 미스로 보려면 구현에서 잡는다.
+ */
+export interface CacheBackend {
+	/**
+	 * 키의 추출 결과 (없으면 undefined)
+	 *
+	 * 그래프 병합이 결과를 수정할 수 있으므로 메모리에 보관하는 구현은
+	 * 복사본을 돌려준다.
+	 */
+	get(key: string): Promise<FileExtraction | undefined>;
+	put(key: string, extraction: FileExtraction): Promise<void>;
+	/** liveKeys에 없는 항목 제거 */
+	prune(liveKeys: ReadonlySet<string>): Promise<void>;
+}
+
+/**
+ * 디렉토리에 키마다 JSON 파일 하나로 저장하는 기본 백엔드
+ *
+ * 임시 파일에 쓴 뒤 이름을 바꾸므로, 같은 디렉토리를 공유하는 여러
+ * 프로세스가 반쯤 쓰인 항목을 읽지 않는다.
+ */
+export class FileCacheBackend implements CacheBackend {
+	constructor(readonly directory: string) {}
+
+	async get(key: string): Promise<FileExtraction | undefined> {
+		try {
+			const content = await fs.readFile(this.entryPath(key), "utf-8");
+			return JSON.parse(content) as FileExtraction;
+		} catch (error) {
+			if ((error as NodeJS.ErrnoException).code === "ENOENT") {
+				return undefined;
+			}
+			throw error;
+		}
+	}
+
+	async put(key: string, extraction: FileExtraction): Promise<void> {
+		const entryPath = this.entryPath(key);
+		const tempPath = `${entryPath}.${process.pid}.${crypto.randomUUID()}.tmp`;
+		await fs.mkdir(path.dirname(entryPath), { recursive: true });
+		await fs.writeFile(tempPath, JSON.stringify(extraction), "utf-8");
+		await fs.rename(tempPath, entryPath);
+	}
+
+	async prune(liveKeys: ReadonlySet<string>): Promise<void> {
+		let shards: Dirent[];
+		try {
+			shards = await fs.readdir(this.directory, { withFileTypes: true });
+		} catch (error) {
+			if ((error as NodeJS.ErrnoException).code === "ENOENT") return;
+			throw error;
+		}
+
+		for (const shard of shards) {
+			if (!shard.isDirectory()) continue;
+			const shardPath = path.join(this.directory, shard.name);
+			for (const file of await fs.readdir(shardPath)) {
+				if (!file.endsWith(".json") || liveKeys.has(file.slice(0, -5))) {
+					continue;
+				}
+				await fs.rm(path.join(shardPath, file), { force: true });
+			}
+		}
+	}
+
+	/** 디렉토리 하나에 파일이 몰리지 않도록 키 앞 두 글자로 나눈 경로 */
+	private entryPath(key: string): string {
+		return path.join(this.directory, key.slice(0, 2), `${key}.json`);
+	}
+}
+
+/**
+ * 파일 경로별 추출 결과 캐시
+ *
+ * 내용 해시와 분석기 버전이 모두 같을 때만 적중으로 본다. 그래프 병합
+ * 중의 변경이 캐시에 스며들지 않도록 저장/조회 시 복사본을 사용한다.
+ */
+export class ExtractionCache {
+	readonly stats: ExtractionCacheStats = { hits: 0, misses: 0, pruned: 0 };
+	private entries: Map<string, ExtractionCacheEntry>;
+
+	constructor(entries: Record<string, ExtractionCacheEntry> = {}) {
+		this.entries = new Map(Object.entries(entries));
+	}
+
+	/**
+	 * 캐시 파일 로드 (파일이 없거나 형식 버전이 다르면 빈 캐시)
+	 */
+	static async load(filePath: string): Promise<ExtractionCache> {
+		let content: string;
+		try {
+			content = await fs.readFile(filePath, "utf-8");
+		} catch (error) {
+			if ((error as NodeJS.ErrnoException).code === "ENOENT") {
+				return new ExtractionCache();
+			}
+			throw error;
+		}
+
+		const data = JSON.parse(content) as ExtractionCacheFile;
+		return data.version === EXTRACTION_CACHE_VERSION
+			? new ExtractionCache(data.entries)
+			: new ExtractionCache();
+	}
+
+	/**
+	 * 캐시를 하나의 JSON 파일로 저장
+	 */
+	async save(filePath: string): Promise<void> {
+		const entries: Record<string, ExtractionCacheEntry> = {};
+		for (const key of Array.from(this.entries.keys()).sort()) {
+			entries[key] = this.entries.get(key) as ExtractionCacheEntry;
+		}
+		const data: ExtractionCacheFile = {
+			version: EXTRACTION_CACHE_VERSION,
+			entries,
+		};
+
+		await fs.mkdir(path.dirname(filePath), { recursive: true });
+		await fs.writeFile(filePath, JSON.stringify(data), "utf-8");
+	}
+
+	/**
+	 * 캐시된 추출 결과 조회 (적중/미스를 stats에 기록)
+	 */
+	get(
+		filePath: string,
+		hash: string,
+		analyzerVersion: string,
+	): FileExtraction | undefined {
+		const entry = this.entries.get(filePath);
+		if (
+			entry &&
+			entry.hash === hash &&
+			entry.analyzerVersion === analyzerVersion
+		) {
+			this.stats.hits++;
+			return structuredClone(entry.extraction);
+		}
+		this.stats.misses++;
+		return undefined;
+	}
+
+	set(
+		filePath: string,
+		hash: string,
+		analyzerVersion: string,
+		extraction: FileExtraction,
+	): void {
+		this.entries.set(filePath, {
+			hash,
+			analyzerVersion,
+			extraction: structuredClone(extraction),
+		});
+	}
+
+	/**
+	 * 파일 항목 제거 (항목이 있었으면 true)
+	 */
+	delete(filePath: string): boolean {
+		return this.entries.delete(filePath);
+	}
+
+	/**
+	 * 목록에 없는 파일의 항목 제거
+	 */
+	prune(existingPaths: Iterable<string>): string[] {
+		const existing = new Set(existingPaths);
+		const removed: string[] = [];
+		for (const filePath of this.entries.keys()) {
+			if (!existing.has(filePath)) removed.push(filePath);
+		}
+		for (const filePath of removed) {
+			this.entries.delete(filePath);
+		}
+		this.stats.pruned += removed.length;
+		return removed.sort();
+	}
+
+	has(filePath: string): boolean {
+		return this.entries.has(filePath);
+	}
+
+	get size(): number {
+		return this.entries.size;
+	}
+}
@@ -0,0 +1,187 @@
+/**
+ * Extraction Pool
+ * tree-sitter 파싱과 추출을 worker thread에 나눠 CPU 코어를 함께 사용
+ */
+
+import { existsSync } from "node:fs";
+import path from "node:path";
+import { Worker } from "node:worker_threads";
+import type { FileExtraction } from "./extractors/LanguageExtractor";
+
+/**
+ * worker가 분석기를 만들 때 쓰는 옵션 (worker로 복사 가능한 값만)
+ */
+export interface ExtractionWorkerOptions {
+	maxFileBytes?: number;
+	parseTimeoutMs?: number;
+}
+
+/** 메인 스레드 -> worker 요청 */
+export interface ExtractionRequest {
+	id: number;
+	sourceCode: string;
+	filePath: string;
+}
+
+/** worker -> 메인 스레드 응답 */
+export type ExtractionResponse =
+	| { id: number; extraction: FileExtraction }
+	| { id: number; error: string };
+
+/**
+ * worker 하나가 맡기에 충분한 최소 파일 수
+ *
+ * worker를 띄우는 비용(모듈 로드, 파서 초기화)보다 파싱이 길어야 이득이므로
+ * 파일이 적으면 메인 스레드에서 파싱한다.
+ */
+export const MIN_FILES_PER_WORKER = 16;
+
+interface Task {
+	request: ExtractionRequest;
+	resolve: (extraction: FileExtraction) => void;
+	reject: (error: Error) => void;
+}
+
+interface PoolWorker {
+	worker: Worker;
+	task?: Task;
+	/** worker가 죽은 원인 ("exit" 전에 "error"로 전달됨) */
+	error?: Error;
+}
+
+/**
+ * 고정 크기 worker thread 풀
+ *
+ * 각 worker는 기본 추출기로 만든 자체 분석기를 가지고 한 번에 파일 하나를
+ * 분석한다 (tree-sitter 파싱은 동기이므로 같은 스레드에서는 겹치지 않는다).
+ * 결과는 구조화 복제로 돌아오며, 순서는 호출자가 정한다.
+ * worker가 죽으면 맡은 작업만 실패시키고 새 worker로 교체한다. 작업을 끝내지
+ * 못하고 죽는 일이 풀 크기보다 많이 이어지면(worker를 띄울 수 없는 환경 등)
+ * 풀을 닫고 남은 작업을 모두 실패 처리한다.
+ * 다 쓴 뒤 close를 호출해야 프로세스가 종료될 수 있다.
+ */
+export class ExtractionPool {
+	private workers: PoolWorker[] = [];
+	private queue: Task[] = [];
+	private nextId = 0;
+	private closed = false;
+	/** 작업을 끝내지 못하고 연달아 죽은 worker 수 */
+	private crashes = 0;
+	private options: ExtractionWorkerOptions;
+
+	constructor(size: number, options: ExtractionWorkerOptions = {}) {
+		if (!Number.isInteger(size) || size < 1) {
+			throw new Error(`Invalid worker count: ${size}`);
+		}
+		this.options = options;
+		for (let i = 0; i < size; i++) {
+			this.workers.push(this.spawn());
+		}
+	}
+
+	get size(): number {
+		return this.workers.length;
+	}
+
+	/**
+	 * worker에서 소스 분석 (SemanticAnalyzer.analyzeSource와 같은 결과)
+	 */
+	analyze(sourceCode: string, filePath: string): Promise<FileExtraction> {
+		if (this.closed) {
+			return Promise.reject(new Error("Extraction pool is closed"));
+		}
+		return new Promise((resolve, reject) => {
+			this.queue.push({
+				request: { id: this.nextId++, sourceCode, filePath },
+				resolve,
+				reject,
+			});
+			this.dispatch();
+		});
+	}
+
+	/**
+	 * 모든 worker 종료 (대기 중인 작업은 실패 처리)
+	 */
+	async close(): Promise<void> {
+		this.fail(new Error("Extraction pool is closed"));
+		await Promise.all(this.workers.map((entry) => entry.worker.terminate()));
+	}
+
+	private spawn(): PoolWorker {
+		const { script, execArgv } = resolveWorkerScript();
+		const entry: PoolWorker = {
+			worker: new Worker(script, { workerData: this.options, execArgv }),
+		};
+
+		entry.worker.on("message", (response: ExtractionResponse) => {
+			const task = entry.task;
+			entry.task = undefined;
+			this.crashes = 0;
+			if (task && task.request.id === response.id) {
+				if ("error" in response) {
+					task.reject(new Error(response.error));
+				} else {
+					task.resolve(response.extraction);
+				}
+			}
+			this.dispatch();
+		});
+		entry.worker.on("error", (error) => {
+			entry.error = error;
+		});
+		entry.worker.on("exit", (code) => {
+			if (this.closed) return;
+			const error =
+				entry.error ?? new Error(`Extraction worker exited with code ${code}`);
+			entry.task?.reject(error);
+			entry.task = undefined;
+
+			if (++this.crashes > this.workers.length) {
+				this.fail(error);
+				return;
+			}
+			this.workers[this.workers.indexOf(entry)] = this.spawn();
+			this.dispatch();
+		});
+		return entry;
+	}
+
+	/**
+	 * 풀을 닫고 진행 중이거나 대기 중인 작업을 모두 실패 처리
+	 */
+	private fail(error: Error): void {
+		this.closed = true;
+		for (const task of this.queue.splice(0)) {
+			task.reject(error);
+		}
+		for (const entry of this.workers) {
+			entry.task?.reject(error);
+			entry.task = undefined;
+		}
+	}
+
+	private dispatch(): void {
+		for (const entry of this.workers) {
+			if (this.queue.length === 0) return;
+			if (entry.task) continue;
+			const task = this.queue.shift() as Task;
+			entry.task = task;
+			entry.worker.postMessage(task.request);
+		}
+	}
+}
+
+/**
+ * worker 스크립트 경로 (빌드 결과는 .js, ts-node/ts-jest 실행은 .ts)
+ */
+function resolveWorkerScript(): { script: string; execArgv?: string[] } {
+	const compiled = path.join(__dirname, "extraction-worker.js");
+	if (existsSync(compiled)) {
+		return { script: compiled };
+	}
+	return {
+		script: path.join(__dirname, "extraction-worker.ts"),
+		execArgv: ["--require", "ts-node/register/transpile-only"],
+	};
+}
@@ -0,0 +1,30 @@
+/**
+ * Extraction Worker
+ * ExtractionPool의 worker thread 진입점 (파일 하나씩 파싱/추출)
+ */
+
+import { parentPort, workerData } from "node:worker_threads";
+import type {
+	ExtractionRequest,
+	ExtractionResponse,
+	ExtractionWorkerOptions,
+} from "./extraction-pool";
+import { SemanticAnalyzer } from "./SemanticAnalyzer";
+
+const analyzer = new SemanticAnalyzer(workerData as ExtractionWorkerOptions);
+
+parentPort?.on("message", async (request: ExtractionRequest) => {
+	let response: ExtractionResponse;
+	try {
+		response = {
+			id: request.id,
+			extraction: await analyzer.analyzeSource(
+				request.sourceCode,
+				request.filePath,
+			),
+		};
+	} catch (error) {
+		response = { id: request.id, error: (error as Error).message };
+	}
+	parentPort?.postMessage(response);
+});
@@ -0,0 +1,1000 @@
+/**
+ * Go Extractor
+ * Go 소스에서 패키지/함수/메서드/타입 심볼과 호출 관계 추출
+ */
+
+import path from "node:path";
+import type Parser from "tree-sitter";
+import { parseDocComments, stripCommentMarkers } from "../annotations";
+import { parseCachePolicy } from "../caching";
+import { parseClassification } from "../classification";
+import { computeComplexity, GO_COMPLEXITY_GRAMMAR } from "../complexity";
+import { parseDeprecation } from "../deprecation";
+import { parseScope } from "../di-scopes";
+import { analyzeErrorHandling } from "../error-handling";
+import { parseExperiment } from "../experiments";
+import { parseRateLimit } from "../rate-limit";
+import { parseResiliencePolicy } from "../resilience";
+import type { SemanticGraph } from "../SemanticGraph";
+import { parseSlaPolicy } from "../sla";
+import { setSourceRanges } from "../source-range";
+import type { CallSite, SemanticEdge, SemanticNode } from "../types";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+/** 패키지 함수로 해석하지 않는 Go 내장 함수 */
+const GO_BUILTINS = new Set([
+	"append",
+	"cap",
+	"clear",
+	"close",
+	"complex",
+	"copy",
+	"delete",
+	"imag",
+	"len",
+	"make",
+	"max",
+	"min",
+	"new",
+	"panic",
+	"print",
+	"println",
+	"real",
+	"recover",
+]);
+
+/** 타입 표현 안의 한정되지 않은 이름 (`context.Context`의 두 부분은 제외) */
+const TYPE_NAME_PATTERN = /(?<![\w.])[A-Za-z_]\w*(?![\w.])/g;
+
+/** 같은 패키지 타입으로 해석하지 않는 Go 내장 타입과 타입 키워드 */
+const GO_BUILTIN_TYPES = new Set([
+	"any",
+	"bool",
+	"byte",
+	"chan",
+	"comparable",
+	"complex64",
+	"complex128",
+	"error",
+	"float32",
+	"float64",
+	"func",
+	"int",
+	"int8",
+	"int16",
+	"int32",
+	"int64",
+	"interface",
+	"map",
+	"rune",
+	"string",
+	"struct",
+	"uint",
+	"uint8",
+	"uint16",
+	"uint32",
+	"uint64",
+	"uintptr",
+]);
+
+/**
+ * Go 심볼 추출기
+ */
+export class GoExtractor implements LanguageExtractor {
+	readonly name = "go-symbols";
+	readonly language = "go";
+	readonly extensions = ["go"];
+	readonly requiresTree = true;
+
+	extract(context: ExtractionContext): FileExtraction {
+		if (!context.tree) {
+			throw new Error(
+				`Go extraction requires a syntax tree: ${context.filePath}`,
+			);
+		}
+
+		const root = context.tree.rootNode;
+		const pkg = resolveGoPackage(
+			context.filePath,
+			findPackageName(root) ?? "main",
+		);
+		const nodes: SemanticNode[] = [];
+		const edges: SemanticEdge[] = [];
+
+		const clause = root.namedChildren.find((n) => n.type === "package_clause");
+		if (clause) {
+			nodes.push(
+				createNode(
+					"package",
+					pkg.name,
+					pkg.id,
+					clause,
+					context,
+					pkg,
+					clause.namedChildren.find((n) => n.type === "package_identifier"),
+				),
+			);
+		}
+
+		// 구문 오류로 ERROR 노드에 감싸인 선언도 추출 (부분 결과)
+		const declarations = root.namedChildren.flatMap((child) =>
+			child.type === "ERROR" ? child.namedChildren : [child],
+		);
+		for (const child of declarations) {
+			switch (child.type) {
+				case "function_declaration":
+				case "method_declaration": {
+					const node = this.createCallableNode(child, context, pkg);
+					if (node) {
+						nodes.push(node);
+						edges.push(...this.createCallEdges(node, pkg));
+						edges.push(...this.createTypeRefEdges(node, pkg));
+						// 값/포인터 리시버 모두 같은 타입 노드에 속함
+						if (node.metadata.receiverType) {
+							edges.push({
+								from: `${pkg.id}.${node.metadata.receiverType}`,
+								to: node.id,
+								type: "contains",
+							});
+						}
+					}
+					break;
+				}
+				case "const_declaration":
+					for (const spec of child.namedChildren) {
+						if (spec.type === "const_spec") {
+							const constants = this.createConstantNodes(
+								spec,
+								child,
+								context,
+								pkg,
+							);
+							nodes.push(...constants);
+						}
+					}
+					break;
+				case "type_declaration":
+					for (const spec of child.namedChildren) {
+						if (spec.type === "type_spec" || spec.type === "type_alias") {
+							const node = this.createTypeNode(
+								spec,
+								child,
+								context,
+								pkg,
+							);
+							if (!node) continue;
+							nodes.push(node);
+
+							const typeNode = spec.childForFieldName("type");
+							if (typeNode?.type === "struct_type") {
+								for (const field of this.createFieldNodes(
+									typeNode,
+									node,
+									context,
+									pkg,
+								)) {
+									nodes.push(field);
+									edges.push({
+										from: node.id,
+										to: field.id,
+										type: "contains",
+									});
+								}
+							}
+						}
+					}
+					break;
+			}
+		}
+
+		// 패키지는 최상위 선언만 포함 (메서드는 리시버 타입, 필드는 구조체에 속함)
+		if (clause) {
+			for (const node of nodes) {
+				if (
+					node.kind !== "package" &&
+					node.kind !== "field" &&
+					!node.metadata.receiverType
+				) {
+					edges.push({ from: pkg.id, to: node.id, type: "contains" });
+				}
+			}
+		}
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes,
+			edges,
+		};
+	}
+
+	/**
+	 * 분석한 인터페이스를 만족하는 구체 타입에 "implements" 엣지 추가
+	 *
+	 * 매개변수 이름을 뺀 메서드 이름과 시그니처가 인터페이스의 모든 메서드와
+	 * 일치해야 하며, 같은 패키지의 임베디드 인터페이스 메서드도 포함한다.
+	 * 값 리시버 메서드만으로 만족하면 metadata.pointer가 false, 포인터
+	 * 리시버 메서드까지 필요하면 true다. 메서드가 없는 인터페이스는 모든
+	 * 타입이 만족하므로 연결하지 않는다. 타입 이름은 원문 그대로 비교하므로
+	 * 패키지 한정자가 다르게 쓰인 경우는 일치하지 않는다.
+	 */
+	link(graph: SemanticGraph): void {
+		const nodes = Array.from(graph.nodes.values()).filter(
+			(node) => node.language === this.language,
+		);
+		const interfaces = nodes.filter((node) => node.kind === "interface");
+		const concreteTypes = nodes.filter(
+			(node) => node.kind === "struct" || node.kind === "type",
+		);
+
+		for (const iface of interfaces) {
+			const required = collectInterfaceMethods(graph, iface, new Set());
+			if (required.size === 0) continue;
+
+			for (const concrete of concreteTypes) {
+				const valueMethods = new Set<string>();
+				const pointerMethods = new Set<string>();
+				for (const edge of graph.getOutgoingEdges(concrete.id, [
+					"contains",
+				])) {
+					const method = graph.getNode(edge.to);
+					const shape = method?.metadata.methodShape as string | undefined;
+					if (!method || shape === undefined) continue;
+					pointerMethods.add(shape);
+					if (!method.metadata.pointerReceiver) valueMethods.add(shape);
+				}
+
+				const satisfiedBy = (methods: Set<string>) =>
+					Array.from(required).every((shape) => methods.has(shape));
+				if (satisfiedBy(pointerMethods)) {
+					graph.addEdge({
+						from: concrete.id,
+						to: iface.id,
+						type: "implements",
+						metadata: { pointer: !satisfiedBy(valueMethods) },
+					});
+				}
+			}
+		}
+	}
+
+	/**
+	 * 함수/메서드 노드 생성
+	 */
+	private createCallableNode(
+		declaration: Parser.SyntaxNode,
+		context: ExtractionContext,
+		pkg: GoPackage,
+	): SemanticNode | null {
+		const nameNode = declaration.childForFieldName("name");
+		if (!nameNode) return null;
+
+		const name = nameNode.text;
+		const isMethod = declaration.type === "method_declaration";
+		const receiver = isMethod ? parseReceiver(declaration) : null;
+		const fqn = receiver
+			? `${pkg.id}.${receiver.typeName}.${name}`
+			: `${pkg.id}.${name}`;
+
+		const body = declaration.childForFieldName("body");
+		const callSites = body ? collectCallSites(body) : [];
+
+		const node = createNode(
+			isMethod ? "method" : "function",
+			name,
+			fqn,
+			declaration,
+			context,
+			pkg,
+			nameNode,
+		);
+		node.metadata.callSites = callSites;
+		node.metadata.signature = formatSignature(declaration);
+		if (body) {
+			node.metadata.complexity = computeComplexity(
+				declaration,
+				body,
+				GO_COMPLEXITY_GRAMMAR,
+			);
+			node.metadata.errorHandling = analyzeErrorHandling(body, callSites);
+		}
+		if (isMethod) {
+			node.metadata.methodShape = formatMethodShape(
+				name,
+				declaration.childForFieldName("parameters"),
+				declaration.childForFieldName("result"),
+			);
+		}
+		node.metadata.parameters = parseParameters(declaration);
+		node.metadata.results = listResultTypes(
+			declaration.childForFieldName("result"),
+		);
+		node.metadata.panics = callSites.some((site) => site.callee === "panic");
+		node.metadata.recovers = callSites.some(
+			(site) => site.callee === "recover",
+		);
+		if (receiver) {
+			node.metadata.receiverType = receiver.typeName;
+			node.metadata.receiverName = receiver.name;
+			node.metadata.pointerReceiver = receiver.pointer;
+			if (body && receiver.name && receiver.name !== "_") {
+				node.metadata.usesReceiver = referencesIdentifier(body, receiver.name);
+			}
+		}
+
+		const resilience = parseResiliencePolicy(node.metadata.annotations);
+		if (resilience) {
+			node.metadata.resilience = resilience;
+		}
+		const cache = parseCachePolicy(node.metadata.annotations);
+		if (cache) {
+			node.metadata.cache = cache;
+		}
+		const scope = parseScope(node.metadata.annotations);
+		if (scope) {
+			node.metadata.scope = scope;
+		}
+		const rateLimit = parseRateLimit(node.metadata.annotations);
+		if (rateLimit) {
+			node.metadata.rateLimit = rateLimit;
+		}
+		const sla = parseSlaPolicy(node.metadata.annotations);
+		if (sla) {
+			node.metadata.sla = sla;
+		}
+
+		return node;
+	}
+
+	/**
+	 * 타입 노드 생성 (struct, interface, 기타 타입)
+	 */
+	private createTypeNode(
+		spec: Parser.SyntaxNode,
+		declaration: Parser.SyntaxNode,
+		context: ExtractionContext,
+		pkg: GoPackage,
+	): SemanticNode | null {
+		const nameNode = spec.childForFieldName("name");
+		if (!nameNode) return null;
+
+		const typeNode = spec.childForFieldName("type");
+		let kind = "type";
+		if (typeNode?.type === "struct_type") kind = "struct";
+		else if (typeNode?.type === "interface_type") kind = "interface";
+
+		// 괄호로 묶인 type 블록은 각 spec의 주석을, 단일 선언은 선언의 주석을 사용
+		const docAnchor = declaration.namedChildCount > 1 ? spec : declaration;
+		const node = createNode(
+			kind,
+			nameNode.text,
+			`${pkg.id}.${nameNode.text}`,
+			docAnchor,
+			context,
+			pkg,
+			nameNode,
+		);
+		node.line = spec.startPosition.row + 1;
+
+		const classification = parseClassification(node.metadata.annotations);
+		if (classification) {
+			node.metadata.classification = classification;
+		}
+		if (typeNode?.type === "interface_type") {
+			const { methods, embedded } = parseInterfaceElements(typeNode);
+			node.metadata.interfaceMethods = methods;
+			node.metadata.embeddedInterfaces = embedded;
+		}
+		return node;
+	}
+
+	/**
+	 * struct 필드 노드 생성 (field_declaration 하나에 여러 이름이 올 수 있음)
+	 *
+	 * 필드 타입은 metadata.fieldType에, 구조체 태그는 키 -> 값 맵으로
+	 * metadata.structTags에 기록한다. 임베디드 필드는 이름이 없으므로 타입
+	 * 이름을 노드 이름으로 쓰고 metadata.embedded를 표시한다.
+	 */
+	private createFieldNodes(
+		structType: Parser.SyntaxNode,
+		parent: SemanticNode,
+		context: ExtractionContext,
+		pkg: GoPackage,
+	): SemanticNode[] {
+		const list = structType.namedChildren.find(
+			(n) => n.type === "field_declaration_list",
+		);
+		const fields: SemanticNode[] = [];
+
+		for (const declaration of list?.namedChildren ?? []) {
+			if (declaration.type !== "field_declaration") continue;
+
+			const typeNode = declaration.childForFieldName("type");
+			const tagNode = declaration.childForFieldName("tag");
+			const tagValue = tagNode ? parseStringLiteral(tagNode) : undefined;
+			const structTags = tagValue ? parseStructTag(tagValue) : {};
+			const names = declaration.namedChildren.filter(
+				(n) => n.type === "field_identifier",
+			);
+
+			const embedded = names.length === 0;
+			const fieldNames = embedded
+				? [{ name: embeddedFieldName(typeNode?.text ?? ""), node: typeNode }]
+				: names.map((n) => ({ name: n.text, node: n }));
+
+			for (const { name, node: nameNode } of fieldNames) {
+				if (!name) continue;
+				const node = createNode(
+					"field",
+					name,
+					`${parent.fqn}.${name}`,
+					declaration,
+					context,
+					pkg,
+					nameNode,
+				);
+				node.metadata.fieldType = typeNode?.text;
+				node.metadata.structTags = structTags;
+				if (embedded) {
+					node.metadata.embedded = true;
+				}
+				fields.push(node);
+			}
+		}
+
+		return fields;
+	}
+
+	/**
+	 * 상수 노드 생성 (const_spec 하나에 여러 이름이 올 수 있음)
+	 *
+	 * 값 식의 원본은 metadata.valueExpression에, 문자열 리터럴이면
+	 * 따옴표를 제거한 값을 metadata.value에 기록한다.
+	 */
+	private createConstantNodes(
+		spec: Parser.SyntaxNode,
+		declaration: Parser.SyntaxNode,
+		context: ExtractionContext,
+		pkg: GoPackage,
+	): SemanticNode[] {
+		const names = spec.namedChildren.filter((n) => n.type === "identifier");
+		const values = spec.childForFieldName("value")?.namedChildren ?? [];
+		const docAnchor = declaration.namedChildCount > 1 ? spec : declaration;
+
+		return names.map((nameNode, index) => {
+			const node = createNode(
+				"constant",
+				nameNode.text,
+				`${pkg.id}.${nameNode.text}`,
+				docAnchor,
+				context,
+				pkg,
+				nameNode,
+			);
+			node.line = spec.startPosition.row + 1;
+
+			const value = values[index];
+			if (value) {
+				node.metadata.valueExpression = value.text;
+				const literal = parseStringLiteral(value);
+				if (literal !== undefined) {
+					node.metadata.value = literal;
+				}
+			}
+			return node;
+		});
+	}
+
+	/**
+	 * 호출 위치를 같은 패키지 심볼에 대한 calls 엣지로 변환
+	 *
+	 * 식별자 호출(`Foo()`)과 리시버 메서드 호출(`s.Method()`)만 해석하며,
+	 * 그래프 빌드 시 대상 노드가 없는 엣지는 버려진다.
+	 */
+	private createCallEdges(
+		node: SemanticNode,
+		pkg: GoPackage,
+	): SemanticEdge[] {
+		const edges: SemanticEdge[] = [];
+		const callSites = node.metadata.callSites as CallSite[];
+		const receiverName = node.metadata.receiverName as string | undefined;
+		const receiverType = node.metadata.receiverType as string | undefined;
+
+		for (const site of callSites) {
+			let target: string | null = null;
+			if (/^[A-Za-z_]\w*$/.test(site.callee)) {
+				if (!GO_BUILTINS.has(site.callee)) {
+					target = `${pkg.id}.${site.callee}`;
+				}
+			} else if (receiverName && receiverType) {
+				const match = site.callee.match(/^([A-Za-z_]\w*)\.([A-Za-z_]\w*)$/);
+				if (match && match[1] === receiverName) {
+					target = `${pkg.id}.${receiverType}.${match[2]}`;
+				}
+			}
+
+			if (target && target !== node.id) {
+				edges.push({
+					from: node.id,
+					to: target,
+					type: "calls",
+					metadata: { line: site.line },
+				});
+			}
+		}
+
+		return edges;
+	}
+
+	/**
+	 * 매개변수/반환 타입에 쓰인 같은 패키지 타입으로 references 엣지 생성
+	 *
+	 * 패키지 한정 타입(`context.Context`)과 내장 타입은 제외하며, 리시버
+	 * 타입은 contains 관계이므로 포함하지 않는다.
+	 */
+	private createTypeRefEdges(
+		node: SemanticNode,
+		pkg: GoPackage,
+	): SemanticEdge[] {
+		const types = [
+			...(node.metadata.parameters as Array<{ type: string }>).map(
+				(parameter) => parameter.type,
+			),
+			...(node.metadata.results as string[]),
+		];
+		const targets = new Set<string>();
+		for (const type of types) {
+			const names = type.replace(/^\.\.\./, "").matchAll(TYPE_NAME_PATTERN);
+			for (const match of names) {
+				if (!GO_BUILTIN_TYPES.has(match[0])) {
+					targets.add(`${pkg.id}.${match[0]}`);
+				}
+			}
+		}
+
+		return Array.from(targets)
+			.filter((target) => target !== node.id)
+			.map((target) => ({
+				from: node.id,
+				to: target,
+				type: "references",
+				metadata: { line: node.line },
+			}));
+	}
+}
+
+/**
+ * 파일이 속한 Go 패키지 (선언된 이름과 패키지 노드 ID)
+ */
+export interface GoPackage {
+	name: string;
+	/** 패키지 노드 ID이자 심볼 FQN 접두사 */
+	id: string;
+}
+
+/**
+ * 파일 경로와 선언된 이름으로 Go 패키지 결정
+ *
+ * 같은 이름의 패키지(main, config 등)가 여러 디렉토리에 있을 수 있으므로
+ * import 경로처럼 파일의 디렉토리를 ID로 쓴다 ("internal/config").
+ * 루트 디렉토리의 파일은 선언된 이름을, 외부 테스트 패키지(`_test`)는
+ * 디렉토리 뒤에 "_test"를 붙인 ID를 쓴다.
+ */
+export function resolveGoPackage(filePath: string, name: string): GoPackage {
+	const directory = path.posix.dirname(filePath.replace(/\\/g, "/"));
+	if (directory === ".") {
+		return { name, id: name };
+	}
+	return { name, id: name.endsWith("_test") ? `${directory}_test` : directory };
+}
+
+/**
+ * Go 노드가 속한 패키지 노드 ID
+ *
+ * metadata.packageId가 없는 노드(직접 만든 그래프 등)는 패키지 이름을 쓴다.
+ */
+export function getGoPackageId(node: SemanticNode): string | undefined {
+	return (node.metadata.packageId ?? node.metadata.package) as
+		| string
+		| undefined;
+}
+
+/**
+ * package 절에서 패키지 이름 추출
+ */
+export function findPackageName(root: Parser.SyntaxNode): string | null {
+	const clause = root.namedChildren.find((n) => n.type === "package_clause");
+	const identifier = clause?.namedChildren.find(
+		(n) => n.type === "package_identifier",
+	);
+	return identifier?.text ?? null;
+}
+
+/**
+ * Go 문자열 리터럴 값 (문자열 리터럴이 아니면 undefined)
+ */
+export function parseStringLiteral(node: Parser.SyntaxNode): string | undefined {
+	if (node.type === "raw_string_literal") {
+		return node.text.slice(1, -1);
+	}
+	if (node.type === "interpreted_string_literal") {
+		try {
+			return JSON.parse(node.text) as string;
+		} catch {
+			return node.text.slice(1, -1);
+		}
+	}
+	return undefined;
+}
+
+/**
+ * 구조체 태그 파싱 (`json:"email" db:"email"` -> { json: "email", db: "email" })
+ */
+export function parseStructTag(tag: string): Record<string, string> {
+	const tags: Record<string, string> = {};
+	for (const match of tag.matchAll(/([^\s:"]+):"((?:[^"\\]|\\.)*)"/g)) {
+		if (!Object.prototype.hasOwnProperty.call(tags, match[1])) {
+			tags[match[1]] = match[2].replace(/\\(.)/g, "$1");
+		}
+	}
+	return tags;
+}
+
+/**
+ * 임베디드 필드 이름 (`*pkg.Base[T]` -> "Base")
+ */
+function embeddedFieldName(typeText: string): string {
+	const base = typeText.replace(/^\*/, "").replace(/\[.*$/, "");
+	return base.split(".").pop() ?? base;
+}
+
+/**
+ * 함수 매개변수 목록 추출 (이름 없는 매개변수는 빈 이름, 가변 인자는 "...T")
+ */
+function parseParameters(
+	declaration: Parser.SyntaxNode,
+): Array<{ name: string; type: string }> {
+	const list = declaration.childForFieldName("parameters");
+	const parameters: Array<{ name: string; type: string }> = [];
+
+	for (const parameter of list?.namedChildren ?? []) {
+		const typeNode = parameter.childForFieldName("type");
+		if (!typeNode) continue;
+
+		const type =
+			parameter.type === "variadic_parameter_declaration"
+				? `...${typeNode.text}`
+				: typeNode.text;
+		const names = parameter.namedChildren.filter(
+			(n) => n.type === "identifier",
+		);
+		if (names.length === 0) {
+			parameters.push({ name: "", type });
+		}
+		for (const name of names) {
+			parameters.push({ name: name.text, type });
+		}
+	}
+
+	return parameters;
+}
+
+/**
+ * 함수/메서드 시그니처 (본문 제외, 공백 정규화)
+ *
+ * 예: "func (s *UserService) GetUser(ctx context.Context, id int64) (*User, error)"
+ */
+function formatSignature(declaration: Parser.SyntaxNode): string {
+	const part = (field: string) =>
+		declaration.childForFieldName(field)?.text ?? "";
+	const name =
+		part("name") + part("type_parameters") + (part("parameters") || "()");
+	return ["func", part("receiver"), name, part("result")]
+		.filter(Boolean)
+		.join(" ")
+		.replace(/\s+/g, " ");
+}
+
+/**
+ * 메서드 집합 비교용 형태 (매개변수 이름 제외)
+ *
+ * 예: "Get(context.Context, int64) (*User, error)". 결과가 하나면 괄호 없이
+ * 쓰므로 `error`와 `(error)`는 같은 형태가 된다.
+ */
+export function formatMethodShape(
+	name: string,
+	parameters: Parser.SyntaxNode | null,
+	result: Parser.SyntaxNode | null,
+): string {
+	const results = listResultTypes(result);
+	const resultText =
+		results.length === 0
+			? ""
+			: results.length === 1
+				? ` ${results[0]}`
+				: ` (${results.join(", ")})`;
+	return `${name}(${listParameterTypes(parameters).join(", ")})${resultText}`;
+}
+
+/**
+ * 반환 타입 목록 (예: "(*User, error)" -> ["*User", "error"], 없으면 [])
+ */
+function listResultTypes(result: Parser.SyntaxNode | null): string[] {
+	if (result?.type === "parameter_list") {
+		return listParameterTypes(result);
+	}
+	return result ? [normalizeType(result.text)] : [];
+}
+
+/**
+ * 매개변수 목록의 타입 (이름 하나당 한 번씩, 예: "a, b int" -> ["int", "int"])
+ */
+function listParameterTypes(list: Parser.SyntaxNode | null): string[] {
+	const types: string[] = [];
+	for (const parameter of list?.namedChildren ?? []) {
+		const typeNode = parameter.childForFieldName("type");
+		if (!typeNode) continue;
+
+		const type =
+			parameter.type === "variadic_parameter_declaration"
+				? `...${normalizeType(typeNode.text)}`
+				: normalizeType(typeNode.text);
+		const count = parameter.namedChildren.filter(
+			(n) => n.type === "identifier",
+		).length;
+		for (let i = 0; i < Math.max(count, 1); i++) {
+			types.push(type);
+		}
+	}
+	return types;
+}
+
+function normalizeType(text: string): string {
+	return text.replace(/\s+/g, " ").trim();
+}
+
+/**
+ * 인터페이스 본문의 메서드 형태와 임베디드 인터페이스 이름
+ */
+function parseInterfaceElements(typeNode: Parser.SyntaxNode): {
+	methods: string[];
+	embedded: string[];
+} {
+	const methods: string[] = [];
+	const embedded: string[] = [];
+	for (const element of typeNode.namedChildren) {
+		if (element.type === "method_elem" || element.type === "method_spec") {
+			const name = element.childForFieldName("name")?.text;
+			if (name) {
+				methods.push(
+					formatMethodShape(
+						name,
+						element.childForFieldName("parameters"),
+						element.childForFieldName("result"),
+					),
+				);
+			}
+		} else if (element.type !== "comment") {
+			const name = normalizeType(element.text);
+			if (/^[A-Za-z_][\w.]*$/.test(name)) embedded.push(name);
+		}
+	}
+	return { methods, embedded };
+}
+
+/**
+ * 인터페이스가 요구하는 메서드 형태 (같은 패키지의 임베디드 인터페이스 포함)
+ */
+export function collectInterfaceMethods(
+	graph: SemanticGraph,
+	iface: SemanticNode,
+	visited: Set<string>,
+): Set<string> {
+	visited.add(iface.id);
+	const methods = new Set<string>(
+		(iface.metadata.interfaceMethods as string[] | undefined) ?? [],
+	);
+	for (const name of (iface.metadata.embeddedInterfaces as string[]) ?? []) {
+		const id = name.includes(".") ? name : `${getGoPackageId(iface)}.${name}`;
+		const embedded = graph.getNode(id);
+		if (!embedded || embedded.kind !== "interface" || visited.has(id)) {
+			continue;
+		}
+		for (const method of collectInterfaceMethods(graph, embedded, visited)) {
+			methods.add(method);
+		}
+	}
+	return methods;
+}
+
+/**
+ * 메서드 리시버 정보 추출 (포인터/제네릭 리시버는 기본 타입 이름으로 정규화)
+ */
+export function parseReceiver(
+	declaration: Parser.SyntaxNode,
+): { name?: string; typeName: string; pointer: boolean } | null {
+	const receiver = declaration.childForFieldName("receiver");
+	const parameter = receiver?.namedChildren.find(
+		(n) => n.type === "parameter_declaration",
+	);
+	const typeNode = parameter?.childForFieldName("type");
+	if (!parameter || !typeNode) return null;
+
+	const typeName = typeNode.text
+		.replace(/^\*/, "")
+		.replace(/\[.*\]$/, "")
+		.trim();
+	return {
+		name: parameter.childForFieldName("name")?.text,
+		typeName,
+		pointer: typeNode.type === "pointer_type",
+	};
+}
+
+/**
+ * 본문에서 이름이 참조되는지 확인
+ *
+ * 셀렉터의 필드 이름(field_identifier)은 제외하고, 클로저 안의 참조는
+ * 포함한다. 같은 이름으로 다시 선언한 변수도 참조로 센다.
+ */
+function referencesIdentifier(body: Parser.SyntaxNode, name: string): boolean {
+	return body
+		.descendantsOfType("identifier")
+		.some((identifier) => identifier.text === name);
+}
+
+/**
+ * 선언 바로 위의 연속된 주석 블록 수집 (주석 마커를 제거한 라인)
+ *
+ * commentTypes는 문법의 주석 노드 타입 (Java는 line_comment/block_comment)
+ */
+export function collectDocComment(
+	declaration: Parser.SyntaxNode,
+	commentTypes: readonly string[] = ["comment"],
+): string[] {
+	return collectDocCommentTexts(declaration, commentTypes).flatMap(
+		stripCommentMarkers,
+	);
+}
+
+/**
+ * 선언 바로 위의 연속된 주석 노드 원문 (마커 포함, 위에서부터)
+ */
+export function collectDocCommentTexts(
+	declaration: Parser.SyntaxNode,
+	commentTypes: readonly string[] = ["comment"],
+): string[] {
+	const comments: Parser.SyntaxNode[] = [];
+	let expectedRow = declaration.startPosition.row;
+	let sibling = declaration.previousNamedSibling;
+
+	while (
+		sibling &&
+		commentTypes.includes(sibling.type) &&
+		sibling.endPosition.row >= expectedRow - 1
+	) {
+		comments.unshift(sibling);
+		expectedRow = sibling.startPosition.row;
+		sibling = sibling.previousNamedSibling;
+	}
+
+	return comments.map((comment) => comment.text);
+}
+
+/**
+ * 함수 본문의 호출 위치 수집
+ */
+export function collectCallSites(body: Parser.SyntaxNode): CallSite[] {
+	return body.descendantsOfType("call_expression").map((call) => {
+		const fn = call.childForFieldName("function");
+		const args = call.childForFieldName("arguments");
+		const site: CallSite = {
+			callee: fn?.text ?? "",
+			line: call.startPosition.row + 1,
+			arguments: args ? args.namedChildren.map((arg) => arg.text) : [],
+			deferred: call.parent?.type === "defer_statement",
+		};
+
+		const assignedTo = findAssignedNames(call);
+		if (assignedTo) {
+			site.assignedTo = assignedTo;
+		}
+		if (
+			call.parent?.type === "expression_list" &&
+			call.parent.parent?.type === "return_statement"
+		) {
+			site.returned = true;
+		}
+		return site;
+	});
+}
+
+/**
+ * 호출 결과를 대입한 변수 이름 (대입의 오른쪽이 아니면 undefined)
+ *
+ * `:=`, `=`, `var x = ...` 형식을 지원한다.
+ */
+function findAssignedNames(call: Parser.SyntaxNode): string[] | undefined {
+	const list = call.parent;
+	const statement = list?.parent;
+	if (list?.type !== "expression_list" || !statement) {
+		return undefined;
+	}
+
+	switch (statement.type) {
+		case "short_var_declaration":
+		case "assignment_statement": {
+			const right = statement.childForFieldName("right");
+			if (right?.startIndex !== list.startIndex) {
+				return undefined;
+			}
+			const left = statement.childForFieldName("left");
+			return left?.namedChildren.map((name) => name.text);
+		}
+		case "var_spec":
+			return statement.namedChildren
+				.filter((n) => n.type === "identifier")
+				.map((name) => name.text);
+		default:
+			return undefined;
+	}
+}
+
+function createNode(
+	kind: string,
+	name: string,
+	fqn: string,
+	declaration: Parser.SyntaxNode,
+	context: ExtractionContext,
+	pkg: GoPackage,
+	nameNode?: Parser.SyntaxNode | null,
+): SemanticNode {
+	const doc = parseDocComments(
+		collectDocCommentTexts(declaration),
+		context.annotationParsers,
+	);
+	const node: SemanticNode = {
+		id: fqn,
+		fqn,
+		name,
+		kind,
+		filePath: context.filePath,
+		language: "go",
+		line: declaration.startPosition.row + 1,
+		semanticTags: doc.semanticTags,
+		description: doc.description,
+		metadata: {
+			package: pkg.name,
+			packageId: pkg.id,
+			annotations: doc.annotations,
+		},
+	};
+	if (doc.data) {
+		node.metadata.annotationData = doc.data;
+	}
+	if (doc.comment) {
+		node.metadata.doc = doc.comment;
+	}
+	setSourceRanges(node, context.sourceCode, declaration, nameNode);
+
+	const deprecation = parseDeprecation(doc.annotations);
+	if (deprecation) {
+		node.metadata.deprecation = deprecation;
+	}
+	const experiment = parseExperiment(doc.annotations);
+	if (experiment) {
+		node.metadata.experiment = experiment;
+	}
+	return node;
+}
+
+/**
+ * Go 추출기 팩토리 함수
+ */
+export function createGoExtractor(): GoExtractor {
+	return new GoExtractor();
+}
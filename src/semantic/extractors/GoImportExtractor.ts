@@ -0,0 +1,161 @@
+/**
+ * Go Import Extractor
+ * Go 소스의 import 선언을 패키지 의존 관계로 추출
+ */
+
+import path from "node:path";
+import type Parser from "tree-sitter";
+import type { SemanticEdge, SemanticNode } from "../types";
+import {
+	findPackageName,
+	parseStringLiteral,
+	resolveGoPackage,
+} from "./GoExtractor";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+/**
+ * import 한 건
+ */
+export interface GoImport {
+	/** 따옴표를 제거한 import 경로 */
+	path: string;
+	/** 별칭 ("_"는 blank, "."은 dot import, 없으면 undefined) */
+	alias?: string;
+	/** 표준 라이브러리 패키지인지 여부 */
+	stdlib: boolean;
+	line: number;
+}
+
+/**
+ * 표준 라이브러리 import 경로인지 확인
+ *
+ * 모듈 경로는 첫 경로 요소에 도메인의 점이 있으므로 점이 없으면 표준 라이브러리로 본다.
+ */
+export function isStdlibImport(importPath: string): boolean {
+	return !importPath.split("/")[0].includes(".");
+}
+
+/**
+ * 구문 트리에서 import 목록 추출 (단일/그룹/별칭/blank/dot import)
+ */
+export function collectGoImports(root: Parser.SyntaxNode): GoImport[] {
+	const imports: GoImport[] = [];
+
+	for (const declaration of root.namedChildren) {
+		if (declaration.type !== "import_declaration") continue;
+
+		const specs = declaration.namedChildren.flatMap((child) =>
+			child.type === "import_spec_list"
+				? child.namedChildren.filter((n) => n.type === "import_spec")
+				: child.type === "import_spec"
+					? [child]
+					: [],
+		);
+
+		for (const spec of specs) {
+			const pathNode = spec.childForFieldName("path");
+			const importPath = pathNode ? parseStringLiteral(pathNode) : undefined;
+			if (!importPath) continue;
+
+			imports.push({
+				path: importPath,
+				alias: spec.childForFieldName("name")?.text,
+				stdlib: isStdlibImport(importPath),
+				line: spec.startPosition.row + 1,
+			});
+		}
+	}
+
+	return imports;
+}
+
+/**
+ * Go import 추출기
+ *
+ * 파일 노드에서 import한 패키지의 "external" 자리표시 노드로 "imports" 엣지를
+ * 만든다. 엣지 metadata에는 원본 경로, 별칭, 표준 라이브러리 여부를 기록하고,
+ * 파일 노드의 metadata.goPackage에는 파일이 속한 패키지 노드 ID를 기록한다.
+ */
+export class GoImportExtractor implements LanguageExtractor {
+	readonly name = "go-imports";
+	readonly language = "go";
+	readonly extensions = ["go"];
+	readonly requiresTree = true;
+
+	extract(context: ExtractionContext): FileExtraction {
+		if (!context.tree) {
+			throw new Error(
+				`Go extraction requires a syntax tree: ${context.filePath}`,
+			);
+		}
+
+		const root = context.tree.rootNode;
+		const fileNode: SemanticNode = {
+			id: context.filePath,
+			fqn: context.filePath,
+			name: path.posix.basename(context.filePath),
+			kind: "file",
+			filePath: context.filePath,
+			language: this.language,
+			line: 1,
+			semanticTags: [],
+			metadata: {
+				goPackage: resolveGoPackage(
+					context.filePath,
+					findPackageName(root) ?? "main",
+				).id,
+			},
+		};
+		const nodes: SemanticNode[] = [fileNode];
+		const edges: SemanticEdge[] = [];
+
+		for (const goImport of collectGoImports(root)) {
+			nodes.push(createPackageNode(goImport));
+			edges.push({
+				from: fileNode.id,
+				to: goImport.path,
+				type: "imports",
+				metadata: {
+					line: goImport.line,
+					path: goImport.path,
+					alias: goImport.alias,
+					stdlib: goImport.stdlib,
+				},
+			});
+		}
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes,
+			edges,
+		};
+	}
+}
+
+/**
+ * import한 패키지의 자리표시 노드
+ */
+function createPackageNode(goImport: GoImport): SemanticNode {
+	return {
+		id: goImport.path,
+		fqn: goImport.path,
+		name: path.posix.basename(goImport.path),
+		kind: "external",
+		filePath: goImport.path,
+		language: "go",
+		semanticTags: [],
+		metadata: { importPath: goImport.path, stdlib: goImport.stdlib },
+	};
+}
+
+/**
+ * Go import 추출기 팩토리 함수
+ */
+export function createGoImportExtractor(): GoImportExtractor {
+	return new GoImportExtractor();
+}
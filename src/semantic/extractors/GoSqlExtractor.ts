@@ -0,0 +1,223 @@
+/**
+ * Go SQL Extractor
+ * 쿼리 변수에 대입된 SQL 문자열에서 함수가 다루는 테이블 추출
+ */
+
+import type Parser from "tree-sitter";
+import { collectSqlTables, parseSqlStatement, tableNodeId } from "../sql";
+import type { SemanticEdge, SemanticNode } from "../types";
+import {
+	findPackageName,
+	parseReceiver,
+	parseStringLiteral,
+	resolveGoPackage,
+} from "./GoExtractor";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+/** 기본 쿼리 변수 이름 패턴 (query, sqlQuery, stmt, countSQL 등) */
+export const DEFAULT_QUERY_VARIABLE_PATTERN = /(query|sql|stmt|statement)$/i;
+
+/**
+ * SQL 추출기 옵션
+ */
+export interface GoSqlExtractorOptions {
+	/** SQL을 담는 변수로 볼 이름 패턴 */
+	variablePattern?: RegExp;
+}
+
+/**
+ * 함수 본문에서 쿼리 변수에 대입된 SQL 문자열
+ */
+export interface GoSqlAssignment {
+	variable: string;
+	text: string;
+	line: number;
+}
+
+/**
+ * Go SQL 추출기 (선택)
+ *
+ * 함수/메서드 본문에서 이름이 쿼리처럼 보이는 변수에 대입된 문자열
+ * 리터럴(리터럴끼리의 + 연결 포함)을 SQL로 해석하고, 참조한 테이블마다
+ * 함수에서 "table:<이름>" 노드로 "uses_table" 엣지를 만든다.
+ * 함수 노드는 GoExtractor가 만들므로 함께 등록해야 엣지가 연결된다.
+ */
+export class GoSqlExtractor implements LanguageExtractor {
+	readonly name = "go-sql";
+	readonly language = "go";
+	readonly extensions = ["go"];
+	readonly requiresTree = true;
+
+	private variablePattern: RegExp;
+
+	constructor(options: GoSqlExtractorOptions = {}) {
+		this.variablePattern =
+			options.variablePattern ?? DEFAULT_QUERY_VARIABLE_PATTERN;
+	}
+
+	extract(context: ExtractionContext): FileExtraction {
+		if (!context.tree) {
+			throw new Error(
+				`Go extraction requires a syntax tree: ${context.filePath}`,
+			);
+		}
+
+		const root = context.tree.rootNode;
+		const pkg = resolveGoPackage(
+			context.filePath,
+			findPackageName(root) ?? "main",
+		);
+		const nodes = new Map<string, SemanticNode>();
+		const edges: SemanticEdge[] = [];
+
+		for (const declaration of root.namedChildren) {
+			if (
+				declaration.type !== "function_declaration" &&
+				declaration.type !== "method_declaration"
+			) {
+				continue;
+			}
+			const name = declaration.childForFieldName("name")?.text;
+			const body = declaration.childForFieldName("body");
+			if (!name || !body) continue;
+
+			const receiver = parseReceiver(declaration);
+			const functionId = receiver
+				? `${pkg.id}.${receiver.typeName}.${name}`
+				: `${pkg.id}.${name}`;
+
+			for (const assignment of collectSqlAssignments(
+				body,
+				this.variablePattern,
+			)) {
+				const statement = parseSqlStatement(assignment.text);
+				if (!statement) continue;
+
+				for (const table of collectSqlTables(assignment.text)) {
+					const id = tableNodeId(table);
+					if (!nodes.has(id)) {
+						nodes.set(id, createTableNode(table, context.filePath));
+					}
+					edges.push({
+						from: functionId,
+						to: id,
+						type: "uses_table",
+						metadata: {
+							line: assignment.line,
+							verb: statement.verb,
+							variable: assignment.variable,
+						},
+					});
+				}
+			}
+		}
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes: Array.from(nodes.values()),
+			edges,
+		};
+	}
+}
+
+/**
+ * 함수 본문에서 패턴에 맞는 변수에 대입된 문자열 수집
+ *
+ * `query := "..."`, `query = "..."`, `var query = "..."` 형태를 지원한다.
+ */
+export function collectSqlAssignments(
+	body: Parser.SyntaxNode,
+	variablePattern: RegExp = DEFAULT_QUERY_VARIABLE_PATTERN,
+): GoSqlAssignment[] {
+	const assignments: GoSqlAssignment[] = [];
+
+	const visit = (node: Parser.SyntaxNode) => {
+		let left: Parser.SyntaxNode[] = [];
+		let right: Parser.SyntaxNode[] = [];
+		if (
+			node.type === "short_var_declaration" ||
+			node.type === "assignment_statement"
+		) {
+			left = node.childForFieldName("left")?.namedChildren ?? [];
+			right = node.childForFieldName("right")?.namedChildren ?? [];
+		} else if (node.type === "var_spec") {
+			left = node.childrenForFieldName("name");
+			right = node.childForFieldName("value")?.namedChildren ?? [];
+		}
+
+		left.forEach((target, index) => {
+			const value = right[index];
+			if (!value || !variablePattern.test(target.text)) return;
+			const text = evaluateStringExpression(value);
+			if (text !== undefined) {
+				assignments.push({
+					variable: target.text,
+					text,
+					line: node.startPosition.row + 1,
+				});
+			}
+		});
+
+		for (const child of node.namedChildren) {
+			// 중첩 함수 리터럴의 쿼리도 바깥 함수에 속한 것으로 본다
+			visit(child);
+		}
+	};
+
+	visit(body);
+	return assignments;
+}
+
+/**
+ * 문자열 리터럴과 리터럴끼리의 + 연결을 값으로 해석
+ */
+function evaluateStringExpression(
+	node: Parser.SyntaxNode,
+): string | undefined {
+	if (node.type === "parenthesized_expression") {
+		const inner = node.namedChildren[0];
+		return inner ? evaluateStringExpression(inner) : undefined;
+	}
+	if (node.type === "binary_expression") {
+		if (node.childForFieldName("operator")?.text !== "+") return undefined;
+		const left = node.childForFieldName("left");
+		const right = node.childForFieldName("right");
+		const leftText = left ? evaluateStringExpression(left) : undefined;
+		const rightText = right ? evaluateStringExpression(right) : undefined;
+		return leftText === undefined || rightText === undefined
+			? undefined
+			: leftText + rightText;
+	}
+	return parseStringLiteral(node);
+}
+
+/**
+ * table 노드
+ */
+function createTableNode(table: string, filePath: string): SemanticNode {
+	const id = tableNodeId(table);
+	return {
+		id,
+		fqn: id,
+		name: table,
+		kind: "table",
+		filePath,
+		language: "sql",
+		semanticTags: [],
+		metadata: {},
+	};
+}
+
+/**
+ * Go SQL 추출기 팩토리 함수
+ */
+export function createGoSqlExtractor(
+	options?: GoSqlExtractorOptions,
+): GoSqlExtractor {
+	return new GoSqlExtractor(options);
+}
@@ -0,0 +1,662 @@
+/**
+ * Java Extractor
+ * Java 소스에서 package/import, 클래스/인터페이스/enum/메서드 심볼과 참조 관계 추출
+ */
+
+import path from "node:path";
+import type Parser from "tree-sitter";
+import { parseDocComments } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import { setSourceRanges } from "../source-range";
+import type { SemanticEdge, SemanticNode } from "../types";
+import { collectDocCommentTexts } from "./GoExtractor";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+/**
+ * import 한 건
+ */
+export interface JavaImport {
+	/** import한 이름 (와일드카드는 ".*"를 뺀 패키지/클래스 이름) */
+	name: string;
+	/** import static 여부 */
+	static: boolean;
+	/** `.*` 형식 여부 */
+	wildcard: boolean;
+	line: number;
+}
+
+/**
+ * Java 추출기 옵션
+ */
+export interface JavaExtractorOptions {
+	/**
+	 * 타입/메서드 어노테이션 -> 시맨틱 태그
+	 * (키는 "Service" 같은 단순 이름 또는 패키지를 포함한 이름)
+	 */
+	annotationTags?: Record<string, string[]>;
+}
+
+/**
+ * link 단계에서 해석할 참조 (후보 중 그래프에 있는 첫 노드로 연결)
+ */
+interface JavaReference {
+	type: string;
+	candidates: string[];
+	line: number;
+}
+
+/** 선언 노드 타입 -> 심볼 종류 */
+const TYPE_KINDS: Record<string, string> = {
+	class_declaration: "class",
+	interface_declaration: "interface",
+	enum_declaration: "enum",
+	record_declaration: "record",
+};
+
+/** 변수가 아닌 호출 대상을 타입 이름으로 볼 형태 (User, com.example.User) */
+const QUALIFIED_TYPE_PATTERN = /^(?:[a-z_$][\w$]*\.)*[A-Z][\w$]*(?:\.[A-Z][\w$]*)*$/;
+
+/** Java 주석 노드 타입 */
+const JAVA_COMMENT_TYPES = ["line_comment", "block_comment"];
+
+/**
+ * 구문 트리에서 package 이름 찾기 (선언이 없으면 undefined)
+ */
+export function findJavaPackage(root: Parser.SyntaxNode): string | undefined {
+	const declaration = root.namedChildren.find(
+		(child) => child.type === "package_declaration",
+	);
+	return declaration?.namedChildren.find(
+		(child) =>
+			child.type === "scoped_identifier" || child.type === "identifier",
+	)?.text;
+}
+
+/**
+ * 구문 트리에서 import 선언 수집
+ *
+ * `import a.b.C;`, `import a.b.*;`, `import static a.b.C.m;`,
+ * `import static a.b.C.*;`를 지원한다.
+ */
+export function collectJavaImports(root: Parser.SyntaxNode): JavaImport[] {
+	const imports: JavaImport[] = [];
+
+	for (const statement of root.namedChildren) {
+		if (statement.type !== "import_declaration") continue;
+
+		const nameNode = statement.namedChildren.find(
+			(child) =>
+				child.type === "scoped_identifier" || child.type === "identifier",
+		);
+		if (!nameNode) continue;
+
+		imports.push({
+			name: nameNode.text.replace(/\s+/g, ""),
+			static: statement.children.some((child) => child.type === "static"),
+			wildcard: statement.namedChildren.some(
+				(child) => child.type === "asterisk",
+			),
+			line: statement.startPosition.row + 1,
+		});
+	}
+
+	return imports;
+}
+
+/**
+ * Java 심볼 추출기
+ *
+ * 파일마다 "file" 노드를 만들어 import한 클래스/패키지로 "imports" 엣지를
+ * 연결하고, 패키지 노드는 최상위 타입을, 타입 노드는 메서드/생성자와 중첩
+ * 타입을 포함한다. 같은 이름의 오버로드 메서드는 하나의 노드로 합치고
+ * metadata.overloads에 개수를 기록한다.
+ *
+ * 상속("extends"/"implements"), 시그니처와 `new`의 타입 참조("references"),
+ * 메서드 호출("calls")은 import, 같은 패키지, 와일드카드 import 순의 후보
+ * FQN으로 기록했다가 link 단계에서 그래프에 있는 첫 후보로 연결하므로,
+ * 다른 파일의 `com.example.User`도 분석 대상이면 그 노드를 가리킨다.
+ * 호출 대상은 필드/매개변수/지역 변수의 선언 타입으로 해석한다.
+ */
+export class JavaExtractor implements LanguageExtractor {
+	readonly name = "java-symbols";
+	readonly language = "java";
+	readonly extensions = ["java"];
+	readonly requiresTree = true;
+
+	private annotationTags: Record<string, string[]>;
+
+	constructor(options: JavaExtractorOptions = {}) {
+		this.annotationTags = options.annotationTags ?? {};
+	}
+
+	extract(context: ExtractionContext): FileExtraction {
+		if (!context.tree) {
+			throw new Error(
+				`Java extraction requires a syntax tree: ${context.filePath}`,
+			);
+		}
+
+		const root = context.tree.rootNode;
+		const packageName = findJavaPackage(root);
+		const qualify = (name: string) =>
+			packageName ? `${packageName}.${name}` : name;
+
+		const fileNode: SemanticNode = {
+			id: context.filePath,
+			fqn: context.filePath,
+			name: path.posix.basename(context.filePath),
+			kind: "file",
+			filePath: context.filePath,
+			language: this.language,
+			line: 1,
+			semanticTags: [],
+			metadata: { javaPackage: packageName ?? "" },
+		};
+		const nodes = new Map<string, SemanticNode>([[fileNode.id, fileNode]]);
+		const edges: SemanticEdge[] = [];
+
+		if (packageName) {
+			nodes.set(packageName, {
+				id: packageName,
+				fqn: packageName,
+				name: packageName.split(".").pop() ?? packageName,
+				kind: "package",
+				filePath: context.filePath,
+				language: this.language,
+				line: 1,
+				semanticTags: [],
+				metadata: {},
+			});
+		}
+
+		// 타입 이름 해석용: 단순 이름 -> FQN
+		const typeScope = new Map<string, string>();
+		const staticMembers = new Map<string, string>();
+		const wildcardPackages: string[] = [];
+		const staticClasses: string[] = [];
+		const imports = collectJavaImports(root);
+		const byTarget = new Map<string, JavaImport[]>();
+		for (const entry of imports) {
+			const segments = entry.name.split(".");
+			const member = segments.pop() ?? entry.name;
+			const target =
+				entry.static && !entry.wildcard ? segments.join(".") : entry.name;
+			if (entry.static) {
+				if (entry.wildcard) staticClasses.push(entry.name);
+				else staticMembers.set(member, entry.name);
+			} else if (entry.wildcard) {
+				wildcardPackages.push(entry.name);
+			} else {
+				typeScope.set(member, entry.name);
+			}
+
+			const entries = byTarget.get(target) ?? [];
+			entries.push(entry);
+			byTarget.set(target, entries);
+		}
+		for (const [target, entries] of byTarget) {
+			if (!nodes.has(target)) {
+				nodes.set(target, createImportPlaceholder(target));
+			}
+			edges.push({
+				from: fileNode.id,
+				to: target,
+				type: "imports",
+				metadata: {
+					line: entries[0].line,
+					static: entries.some((entry) => entry.static),
+					wildcard: entries.some((entry) => entry.wildcard),
+					names: entries.flatMap((entry) =>
+						entry.static && !entry.wildcard
+							? [entry.name.slice(target.length + 1)]
+							: [],
+					),
+				},
+			});
+		}
+
+		// 파일에 선언된 타입 (중첩 타입 포함, 선언 전에 참조될 수 있으므로 먼저 수집)
+		const declared = new Map<string, string>();
+		const collectDeclared = (
+			declaration: Parser.SyntaxNode,
+			prefix: string,
+		) => {
+			const name = declaration.childForFieldName("name")?.text;
+			if (!name) return;
+			const id = prefix ? `${prefix}.${name}` : qualify(name);
+			if (!declared.has(name)) declared.set(name, id);
+			for (const member of typeMembers(declaration)) {
+				if (TYPE_KINDS[member.type]) collectDeclared(member, id);
+			}
+		};
+		for (const child of root.namedChildren) {
+			if (TYPE_KINDS[child.type]) collectDeclared(child, "");
+		}
+
+		const resolveType = (name: string): string[] => {
+			if (name === "var") return [];
+			const [head, ...rest] = name.split(".");
+			const base = declared.get(head) ?? typeScope.get(head);
+			if (base) return [[base, ...rest].join(".")];
+			if (rest.length > 0) return unique([name, qualify(name)]);
+			return unique([
+				qualify(head),
+				...wildcardPackages.map((pkg) => `${pkg}.${head}`),
+			]);
+		};
+
+		const visitType = (
+			declaration: Parser.SyntaxNode,
+			outer: SemanticNode | undefined,
+			enclosing: string[],
+		) => {
+			const nameNode = declaration.childForFieldName("name");
+			if (!nameNode) return;
+			const id = outer
+				? `${outer.id}.${nameNode.text}`
+				: qualify(nameNode.text);
+			if (nodes.has(id)) return;
+
+			const node = this.createNode(
+				TYPE_KINDS[declaration.type],
+				nameNode,
+				id,
+				declaration,
+				context,
+				packageName,
+				outer?.kind === "interface",
+			);
+			const references: JavaReference[] = [];
+			const line = declaration.startPosition.row + 1;
+			for (const clause of declaration.namedChildren) {
+				const type =
+					clause.type === "superclass" ||
+					(clause.type === "extends_interfaces" &&
+						declaration.type === "interface_declaration")
+						? "extends"
+						: clause.type === "super_interfaces"
+							? "implements"
+							: undefined;
+				if (!type) continue;
+				for (const name of collectTypeNames(clause)) {
+					references.push({ type, candidates: resolveType(name), line });
+				}
+			}
+			node.metadata.javaReferences = references;
+			nodes.set(id, node);
+			edges.push({
+				from: outer?.id ?? packageName ?? fileNode.id,
+				to: id,
+				type: "contains",
+			});
+
+			// 멤버 메서드의 호출 대상 해석용 필드 타입
+			const fields = new Map<string, string[]>();
+			for (const member of typeMembers(declaration)) {
+				if (member.type !== "field_declaration") continue;
+				const typeNode = member.childForFieldName("type");
+				const typeName = typeNode ? collectTypeNames(typeNode)[0] : undefined;
+				if (!typeName) continue;
+				for (const declarator of member.namedChildren) {
+					const name =
+						declarator.type === "variable_declarator"
+							? declarator.childForFieldName("name")?.text
+							: undefined;
+					if (name) fields.set(name, resolveType(typeName));
+				}
+			}
+
+			const scopeIds = [id, ...enclosing];
+			for (const member of typeMembers(declaration)) {
+				if (TYPE_KINDS[member.type]) {
+					visitType(member, node, scopeIds);
+				} else if (
+					member.type === "method_declaration" ||
+					member.type === "constructor_declaration"
+				) {
+					this.visitCallable(member, node, scopeIds, {
+						context,
+						packageName,
+						nodes,
+						edges,
+						fields,
+						resolveType,
+						staticMembers,
+						staticClasses,
+					});
+				}
+			}
+		};
+
+		for (const child of root.namedChildren) {
+			if (TYPE_KINDS[child.type]) visitType(child, undefined, []);
+		}
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes: Array.from(nodes.values()),
+			edges,
+		};
+	}
+
+	/**
+	 * 추출 단계에서 기록한 참조를 그래프에 있는 첫 후보 노드로 연결
+	 */
+	link(graph: SemanticGraph): void {
+		for (const node of Array.from(graph.nodes.values())) {
+			if (node.language !== this.language) continue;
+			const references = node.metadata.javaReferences as
+				| JavaReference[]
+				| undefined;
+
+			for (const reference of references ?? []) {
+				const target = reference.candidates.find(
+					(candidate) => candidate !== node.id && graph.getNode(candidate),
+				);
+				if (!target) continue;
+				graph.addEdge({
+					from: node.id,
+					to: target,
+					type: reference.type,
+					metadata: { line: reference.line },
+				});
+			}
+		}
+	}
+
+	/**
+	 * 메서드/생성자 노드 생성 (오버로드는 먼저 선언된 노드에 합침)
+	 */
+	private visitCallable(
+		declaration: Parser.SyntaxNode,
+		owner: SemanticNode,
+		scopeIds: string[],
+		state: {
+			context: ExtractionContext;
+			packageName: string | undefined;
+			nodes: Map<string, SemanticNode>;
+			edges: SemanticEdge[];
+			fields: Map<string, string[]>;
+			resolveType: (name: string) => string[];
+			staticMembers: Map<string, string>;
+			staticClasses: string[];
+		},
+	): void {
+		const nameNode = declaration.childForFieldName("name");
+		if (!nameNode) return;
+		const { nodes, resolveType } = state;
+		const id = `${owner.id}.${nameNode.text}`;
+		const isConstructor = declaration.type === "constructor_declaration";
+
+		const parameters: Array<{ name: string; type: string }> = [];
+		const variables = new Map(state.fields);
+		const referencedTypes: string[] = [];
+		const parameterList = declaration.childForFieldName("parameters");
+		for (const parameter of parameterList?.namedChildren ?? []) {
+			const typeNode =
+				parameter.childForFieldName("type") ??
+				parameter.namedChildren.find(
+					(child) =>
+						child.type !== "modifiers" &&
+						child.type !== "variable_declarator",
+				);
+			const name =
+				parameter.childForFieldName("name")?.text ??
+				parameter.namedChildren
+					.find((child) => child.type === "variable_declarator")
+					?.childForFieldName("name")?.text;
+			if (!typeNode || !name) continue;
+
+			const isSpread = parameter.type === "spread_parameter";
+			parameters.push({
+				name,
+				type: isSpread ? `${typeNode.text}...` : typeNode.text,
+			});
+			const typeNames = collectTypeNames(typeNode);
+			referencedTypes.push(...typeNames);
+			if (typeNames[0]) variables.set(name, resolveType(typeNames[0]));
+		}
+
+		const returnType = isConstructor
+			? undefined
+			: declaration.childForFieldName("type");
+		if (returnType) referencedTypes.push(...collectTypeNames(returnType));
+
+		let node = nodes.get(id);
+		if (node) {
+			node.metadata.overloads =
+				((node.metadata.overloads as number | undefined) ?? 1) + 1;
+		} else {
+			node = this.createNode(
+				isConstructor ? "constructor" : "method",
+				nameNode,
+				id,
+				declaration,
+				state.context,
+				state.packageName,
+				owner.kind === "interface",
+			);
+			node.metadata.parameters = parameters;
+			node.metadata.results =
+				returnType && returnType.type !== "void_type" ? [returnType.text] : [];
+			node.metadata.signature = `${nameNode.text}(${parameters
+				.map((parameter) => parameter.type)
+				.join(", ")})`;
+			node.metadata.javaReferences = [];
+			nodes.set(id, node);
+			state.edges.push({ from: owner.id, to: id, type: "contains" });
+		}
+
+		const references = node.metadata.javaReferences as JavaReference[];
+		const line = declaration.startPosition.row + 1;
+		for (const name of referencedTypes) {
+			references.push({
+				type: "references",
+				candidates: resolveType(name),
+				line,
+			});
+		}
+
+		const body = declaration.childForFieldName("body");
+		if (!body) return;
+
+		for (const local of body.descendantsOfType("local_variable_declaration")) {
+			const typeNode = local.childForFieldName("type");
+			const typeName = typeNode ? collectTypeNames(typeNode)[0] : undefined;
+			if (!typeName) continue;
+			for (const declarator of local.namedChildren) {
+				const name =
+					declarator.type === "variable_declarator"
+						? declarator.childForFieldName("name")?.text
+						: undefined;
+				if (name) variables.set(name, resolveType(typeName));
+			}
+		}
+
+		for (const creation of body.descendantsOfType(
+			"object_creation_expression",
+		)) {
+			const typeNode = creation.childForFieldName("type");
+			for (const name of typeNode ? collectTypeNames(typeNode) : []) {
+				references.push({
+					type: "references",
+					candidates: resolveType(name),
+					line: creation.startPosition.row + 1,
+				});
+			}
+		}
+
+		for (const call of body.descendantsOfType("method_invocation")) {
+			const method = call.childForFieldName("name")?.text;
+			if (!method) continue;
+			const object = call.childForFieldName("object");
+
+			let receivers: string[];
+			if (!object) {
+				const imported = state.staticMembers.get(method);
+				references.push({
+					type: "calls",
+					candidates: unique([
+						...scopeIds.map((scopeId) => `${scopeId}.${method}`),
+						...(imported ? [imported] : []),
+						...state.staticClasses.map(
+							(className) => `${className}.${method}`,
+						),
+					]),
+					line: call.startPosition.row + 1,
+				});
+				continue;
+			}
+			if (object.type === "this") {
+				receivers = [owner.id];
+			} else {
+				const thisField =
+					object.type === "field_access" &&
+					object.childForFieldName("object")?.type === "this"
+						? object.childForFieldName("field")?.text
+						: undefined;
+				const name = thisField ?? object.text;
+				const variable = variables.get(name);
+				receivers = variable
+					? variable
+					: !thisField &&
+							(object.type === "identifier" ||
+								object.type === "field_access") &&
+							QUALIFIED_TYPE_PATTERN.test(name)
+						? resolveType(name)
+						: [];
+			}
+			if (receivers.length === 0) continue;
+
+			references.push({
+				type: "calls",
+				candidates: receivers.map((receiver) => `${receiver}.${method}`),
+				line: call.startPosition.row + 1,
+			});
+		}
+	}
+
+	/**
+	 * 타입/메서드 노드 공통 생성 (문서 주석, 어노테이션 태그, 공개 여부)
+	 */
+	private createNode(
+		kind: string,
+		nameNode: Parser.SyntaxNode,
+		id: string,
+		declaration: Parser.SyntaxNode,
+		context: ExtractionContext,
+		packageName: string | undefined,
+		inInterface: boolean,
+	): SemanticNode {
+		const doc = parseDocComments(
+			collectDocCommentTexts(declaration, JAVA_COMMENT_TYPES),
+			context.annotationParsers,
+		);
+		const modifiers = declaration.namedChildren.find(
+			(child) => child.type === "modifiers",
+		);
+		const keywords = (modifiers?.children ?? [])
+			.filter((child) => !child.isNamed)
+			.map((child) => child.type);
+		const javaAnnotations = (modifiers?.namedChildren ?? [])
+			.filter(
+				(child) =>
+					child.type === "marker_annotation" || child.type === "annotation",
+			)
+			.map((child) => child.childForFieldName("name")?.text ?? "")
+			.filter((name) => name.length > 0);
+
+		const semanticTags = new Set(doc.semanticTags);
+		for (const annotation of javaAnnotations) {
+			const simpleName = annotation.split(".").pop() ?? annotation;
+			for (const tag of this.annotationTags[annotation] ??
+				this.annotationTags[simpleName] ??
+				[]) {
+				semanticTags.add(tag);
+			}
+		}
+
+		const node: SemanticNode = {
+			id,
+			fqn: id,
+			name: nameNode.text,
+			kind,
+			filePath: context.filePath,
+			language: this.language,
+			line: declaration.startPosition.row + 1,
+			semanticTags: Array.from(semanticTags),
+			description: doc.description,
+			metadata: {
+				package: packageName ?? "",
+				annotations: doc.annotations,
+				annotationData: doc.data,
+				javaAnnotations,
+				modifiers: keywords,
+				// 인터페이스 멤버는 public 키워드가 없어도 공개
+				exported: keywords.includes("public") || inInterface,
+			},
+		};
+		if (doc.comment) {
+			node.metadata.doc = doc.comment;
+		}
+		setSourceRanges(node, context.sourceCode, declaration, nameNode);
+		return node;
+	}
+}
+
+/**
+ * 타입 선언 본문의 멤버 (enum 상수 뒤의 선언부 포함)
+ */
+function typeMembers(declaration: Parser.SyntaxNode): Parser.SyntaxNode[] {
+	const body = declaration.childForFieldName("body");
+	return (body?.namedChildren ?? []).flatMap((child) =>
+		child.type === "enum_body_declarations" ? child.namedChildren : [child],
+	);
+}
+
+/**
+ * 타입 표현에 등장하는 타입 이름 (제네릭 인자 포함, 기본 타입 제외)
+ *
+ * 예: `Map<String, List<User>>` -> ["Map", "String", "List", "User"]
+ */
+function collectTypeNames(node: Parser.SyntaxNode): string[] {
+	if (node.type === "type_identifier") return [node.text];
+	if (node.type === "scoped_type_identifier") {
+		return [node.text.replace(/\s+/g, "")];
+	}
+	return node.namedChildren.flatMap(collectTypeNames);
+}
+
+function unique(values: string[]): string[] {
+	return Array.from(new Set(values));
+}
+
+/**
+ * import한 클래스/패키지의 자리표시 노드 (같은 FQN이 분석되면 실제 노드로 대체됨)
+ */
+function createImportPlaceholder(name: string): SemanticNode {
+	return {
+		id: name,
+		fqn: name,
+		name: name.split(".").pop() ?? name,
+		kind: "external",
+		filePath: `${name.replace(/\./g, "/")}.java`,
+		language: "java",
+		semanticTags: [],
+		metadata: { importPath: name },
+	};
+}
+
+/**
+ * Java 추출기 팩토리 함수
+ */
+export function createJavaExtractor(
+	options?: JavaExtractorOptions,
+): JavaExtractor {
+	return new JavaExtractor(options);
+}
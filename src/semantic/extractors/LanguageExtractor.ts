@@ -0,0 +1,89 @@
+/**
+ * Language Extractor Interface
+ * 언어별 심볼/관계 추출기 공통 인터페이스
+ */
+
+import type Parser from "tree-sitter";
+import type { AnnotationParser } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "../types";
+
+/**
+ * 추출 실행 컨텍스트
+ */
+export interface ExtractionContext {
+	/** 소스 코드 */
+	sourceCode: string;
+	/** 파일 경로 (노드 식별용) */
+	filePath: string;
+	/** tree-sitter 구문 트리 (requiresTree 추출기에만 제공) */
+	tree?: Parser.Tree;
+	/** 분석기에 등록된 문서 주석 어노테이션 파서 (생략 시 기본 파서) */
+	annotationParsers?: readonly AnnotationParser[];
+}
+
+/**
+ * 구문 오류 하나 (tree-sitter ERROR/MISSING 노드)
+ */
+export interface ParseError {
+	/** 오류 구간 시작/끝 오프셋 (소스 문자열 기준) */
+	startIndex: number;
+	endIndex: number;
+	/** 시작 위치 (1부터) */
+	line: number;
+	column: number;
+	message: string;
+	/** ERROR 노드의 원문 (MISSING 노드는 빈 문자열) */
+	text: string;
+}
+
+/**
+ * 분석하지 않고 건너뛴 파일 정보
+ *
+ * - max-file-bytes: 파일 크기가 maxFileBytes를 넘음 (파싱하지 않음)
+ * - parse-timeout: 파싱이 parseTimeoutMs 안에 끝나지 않아 중단
+ */
+export interface FileSkip {
+	reason: "max-file-bytes" | "parse-timeout";
+	message: string;
+}
+
+/**
+ * 단일 파일 추출 결과
+ */
+export interface FileExtraction {
+	filePath: string;
+	language: string;
+	nodes: SemanticNode[];
+	edges: SemanticEdge[];
+	/** 구문 오류가 있어 일부 심볼만 추출했는지 여부 (분석기가 설정) */
+	partial?: boolean;
+	/** 구문 오류 목록 (분석기가 설정) */
+	errors?: ParseError[];
+	/** 크기/시간 제한으로 건너뛰었으면 그 이유 (분석기가 설정) */
+	skipped?: FileSkip;
+}
+
+/**
+ * 언어별 추출기 인터페이스
+ */
+export interface LanguageExtractor {
+	/** 추출기 이름 */
+	readonly name: string;
+	/** 대상 언어 */
+	readonly language: string;
+	/** 처리할 파일 확장자 (점 제외) */
+	readonly extensions: string[];
+	/** tree-sitter 구문 트리가 필요한지 여부 */
+	readonly requiresTree: boolean;
+
+	/**
+	 * 파일에서 노드와 엣지 추출
+	 */
+	extract(context: ExtractionContext): FileExtraction;
+
+	/**
+	 * 전체 그래프 병합 후 파일 간/언어 간 관계 연결 (선택)
+	 */
+	link?(graph: SemanticGraph): void;
+}
@@ -0,0 +1,168 @@
+/**
+ * Pattern Extractor
+ * 설정한 함수 호출 패턴을 사용자 정의 종류의 심볼로 추출
+ */
+
+import type Parser from "tree-sitter";
+import { parseDocComments } from "../annotations";
+import { setSourceRanges } from "../source-range";
+import type { SemanticNode } from "../types";
+import { collectDocCommentTexts } from "./GoExtractor";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+/**
+ * 호출 패턴 -> 심볼 매핑
+ *
+ * 예: `{ call: "defineService", kind: "service" }`는
+ * `defineService("billing", ...)` 호출을 "billing" 이름의 service 노드로 만든다.
+ */
+export interface SymbolCallPattern {
+	/** 호출 대상 원본 텍스트 (예: "defineService", "app.Route") */
+	call: string;
+	/** 생성할 노드 종류 */
+	kind: string;
+	/** 이름으로 사용할 인자 위치 (기본: 0) */
+	nameArgument?: number;
+	/** 함수 본문 밖의 호출만 인정할지 여부 (기본: true) */
+	topLevelOnly?: boolean;
+}
+
+/**
+ * 패턴 추출기 설정
+ */
+export interface PatternExtractorOptions {
+	/** tree-sitter 언어 */
+	language: string;
+	/** 처리할 파일 확장자 (점 제외) */
+	extensions: string[];
+	patterns: SymbolCallPattern[];
+}
+
+/** 호출 노드 타입 (언어별 문법 차이) */
+const CALL_NODE_TYPES = ["call_expression", "call"];
+
+/** 이 노드 안의 호출은 최상위 호출로 보지 않음 */
+const FUNCTION_NODE_TYPES = new Set([
+	"function_declaration",
+	"method_declaration",
+	"func_literal",
+	"function",
+	"function_expression",
+	"arrow_function",
+	"method_definition",
+	"function_definition",
+	"lambda",
+	"constructor_declaration",
+]);
+
+/**
+ * 호출 패턴 기반 심볼 추출기
+ *
+ * 전체 tree-sitter 쿼리를 작성하지 않고도 도메인 키워드 호출을
+ * 심볼로 인식하기 위한 추출기. 노드 ID는 "kind:name" 형식이며, 선언 범위는
+ * 호출 식, 이름 범위는 이름 인자다.
+ */
+export class PatternExtractor implements LanguageExtractor {
+	readonly name: string;
+	readonly language: string;
+	readonly extensions: string[];
+	readonly requiresTree = true;
+	private patterns: Map<string, SymbolCallPattern>;
+
+	constructor(options: PatternExtractorOptions) {
+		this.name = `${options.language}-patterns`;
+		this.language = options.language;
+		this.extensions = options.extensions;
+		this.patterns = new Map(options.patterns.map((p) => [p.call, p]));
+	}
+
+	extract(context: ExtractionContext): FileExtraction {
+		if (!context.tree) {
+			throw new Error(
+				`Pattern extraction requires a syntax tree: ${context.filePath}`,
+			);
+		}
+
+		const nodes: SemanticNode[] = [];
+		const calls = context.tree.rootNode.descendantsOfType(CALL_NODE_TYPES);
+
+		for (const call of calls) {
+			const callee = call.childForFieldName("function")?.text;
+			const pattern = callee ? this.patterns.get(callee) : undefined;
+			if (!pattern) continue;
+			if ((pattern.topLevelOnly ?? true) && isInsideFunction(call)) continue;
+
+			const args = call.childForFieldName("arguments")?.namedChildren ?? [];
+			const nameNode = args[pattern.nameArgument ?? 0];
+			if (!nameNode) continue;
+
+			const name = unquote(nameNode.text);
+			const doc = parseDocComments(
+				collectDocCommentTexts(topLevelStatement(call)),
+				context.annotationParsers,
+			);
+			const node: SemanticNode = {
+				id: `${pattern.kind}:${name}`,
+				fqn: `${pattern.kind}:${name}`,
+				name,
+				kind: pattern.kind,
+				filePath: context.filePath,
+				language: this.language,
+				line: call.startPosition.row + 1,
+				semanticTags: doc.semanticTags,
+				description: doc.description,
+				metadata: {
+					annotations: doc.annotations,
+					annotationData: doc.data,
+					doc: doc.comment,
+					pattern: pattern.call,
+					arguments: args.map((arg) => arg.text),
+				},
+			};
+			setSourceRanges(node, context.sourceCode, call, nameNode);
+			nodes.push(node);
+		}
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes,
+			edges: [],
+		};
+	}
+}
+
+function isInsideFunction(node: Parser.SyntaxNode): boolean {
+	for (let parent = node.parent; parent; parent = parent.parent) {
+		if (FUNCTION_NODE_TYPES.has(parent.type)) return true;
+	}
+	return false;
+}
+
+/**
+ * 호출을 감싸는 최상위 문장 (문서 주석 탐색 기준)
+ */
+function topLevelStatement(node: Parser.SyntaxNode): Parser.SyntaxNode {
+	let current = node;
+	while (current.parent?.parent) {
+		current = current.parent;
+	}
+	return current;
+}
+
+function unquote(text: string): string {
+	return text.replace(/^(["'`])(.*)\1$/s, "$2");
+}
+
+/**
+ * 패턴 추출기 팩토리 함수
+ */
+export function createPatternExtractor(
+	options: PatternExtractorOptions,
+): PatternExtractor {
+	return new PatternExtractor(options);
+}
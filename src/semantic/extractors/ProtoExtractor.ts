@@ -0,0 +1,335 @@
+/**
+ * Protocol Buffer Extractor
+ * .proto 파일에서 message/enum/service/rpc 정의와 import 관계 추출
+ */
+
+import path from "node:path";
+import { parseDocComments } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import { getTextRange } from "../source-range";
+import type { SemanticEdge, SemanticNode, SourceRange } from "../types";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+const PACKAGE_PATTERN = /^\s*package\s+([\w.]+)\s*;/;
+const IMPORT_PATTERN = /^\s*import\s+(?:public\s+|weak\s+)?"([^"]+)"\s*;/;
+const GO_PACKAGE_PATTERN = /^\s*option\s+go_package\s*=\s*"([^"]+)"\s*;/;
+const DEFINITION_PATTERN = /^\s*(message|enum|service)\s+(\w+)/;
+const RPC_PATTERN =
+	/^\s*rpc\s+(\w+)\s*\(\s*(stream\s+)?([\w.]+)\s*\)\s*returns\s*\(\s*(stream\s+)?([\w.]+)\s*\)/;
+
+interface OpenScope {
+	node: SemanticNode;
+	depth: number;
+	/** 선언 시작 위치 (문자열 인덱스) */
+	start: number;
+}
+
+/**
+ * Protocol Buffer 추출기
+ *
+ * tree-sitter 문법 없이 라인 단위로 정의를 인식한다. 선언 범위는 정의
+ * 키워드부터 짝이 맞는 닫는 중괄호(본문이 없는 rpc는 라인 끝)까지다.
+ * 생성된 Go 타입이 함께 분석되면 link 단계에서 "generates" 엣지로 연결한다.
+ */
+export class ProtoExtractor implements LanguageExtractor {
+	readonly name = "proto-definitions";
+	readonly language = "proto";
+	readonly extensions = ["proto"];
+	readonly requiresTree = false;
+
+	extract(context: ExtractionContext): FileExtraction {
+		const lines = context.sourceCode.split("\n");
+		const protoPackage = lines
+			.map((line) => line.match(PACKAGE_PATTERN)?.[1])
+			.find((name) => name !== undefined);
+		const goPackage = lines
+			.map((line) => line.match(GO_PACKAGE_PATTERN)?.[1])
+			.find((name) => name !== undefined);
+
+		const fileNode: SemanticNode = {
+			id: context.filePath,
+			fqn: context.filePath,
+			name: path.posix.basename(context.filePath),
+			kind: "file",
+			filePath: context.filePath,
+			language: this.language,
+			line: 1,
+			semanticTags: [],
+			metadata: {
+				package: protoPackage,
+				goPackage: goPackage ? normalizeGoPackage(goPackage) : undefined,
+			},
+		};
+		const nodes: SemanticNode[] = [fileNode];
+		const edges: SemanticEdge[] = [];
+
+		const scopes: OpenScope[] = [];
+		let pendingDoc: string[] = [];
+		let depth = 0;
+		let offset = 0;
+
+		lines.forEach((rawLine, index) => {
+			const lineNumber = index + 1;
+			const trimmed = rawLine.trim();
+			const lineStart = offset;
+			offset += rawLine.length + 1;
+
+			if (trimmed.startsWith("//")) {
+				pendingDoc.push(trimmed);
+				return;
+			}
+
+			const line = rawLine.replace(/\/\/.*$/, "");
+			const importMatch = line.match(IMPORT_PATTERN);
+			if (importMatch) {
+				nodes.push(createImportNode(importMatch[1]));
+				edges.push({
+					from: fileNode.id,
+					to: importMatch[1],
+					type: "imports",
+					metadata: { line: lineNumber },
+				});
+			}
+
+			const parent = scopes[scopes.length - 1]?.node;
+			const definition = line.match(DEFINITION_PATTERN);
+			const rpc = line.match(RPC_PATTERN);
+			const declarationStart =
+				lineStart + line.length - line.trimStart().length;
+
+			if (definition) {
+				const [, kind, name] = definition;
+				const fqn = parent
+					? `${parent.fqn}.${name}`
+					: qualify(protoPackage, name);
+				const node = this.createNode(
+					kind,
+					name,
+					fqn,
+					context,
+					lineNumber,
+					pendingDoc,
+					goPackage,
+				);
+				node.nameRange = nameRange(
+					context.sourceCode,
+					lineStart,
+					line,
+					kind,
+					name,
+				);
+				if (kind !== "service") {
+					node.metadata.goName = parent
+						? `${parent.metadata.goName}_${name}`
+						: name;
+				}
+				nodes.push(node);
+				edges.push({
+					from: parent ? parent.id : fileNode.id,
+					to: node.id,
+					type: "contains",
+				});
+				scopes.push({ node, depth: depth + 1, start: declarationStart });
+			} else if (rpc && parent?.kind === "service") {
+				const [
+					,
+					name,
+					requestStream,
+					requestType,
+					responseStream,
+					responseType,
+				] = rpc;
+				const node = this.createNode(
+					"rpc",
+					name,
+					`${parent.fqn}.${name}`,
+					context,
+					lineNumber,
+					pendingDoc,
+					goPackage,
+				);
+				node.metadata.request = qualify(protoPackage, requestType);
+				node.metadata.response = qualify(protoPackage, responseType);
+				node.metadata.clientStreaming = Boolean(requestStream);
+				node.metadata.serverStreaming = Boolean(responseStream);
+				node.nameRange = nameRange(
+					context.sourceCode,
+					lineStart,
+					line,
+					"rpc",
+					name,
+				);
+				if (line.includes("{")) {
+					scopes.push({ node, depth: depth + 1, start: declarationStart });
+				} else {
+					node.range = getTextRange(
+						context.sourceCode,
+						declarationStart,
+						lineStart + line.trimEnd().length,
+					);
+				}
+				nodes.push(node);
+				edges.push(
+					{ from: parent.id, to: node.id, type: "contains" },
+					{ from: node.id, to: node.metadata.request, type: "references" },
+					{ from: node.id, to: node.metadata.response, type: "references" },
+				);
+			}
+
+			if (trimmed.length > 0) {
+				pendingDoc = [];
+			}
+
+			for (let column = 0; column < line.length; column++) {
+				if (line[column] === "{") {
+					depth++;
+				} else if (line[column] === "}") {
+					depth--;
+					while (
+						scopes.length > 0 &&
+						scopes[scopes.length - 1].depth > depth
+					) {
+						const scope = scopes.pop() as OpenScope;
+						scope.node.range = getTextRange(
+							context.sourceCode,
+							scope.start,
+							lineStart + column + 1,
+						);
+					}
+				}
+			}
+		});
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes,
+			edges,
+		};
+	}
+
+	/**
+	 * 생성된 Go 타입과 proto 정의 연결
+	 *
+	 * go_package의 패키지 이름이 같은 Go 노드 중 message/enum은 protoc-gen-go가
+	 * 만드는 이름의 타입(중첩 정의는 `Parent_Child`), service는 `<Service>Server`/`<Service>Client` 타입과 연결한다.
+	 */
+	link(graph: SemanticGraph): void {
+		const goNodes = Array.from(graph.nodes.values()).filter(
+			(node) => node.language === "go",
+		);
+
+		for (const node of Array.from(graph.nodes.values())) {
+			if (node.language !== this.language || !node.metadata.goPackage) {
+				continue;
+			}
+
+			let candidates: string[] = [];
+			if (node.kind === "message" || node.kind === "enum") {
+				candidates = [node.metadata.goName];
+			} else if (node.kind === "service") {
+				candidates = [`${node.name}Server`, `${node.name}Client`];
+			}
+
+			for (const goNode of goNodes) {
+				if (
+					goNode.metadata.package === node.metadata.goPackage &&
+					candidates.includes(goNode.name) &&
+					goNode.kind !== "method"
+				) {
+					graph.addEdge({ from: node.id, to: goNode.id, type: "generates" });
+				}
+			}
+		}
+	}
+
+	private createNode(
+		kind: string,
+		name: string,
+		fqn: string,
+		context: ExtractionContext,
+		line: number,
+		comments: string[],
+		goPackage: string | undefined,
+	): SemanticNode {
+		const doc = parseDocComments(comments, context.annotationParsers);
+		return {
+			id: fqn,
+			fqn,
+			name,
+			kind,
+			filePath: context.filePath,
+			language: this.language,
+			line,
+			semanticTags: doc.semanticTags,
+			description: doc.description,
+			metadata: {
+				annotations: doc.annotations,
+				annotationData: doc.data,
+				doc: doc.comment,
+				goPackage: goPackage ? normalizeGoPackage(goPackage) : undefined,
+			},
+		};
+	}
+}
+
+/**
+ * import 대상 파일의 자리표시 노드 (같은 파일이 분석되면 실제 파일 노드로 대체됨)
+ */
+function createImportNode(importPath: string): SemanticNode {
+	return {
+		id: importPath,
+		fqn: importPath,
+		name: path.posix.basename(importPath),
+		kind: "external",
+		filePath: importPath,
+		language: "proto",
+		semanticTags: [],
+		metadata: { importPath },
+	};
+}
+
+/**
+ * 정의 라인에서 키워드 뒤 이름 토큰의 범위
+ */
+function nameRange(
+	sourceCode: string,
+	lineStart: number,
+	line: string,
+	keyword: string,
+	name: string,
+): SourceRange {
+	const column = line.indexOf(name, line.indexOf(keyword) + keyword.length);
+	return getTextRange(
+		sourceCode,
+		lineStart + column,
+		lineStart + column + name.length,
+	);
+}
+
+function qualify(protoPackage: string | undefined, name: string): string {
+	if (!protoPackage || name.includes(".")) {
+		return name.replace(/^\./, "");
+	}
+	return `${protoPackage}.${name}`;
+}
+
+/**
+ * go_package 옵션에서 Go 패키지 이름 추출
+ * 예: "example.com/gen/userpb;userpb" -> "userpb", "example.com/gen/userpb" -> "userpb"
+ */
+function normalizeGoPackage(goPackage: string): string {
+	const [importPath, explicitName] = goPackage.split(";");
+	return explicitName || importPath.split("/").pop() || importPath;
+}
+
+/**
+ * Proto 추출기 팩토리 함수
+ */
+export function createProtoExtractor(): ProtoExtractor {
+	return new ProtoExtractor();
+}
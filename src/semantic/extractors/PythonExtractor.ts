@@ -0,0 +1,372 @@
+/**
+ * Python Extractor
+ * Python 소스에서 모듈 import, 최상위 함수/클래스 심볼과 호출 관계 추출
+ */
+
+import type Parser from "tree-sitter";
+import {
+	createDocComment,
+	parseDocComments,
+	stripCommentMarkers,
+} from "../annotations";
+import { computeComplexity, PYTHON_COMPLEXITY_GRAMMAR } from "../complexity";
+import { setSourceRanges } from "../source-range";
+import type { SemanticEdge, SemanticNode } from "../types";
+import { collectDocCommentTexts } from "./GoExtractor";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+/**
+ * import 한 건 (from-import는 가져온 이름마다 한 건)
+ */
+export interface PythonImport {
+	/** 절대 모듈 경로 (상대 import는 현재 모듈 기준으로 해석) */
+	module: string;
+	/** from-import로 가져온 이름 (import x 형식은 undefined) */
+	name?: string;
+	/** as 별칭 */
+	alias?: string;
+	line: number;
+}
+
+/**
+ * 파일 경로를 모듈 이름으로 변환 ("app/user/service.py" -> "app.user.service")
+ *
+ * __init__.py는 패키지 이름이 된다.
+ */
+export function pythonModuleName(filePath: string): string {
+	const parts = filePath
+		.replace(/\\/g, "/")
+		.replace(/\.pyi?$/, "")
+		.split("/")
+		.filter((part) => part.length > 0 && part !== ".");
+	if (parts[parts.length - 1] === "__init__" && parts.length > 1) {
+		parts.pop();
+	}
+	return parts.join(".");
+}
+
+/**
+ * Python 심볼 추출기
+ *
+ * 모듈마다 "module" 노드를 만들고 import한 모듈로 "imports" 엣지를 연결한다.
+ * 분석되지 않은 모듈은 "external" 자리표시 노드가 된다. `# @semantic-tags:`
+ * 주석은 Go와 같은 방식으로 바로 아래 정의에 적용된다.
+ */
+export class PythonExtractor implements LanguageExtractor {
+	readonly name = "python-symbols";
+	readonly language = "python";
+	readonly extensions = ["py"];
+	readonly requiresTree = true;
+
+	extract(context: ExtractionContext): FileExtraction {
+		if (!context.tree) {
+			throw new Error(
+				`Python extraction requires a syntax tree: ${context.filePath}`,
+			);
+		}
+
+		const root = context.tree.rootNode;
+		const moduleName = pythonModuleName(context.filePath);
+		const isPackage = /(^|\/)__init__\.pyi?$/.test(context.filePath);
+		const moduleNode: SemanticNode = {
+			id: moduleName,
+			fqn: moduleName,
+			name: moduleName.split(".").pop() ?? moduleName,
+			kind: "module",
+			filePath: context.filePath,
+			language: this.language,
+			line: 1,
+			semanticTags: [],
+			metadata: { isPackage },
+		};
+		const nodes: SemanticNode[] = [moduleNode];
+		const edges: SemanticEdge[] = [];
+
+		// 호출 해석용: 로컬 이름 -> 심볼/모듈 FQN
+		const scope = new Map<string, string>();
+		const imports = collectPythonImports(root, moduleName, isPackage);
+		const byModule = new Map<string, PythonImport[]>();
+		for (const entry of imports) {
+			const entries = byModule.get(entry.module) ?? [];
+			entries.push(entry);
+			byModule.set(entry.module, entries);
+
+			if (entry.name) {
+				scope.set(entry.alias ?? entry.name, `${entry.module}.${entry.name}`);
+			} else if (entry.alias) {
+				scope.set(entry.alias, entry.module);
+			} else {
+				// `import a.b`는 a를 바인딩하므로 a.b.f()는 a부터 해석
+				const head = entry.module.split(".")[0];
+				scope.set(head, head);
+			}
+		}
+		for (const [module, entries] of byModule) {
+			nodes.push(createModulePlaceholder(module));
+			edges.push({
+				from: moduleNode.id,
+				to: module,
+				type: "imports",
+				metadata: {
+					line: entries[0].line,
+					names: entries.flatMap((entry) =>
+						entry.name ? [entry.name] : [],
+					),
+					aliases: entries.flatMap((entry) =>
+						entry.alias ? [entry.alias] : [],
+					),
+				},
+			});
+		}
+
+		const definitions: Array<{
+			node: SemanticNode;
+			body: Parser.SyntaxNode;
+		}> = [];
+		for (const child of root.namedChildren) {
+			const definition =
+				child.type === "decorated_definition"
+					? child.childForFieldName("definition")
+					: child;
+			if (
+				definition?.type !== "function_definition" &&
+				definition?.type !== "class_definition"
+			) {
+				continue;
+			}
+
+			const nameNode = definition.childForFieldName("name");
+			const body = definition.childForFieldName("body");
+			if (!nameNode || !body) continue;
+
+			const comments = collectDocCommentTexts(child);
+			const doc = parseDocComments(comments, context.annotationParsers);
+			const docstring = findDocstring(body);
+			const node: SemanticNode = {
+				id: `${moduleName}.${nameNode.text}`,
+				fqn: `${moduleName}.${nameNode.text}`,
+				name: nameNode.text,
+				kind: definition.type === "class_definition" ? "class" : "function",
+				filePath: context.filePath,
+				language: this.language,
+				line: child.startPosition.row + 1,
+				semanticTags: doc.semanticTags,
+				description: doc.description,
+				metadata: {
+					module: moduleName,
+					annotations: doc.annotations,
+					annotationData: doc.data,
+					doc: docstring
+						? createDocComment(
+								[...comments, docstring],
+								[
+									...comments.flatMap(stripCommentMarkers),
+									...docstringLines(docstring),
+								],
+							)
+						: doc.comment,
+					// 밑줄로 시작하는 이름은 모듈 내부용 (Python 관례)
+					exported: !nameNode.text.startsWith("_"),
+				},
+			};
+			setSourceRanges(node, context.sourceCode, child, nameNode);
+			if (node.kind === "function") {
+				node.metadata.complexity = computeComplexity(
+					definition,
+					body,
+					PYTHON_COMPLEXITY_GRAMMAR,
+				);
+			}
+			nodes.push(node);
+			edges.push({ from: moduleNode.id, to: node.id, type: "contains" });
+			definitions.push({ node, body });
+			scope.set(node.name, node.id);
+		}
+
+		for (const { node, body } of definitions) {
+			for (const call of body.descendantsOfType("call")) {
+				const target = resolveCallee(call, scope);
+				if (target && target !== node.id) {
+					edges.push({
+						from: node.id,
+						to: target,
+						type: "calls",
+						metadata: { line: call.startPosition.row + 1 },
+					});
+				}
+			}
+		}
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes,
+			edges,
+		};
+	}
+}
+
+/**
+ * 구문 트리에서 모듈 최상위 import 수집
+ *
+ * `import a.b`, `import a.b as c`, `from a import b, c as d`,
+ * `from . import x`, `from ..pkg import y`를 지원한다. `from a import *`는
+ * 이름 없이 모듈만 기록한다.
+ */
+export function collectPythonImports(
+	root: Parser.SyntaxNode,
+	moduleName: string,
+	isPackage = false,
+): PythonImport[] {
+	const imports: PythonImport[] = [];
+
+	for (const statement of root.namedChildren) {
+		const line = statement.startPosition.row + 1;
+
+		if (statement.type === "import_statement") {
+			for (const child of statement.namedChildren) {
+				const { name, alias } = splitAlias(child);
+				if (name) imports.push({ module: name, alias, line });
+			}
+		} else if (statement.type === "import_from_statement") {
+			const moduleNode = statement.childForFieldName("module_name");
+			if (!moduleNode) continue;
+			const module = resolveRelativeModule(
+				moduleNode.text,
+				moduleName,
+				isPackage,
+			);
+
+			const names = statement.namedChildren.filter(
+				(child) => child.id !== moduleNode.id,
+			);
+			if (names.some((child) => child.type === "wildcard_import")) {
+				if (module) imports.push({ module, line });
+				continue;
+			}
+			for (const child of names) {
+				const { name, alias } = splitAlias(child);
+				if (!name) continue;
+				imports.push(
+					module
+						? { module, name, alias, line }
+						: { module: name, alias, line },
+				);
+			}
+		}
+	}
+
+	return imports;
+}
+
+/**
+ * dotted_name 또는 aliased_import에서 이름과 별칭 분리
+ */
+function splitAlias(node: Parser.SyntaxNode): {
+	name?: string;
+	alias?: string;
+} {
+	if (node.type === "aliased_import") {
+		return {
+			name: node.childForFieldName("name")?.text,
+			alias: node.childForFieldName("alias")?.text,
+		};
+	}
+	if (node.type === "dotted_name" || node.type === "identifier") {
+		return { name: node.text };
+	}
+	return {};
+}
+
+/**
+ * 상대 import 모듈 경로를 절대 경로로 변환 (".a" -> 같은 패키지의 a)
+ */
+function resolveRelativeModule(
+	text: string,
+	moduleName: string,
+	isPackage: boolean,
+): string {
+	const dots = text.match(/^\.*/)?.[0].length ?? 0;
+	if (dots === 0) return text;
+
+	const packageParts = moduleName.split(".");
+	if (!isPackage) packageParts.pop();
+	const base = packageParts.slice(0, packageParts.length - (dots - 1));
+	const rest = text.slice(dots);
+	return [...base, ...(rest ? [rest] : [])].join(".");
+}
+
+/**
+ * 호출 대상 해석 (`name()` 또는 `alias.func()`)
+ */
+function resolveCallee(
+	call: Parser.SyntaxNode,
+	scope: Map<string, string>,
+): string | null {
+	const callee = call.childForFieldName("function");
+	if (!callee) return null;
+
+	if (callee.type === "identifier") {
+		return scope.get(callee.text) ?? null;
+	}
+	if (callee.type === "attribute") {
+		const match = callee.text.match(/^([A-Za-z_]\w*)((?:\.[A-Za-z_]\w*)+)$/);
+		const base = match ? scope.get(match[1]) : undefined;
+		return base ? `${base}${match?.[2]}` : null;
+	}
+	return null;
+}
+
+/**
+ * import한 모듈의 자리표시 노드 (같은 모듈이 분석되면 실제 모듈 노드로 대체됨)
+ */
+/**
+ * 함수/클래스 본문 첫 문장의 docstring 원문 (따옴표 포함)
+ */
+function findDocstring(body: Parser.SyntaxNode): string | undefined {
+	const first = body.namedChildren[0];
+	const expression = first?.namedChildren[0];
+	return first?.type === "expression_statement" &&
+		expression?.type === "string"
+		? expression.text
+		: undefined;
+}
+
+/**
+ * docstring 본문 라인 (접두사/따옴표 제거, 둘째 줄부터 공통 들여쓰기 제거)
+ */
+function docstringLines(docstring: string): string[] {
+	const content = docstring
+		.replace(/^[rRuUbBfF]*("""|'''|"|')/, "")
+		.replace(/("""|'''|"|')$/, "");
+	const [first, ...rest] = content.split("\n");
+	const indents = rest
+		.filter((line) => line.trim() !== "")
+		.map((line) => line.length - line.trimStart().length);
+	const indent = indents.length > 0 ? Math.min(...indents) : 0;
+	return [first.trim(), ...rest.map((line) => line.slice(indent).trimEnd())];
+}
+
+function createModulePlaceholder(module: string): SemanticNode {
+	return {
+		id: module,
+		fqn: module,
+		name: module.split(".").pop() ?? module,
+		kind: "external",
+		filePath: `${module.replace(/\./g, "/")}.py`,
+		language: "python",
+		semanticTags: [],
+		metadata: { importPath: module },
+	};
+}
+
+/**
+ * Python 추출기 팩토리 함수
+ */
+export function createPythonExtractor(): PythonExtractor {
+	return new PythonExtractor();
+}
@@ -0,0 +1,619 @@
+/**
+ * TypeScript Extractor
+ * TypeScript/JavaScript 소스에서 ES 모듈/CommonJS 의존성과 최상위 심볼 추출
+ */
+
+import path from "node:path";
+import type Parser from "tree-sitter";
+import { parseDocComments } from "../annotations";
+import type { SemanticGraph } from "../SemanticGraph";
+import { setSourceRanges } from "../source-range";
+import type { SemanticEdge, SemanticNode } from "../types";
+import { collectDocCommentTexts } from "./GoExtractor";
+import type {
+	ExtractionContext,
+	FileExtraction,
+	LanguageExtractor,
+} from "./LanguageExtractor";
+
+/**
+ * 의존성 선언 형태
+ *
+ * - import: `import ... from "x"`, `import "x"`
+ * - require: `require("x")`, `import x = require("x")`
+ * - dynamic: `import("x")`
+ * - export: `export ... from "x"` (re-export)
+ */
+export type TypeScriptImportForm = "import" | "require" | "dynamic" | "export";
+
+/**
+ * 모듈 의존성 한 건
+ */
+export interface TypeScriptImport {
+	/** 원본 모듈 지정자 (예: "./user", "react") */
+	specifier: string;
+	form: TypeScriptImportForm;
+	/** 가져온 이름 (default import는 "default", namespace는 "*") */
+	names: string[];
+	/** 런타임에 사라지는 타입 전용 import/export */
+	typeOnly: boolean;
+	line: number;
+}
+
+/** 값이 함수이면 변수 선언을 함수 심볼로 본다 */
+const FUNCTION_VALUE_TYPES = new Set([
+	"arrow_function",
+	"function",
+	"function_expression",
+	"generator_function",
+]);
+
+/** 선언 노드 타입 -> 심볼 종류 */
+const DECLARATION_KINDS: Record<string, string> = {
+	function_declaration: "function",
+	generator_function_declaration: "function",
+	class_declaration: "class",
+	abstract_class_declaration: "class",
+	interface_declaration: "interface",
+	type_alias_declaration: "type",
+	enum_declaration: "enum",
+};
+
+/**
+ * 파일 확장자로 언어 이름 판단 (파서는 TypeScript 문법을 공유)
+ */
+export function typeScriptLanguageOf(filePath: string): string {
+	return /\.[mc]?jsx?$/.test(filePath) ? "javascript" : "typescript";
+}
+
+/**
+ * 모듈 지정자를 그래프 노드 ID로 변환
+ *
+ * 상대 경로는 현재 파일 기준 경로로 정규화하고(확장자는 붙이지 않음),
+ * 패키지 이름은 그대로 사용한다.
+ */
+export function typeScriptImportTarget(
+	filePath: string,
+	specifier: string,
+): string {
+	if (!specifier.startsWith(".")) return specifier;
+	return path.posix.join(
+		path.posix.dirname(filePath.replace(/\\/g, "/")),
+		specifier,
+	);
+}
+
+/** 확장자 없는 지정자가 가리킬 수 있는 모듈 파일 확장자 (우선순위 순) */
+export const TYPESCRIPT_MODULE_EXTENSIONS = [
+	".ts",
+	".tsx",
+	".d.ts",
+	".mts",
+	".cts",
+	".js",
+	".jsx",
+	".mjs",
+	".cjs",
+];
+
+/**
+ * 모듈 경로가 가리킬 수 있는 파일 노드 ID 후보 (우선순위 순)
+ *
+ * 확장자 생략, 디렉토리 index 파일, ESM 스타일 ".js" 지정자를 처리한다.
+ */
+export function typeScriptModuleCandidates(base: string): string[] {
+	const stem = base.replace(/\.[mc]?jsx?$/, "");
+	return [
+		base,
+		...TYPESCRIPT_MODULE_EXTENSIONS.map((ext) => `${stem}${ext}`),
+		...TYPESCRIPT_MODULE_EXTENSIONS.map((ext) => `${base}/index${ext}`),
+	];
+}
+
+/**
+ * 구문 트리에서 모듈 의존성 수집
+ *
+ * 최상위 import/export 선언과 파일 어디서든 문자열 인자로 호출한
+ * require()/import()를 수집한다. 지정자가 문자열 리터럴이 아니면 무시한다.
+ */
+export function collectTypeScriptImports(
+	root: Parser.SyntaxNode,
+): TypeScriptImport[] {
+	const imports: TypeScriptImport[] = [];
+
+	for (const statement of root.namedChildren) {
+		const line = statement.startPosition.row + 1;
+
+		if (statement.type === "import_statement") {
+			const requireClause = statement.namedChildren.find(
+				(child) => child.type === "import_require_clause",
+			);
+			const source =
+				statement.childForFieldName("source") ??
+				requireClause?.childForFieldName("source");
+			const specifier = source ? stringValue(source) : undefined;
+			if (specifier === undefined) continue;
+
+			const clause = statement.namedChildren.find(
+				(child) => child.type === "import_clause",
+			);
+			const { names, allTyped } = clause
+				? collectImportNames(clause)
+				: { names: [], allTyped: false };
+			imports.push({
+				specifier,
+				form: requireClause ? "require" : "import",
+				names,
+				typeOnly: hasKeyword(statement, "type") || allTyped,
+				line,
+			});
+		} else if (statement.type === "export_statement") {
+			const source = statement.childForFieldName("source");
+			const specifier = source ? stringValue(source) : undefined;
+			if (specifier === undefined) continue;
+
+			const clause = statement.namedChildren.find(
+				(child) => child.type === "export_clause",
+			);
+			const specifiers = clause
+				? clause.namedChildren.filter(
+						(child) => child.type === "export_specifier",
+					)
+				: [];
+			imports.push({
+				specifier,
+				form: "export",
+				names: clause
+					? specifiers.map(
+							(child) => child.childForFieldName("name")?.text ?? "",
+						)
+					: ["*"],
+				typeOnly:
+					hasKeyword(statement, "type") ||
+					(specifiers.length > 0 &&
+						specifiers.every((child) => hasKeyword(child, "type"))),
+				line,
+			});
+		}
+	}
+
+	for (const call of root.descendantsOfType("call_expression")) {
+		const callee = call.childForFieldName("function");
+		const form =
+			callee?.type === "import"
+				? "dynamic"
+				: callee?.type === "identifier" && callee.text === "require"
+					? "require"
+					: undefined;
+		const argument = call.childForFieldName("arguments")?.namedChildren[0];
+		const specifier = argument ? stringValue(argument) : undefined;
+		if (!form || specifier === undefined) continue;
+
+		imports.push({
+			specifier,
+			form,
+			names: [],
+			typeOnly: false,
+			line: call.startPosition.row + 1,
+		});
+	}
+
+	return imports.sort((a, b) => a.line - b.line);
+}
+
+/**
+ * TypeScript/JavaScript 심볼 추출기
+ *
+ * 파일마다 "module" 노드를 만들고 의존 모듈로 "imports" 엣지를 연결한다.
+ * 타입 전용 의존성은 런타임에 존재하지 않으므로 "imports_type" 엣지로
+ * 구분한다. 심볼 ID는 "파일경로#이름" (메서드는 "파일경로#클래스.메서드")이며
+ * `// @semantic-tags:`, `/** @description: *\/` 주석을 Go와 같은 방식으로 읽는다.
+ * `export { a as b } from "x"`는 "export" 종류의 심볼(파일경로#b)을 만들고,
+ * link 단계에서 원본 심볼로 "re-exports" 엣지를 잇는다 (compactGraph 참고).
+ * `export * from "x"`는 이름을 알 수 없으므로 모듈 의존성으로만 남는다.
+ */
+export class TypeScriptExtractor implements LanguageExtractor {
+	readonly name = "typescript-symbols";
+	readonly language = "typescript";
+	readonly extensions = ["ts", "tsx", "mts", "cts", "js", "jsx", "mjs", "cjs"];
+	readonly requiresTree = true;
+
+	extract(context: ExtractionContext): FileExtraction {
+		if (!context.tree) {
+			throw new Error(
+				`TypeScript extraction requires a syntax tree: ${context.filePath}`,
+			);
+		}
+
+		const root = context.tree.rootNode;
+		const language = typeScriptLanguageOf(context.filePath);
+		const moduleNode: SemanticNode = {
+			id: context.filePath,
+			fqn: context.filePath,
+			name: path.posix.basename(context.filePath),
+			kind: "module",
+			filePath: context.filePath,
+			language,
+			line: 1,
+			semanticTags: [],
+			metadata: {},
+		};
+		const nodes: SemanticNode[] = [moduleNode];
+		const edges: SemanticEdge[] = [];
+
+		// 같은 대상/엣지 타입의 의존성은 엣지 하나로 합친다
+		const grouped = new Map<string, TypeScriptImport[]>();
+		for (const entry of collectTypeScriptImports(root)) {
+			const key = `${entry.typeOnly ? "type" : "value"}\n${entry.specifier}`;
+			const entries = grouped.get(key) ?? [];
+			entries.push(entry);
+			grouped.set(key, entries);
+		}
+		for (const entries of grouped.values()) {
+			const [first] = entries;
+			const target = typeScriptImportTarget(context.filePath, first.specifier);
+			nodes.push(createModulePlaceholder(target, first.specifier, language));
+			edges.push({
+				from: moduleNode.id,
+				to: target,
+				type: first.typeOnly ? "imports_type" : "imports",
+				metadata: {
+					line: first.line,
+					specifier: first.specifier,
+					forms: unique(entries.map((entry) => entry.form)),
+					names: unique(entries.flatMap((entry) => entry.names)),
+				},
+			});
+		}
+
+		const localExports = new Map<string, string>();
+		for (const statement of root.namedChildren) {
+			const exported = statement.type === "export_statement";
+			const source = exported && statement.childForFieldName("source");
+			if (source) {
+				const specifier = stringValue(source);
+				if (specifier === undefined) continue;
+				this.extractReExports(
+					statement,
+					specifier,
+					moduleNode,
+					context,
+					language,
+					nodes,
+					edges,
+				);
+				continue;
+			}
+
+			const declaration = exported
+				? statement.childForFieldName("declaration")
+				: statement;
+			if (!declaration) {
+				// export { a, b as c }
+				const clause = statement.namedChildren.find(
+					(child) => child.type === "export_clause",
+				);
+				for (const specifier of clause?.namedChildren ?? []) {
+					const name = specifier.childForFieldName("name")?.text;
+					if (!name) continue;
+					localExports.set(
+						name,
+						specifier.childForFieldName("alias")?.text ?? name,
+					);
+				}
+				continue;
+			}
+
+			const isDefault = exported && hasKeyword(statement, "default");
+			for (const { name, kind, node } of declaredSymbols(declaration)) {
+				const symbol = this.createSymbolNode(
+					`${context.filePath}#${name}`,
+					name,
+					kind,
+					statement,
+					context,
+					language,
+				);
+				setSourceRanges(
+					symbol,
+					context.sourceCode,
+					statement,
+					node.childForFieldName("name"),
+				);
+				symbol.metadata.exported = exported;
+				if (isDefault) symbol.metadata.exportedAs = "default";
+				nodes.push(symbol);
+				edges.push({ from: moduleNode.id, to: symbol.id, type: "contains" });
+
+				if (kind === "class") {
+					this.extractMethods(node, symbol, context, language, nodes, edges);
+				}
+			}
+		}
+
+		for (const node of nodes) {
+			const alias =
+				node.kind !== "method" && node.id.startsWith(`${moduleNode.id}#`)
+					? localExports.get(node.name)
+					: undefined;
+			if (alias === undefined) continue;
+			node.metadata.exported = true;
+			if (alias !== node.name) node.metadata.exportedAs = alias;
+		}
+
+		return {
+			filePath: context.filePath,
+			language: this.language,
+			nodes,
+			edges,
+		};
+	}
+
+	/**
+	 * re-export 심볼을 원본 모듈의 심볼로 연결
+	 *
+	 * 원본 모듈은 import와 같은 규칙(확장자 생략, index 파일)으로 찾고,
+	 * `export { default } from`은 원본 모듈의 default export 심볼로 잇는다.
+	 * 분석되지 않은 모듈의 심볼은 연결하지 않는다.
+	 */
+	link(graph: SemanticGraph): void {
+		for (const node of Array.from(graph.nodes.values())) {
+			const reExport = node.metadata.reExport as
+				| { specifier: string; name: string }
+				| undefined;
+			if (node.kind !== "export" || !reExport) continue;
+
+			const target = typeScriptModuleCandidates(
+				typeScriptImportTarget(node.filePath, reExport.specifier),
+			).find((candidate) => graph.getNode(candidate)?.kind === "module");
+			if (!target) continue;
+
+			const original =
+				graph.getNode(`${target}#${reExport.name}`) ??
+				(reExport.name === "default"
+					? graph
+							.getOutgoingEdges(target, ["contains"])
+							.map((edge) => graph.getNode(edge.to))
+							.find((symbol) => symbol?.metadata.exportedAs === "default")
+					: undefined);
+			if (!original || original.id === node.id) continue;
+
+			graph.addEdge({
+				from: node.id,
+				to: original.id,
+				type: "re-exports",
+				metadata: { specifier: reExport.specifier },
+			});
+		}
+	}
+
+	/**
+	 * `export { a, b as c } from "x"`의 이름마다 "export" 심볼 생성
+	 */
+	private extractReExports(
+		statement: Parser.SyntaxNode,
+		specifier: string,
+		moduleNode: SemanticNode,
+		context: ExtractionContext,
+		language: string,
+		nodes: SemanticNode[],
+		edges: SemanticEdge[],
+	): void {
+		const clause = statement.namedChildren.find(
+			(child) => child.type === "export_clause",
+		);
+		for (const child of clause?.namedChildren ?? []) {
+			if (child.type !== "export_specifier") continue;
+			const nameNode = child.childForFieldName("name");
+			if (!nameNode) continue;
+			const aliasNode = child.childForFieldName("alias") ?? nameNode;
+
+			const symbol = this.createSymbolNode(
+				`${moduleNode.id}#${aliasNode.text}`,
+				aliasNode.text,
+				"export",
+				statement,
+				context,
+				language,
+			);
+			setSourceRanges(symbol, context.sourceCode, child, aliasNode);
+			symbol.line = child.startPosition.row + 1;
+			symbol.metadata.exported = true;
+			symbol.metadata.reExport = { specifier, name: nameNode.text };
+			nodes.push(symbol);
+			edges.push({ from: moduleNode.id, to: symbol.id, type: "contains" });
+		}
+	}
+
+	/**
+	 * 클래스 본문의 메서드 노드 추출
+	 */
+	private extractMethods(
+		declaration: Parser.SyntaxNode,
+		owner: SemanticNode,
+		context: ExtractionContext,
+		language: string,
+		nodes: SemanticNode[],
+		edges: SemanticEdge[],
+	): void {
+		const body = declaration.childForFieldName("body");
+		for (const member of body?.namedChildren ?? []) {
+			if (member.type !== "method_definition") continue;
+			const name = member.childForFieldName("name")?.text;
+			if (!name) continue;
+
+			const method = this.createSymbolNode(
+				`${owner.id}.${name}`,
+				name,
+				"method",
+				member,
+				context,
+				language,
+			);
+			setSourceRanges(
+				method,
+				context.sourceCode,
+				member,
+				member.childForFieldName("name"),
+			);
+			method.metadata.className = owner.name;
+			method.metadata.static = hasKeyword(member, "static");
+			nodes.push(method);
+			edges.push({ from: owner.id, to: method.id, type: "contains" });
+		}
+	}
+
+	private createSymbolNode(
+		id: string,
+		name: string,
+		kind: string,
+		statement: Parser.SyntaxNode,
+		context: ExtractionContext,
+		language: string,
+	): SemanticNode {
+		const doc = parseDocComments(
+			collectDocCommentTexts(statement),
+			context.annotationParsers,
+		);
+		const node: SemanticNode = {
+			id,
+			fqn: id,
+			name,
+			kind,
+			filePath: context.filePath,
+			language,
+			line: statement.startPosition.row + 1,
+			semanticTags: doc.semanticTags,
+			description: doc.description,
+			metadata: { annotations: doc.annotations },
+		};
+		if (doc.data) {
+			node.metadata.annotationData = doc.data;
+		}
+		if (doc.comment) {
+			node.metadata.doc = doc.comment;
+		}
+		return node;
+	}
+}
+
+/**
+ * 선언 노드가 정의하는 이름과 종류 (변수 선언은 여러 개일 수 있음)
+ */
+function declaredSymbols(
+	declaration: Parser.SyntaxNode,
+): Array<{ name: string; kind: string; node: Parser.SyntaxNode }> {
+	if (
+		declaration.type === "lexical_declaration" ||
+		declaration.type === "variable_declaration"
+	) {
+		return declaration.namedChildren.flatMap((declarator) => {
+			const name = declarator.childForFieldName("name");
+			if (declarator.type !== "variable_declarator" || !name) return [];
+			if (name.type !== "identifier") return [];
+			const value = declarator.childForFieldName("value");
+			return [
+				{
+					name: name.text,
+					kind:
+						value && FUNCTION_VALUE_TYPES.has(value.type)
+							? "function"
+							: "variable",
+					node: declarator,
+				},
+			];
+		});
+	}
+
+	const kind = DECLARATION_KINDS[declaration.type];
+	const name = declaration.childForFieldName("name")?.text;
+	return kind && name ? [{ name, kind, node: declaration }] : [];
+}
+
+/**
+ * import 절에서 가져온 이름 수집
+ */
+function collectImportNames(clause: Parser.SyntaxNode): {
+	names: string[];
+	allTyped: boolean;
+} {
+	const names: string[] = [];
+	let specifiers = 0;
+	let typed = 0;
+
+	for (const child of clause.namedChildren) {
+		if (child.type === "identifier") {
+			names.push("default");
+		} else if (child.type === "namespace_import") {
+			names.push("*");
+		} else if (child.type === "named_imports") {
+			for (const specifier of child.namedChildren) {
+				if (specifier.type !== "import_specifier") continue;
+				specifiers++;
+				if (hasKeyword(specifier, "type")) typed++;
+				names.push(specifier.childForFieldName("name")?.text ?? "");
+			}
+		}
+	}
+
+	// default/namespace import가 섞이면 값 import
+	const allTyped =
+		specifiers > 0 && typed === specifiers && specifiers === names.length;
+	return { names, allTyped };
+}
+
+/**
+ * 노드의 직접 자식 중 익명 키워드 토큰이 있는지 확인
+ */
+function hasKeyword(node: Parser.SyntaxNode, keyword: string): boolean {
+	return node.children.some(
+		(child) => !child.isNamed && child.type === keyword,
+	);
+}
+
+/**
+ * 문자열 리터럴 값 (치환이 있는 템플릿 문자열은 undefined)
+ */
+function stringValue(node: Parser.SyntaxNode): string | undefined {
+	if (node.type === "string") {
+		return node.text.slice(1, -1);
+	}
+	if (
+		node.type === "template_string" &&
+		!node.namedChildren.some((child) => child.type === "template_substitution")
+	) {
+		return node.text.slice(1, -1);
+	}
+	return undefined;
+}
+
+function unique<T>(values: T[]): T[] {
+	return Array.from(new Set(values));
+}
+
+/**
+ * 의존 모듈의 자리표시 노드
+ */
+function createModulePlaceholder(
+	target: string,
+	specifier: string,
+	language: string,
+): SemanticNode {
+	return {
+		id: target,
+		fqn: target,
+		name: path.posix.basename(target),
+		kind: "external",
+		filePath: target,
+		language,
+		semanticTags: [],
+		metadata: { importPath: specifier },
+	};
+}
+
+/**
+ * TypeScript 추출기 팩토리 함수
+ */
+export function createTypeScriptExtractor(): TypeScriptExtractor {
+	return new TypeScriptExtractor();
+}
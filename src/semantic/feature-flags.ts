@@ -0,0 +1,90 @@
+/**
+ * Feature Flag Linking
+ * 플래그 확인 호출을 flag 노드와 연결
+ */
+
+import { resolveStringValue } from "./constants";
+import { getGoPackageId } from "./extractors/GoExtractor";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { CallSite } from "./types";
+
+/**
+ * 플래그 확인 함수 설정
+ */
+export interface FeatureFlagConfig {
+	/** 플래그 확인 호출 대상 (예: "flags.Enabled") */
+	functions: string[];
+	/** 플래그 키 인자 위치 (기본: 0) */
+	keyArgument?: number;
+}
+
+export const DEFAULT_FEATURE_FLAG_CONFIG: FeatureFlagConfig = {
+	functions: ["flags.Enabled", "flags.IsEnabled", "featureflag.Enabled"],
+};
+
+/**
+ * flag 노드 ID
+ */
+export function flagNodeId(key: string): string {
+	return `flag:${key}`;
+}
+
+/**
+ * 플래그 확인 호출을 찾아 flag 노드와 "uses_flag" 엣지 추가
+ *
+ * 키 인자는 리터럴뿐 아니라 상수(다른 파일/패키지 포함)도 해석한다.
+ * 해석하지 못한 호출은 호출 함수의 metadata.unresolvedFlags에 원본 식으로 남긴다.
+ * 추가된 flag 노드 수를 반환한다.
+ */
+export function linkFeatureFlags(
+	graph: SemanticGraph,
+	config: FeatureFlagConfig = DEFAULT_FEATURE_FLAG_CONFIG,
+): number {
+	const keyArgument = config.keyArgument ?? 0;
+	let created = 0;
+
+	for (const node of Array.from(graph.nodes.values())) {
+		const callSites = node.metadata.callSites as CallSite[] | undefined;
+		if (!callSites) continue;
+
+		const unresolved: string[] = [];
+		for (const site of callSites) {
+			if (!config.functions.includes(site.callee)) continue;
+
+			const expression = site.arguments[keyArgument];
+			if (expression === undefined) continue;
+
+			const key = resolveStringValue(graph, expression, getGoPackageId(node));
+			if (key === undefined) {
+				unresolved.push(expression);
+				continue;
+			}
+
+			const id = flagNodeId(key);
+			if (!graph.hasNode(id)) {
+				graph.addNode({
+					id,
+					fqn: id,
+					name: key,
+					kind: "flag",
+					filePath: node.filePath,
+					semanticTags: [],
+					metadata: {},
+				});
+				created++;
+			}
+			graph.addEdge({
+				from: node.id,
+				to: id,
+				type: "uses_flag",
+				metadata: { line: site.line, expression },
+			});
+		}
+
+		if (unresolved.length > 0) {
+			node.metadata.unresolvedFlags = unresolved;
+		}
+	}
+
+	return created;
+}
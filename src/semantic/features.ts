@@ -0,0 +1,82 @@
+/**
+ * Feature Ownership
+ * @feature 어노테이션 조회 및 기능별 영향 범위 리포트
+ */
+
+import { getAnnotationValues } from "./annotations";
+import {
+	computeImpactSet,
+	type ImpactEntry,
+	type ImpactOptions,
+} from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 기능별 영향 범위
+ */
+export interface FeatureImpact {
+	feature: string;
+	/** 변경된 파일에 선언된 이 기능의 심볼 (ID 순) */
+	changed: SemanticNode[];
+	/** 변경 심볼에 의존하는 이 기능의 심볼 (깊이, ID 순) */
+	affected: ImpactEntry[];
+}
+
+/**
+ * 노드가 속한 기능 목록 (`@feature checkout, payments`)
+ */
+export function getFeatures(node: SemanticNode): string[] {
+	const features = getAnnotationValues(node, "feature").flatMap((value) =>
+		value.split(/[\s,]+/).filter((feature) => feature.length > 0),
+	);
+	return Array.from(new Set(features));
+}
+
+/**
+ * 변경된 파일의 영향 범위를 기능별로 묶기 (기능 이름 순)
+ *
+ * 변경된 파일에 선언된 심볼과 computeImpactSet으로 구한 의존 심볼을
+ * 각자의 @feature로 분류한다. 기능이 없는 심볼은 결과에서 빠지며,
+ * 여러 기능에 속한 심볼은 각 기능에 모두 포함된다.
+ */
+export function impactByFeature(
+	graph: SemanticGraph,
+	changedFiles: string[],
+	options: ImpactOptions = {},
+): FeatureImpact[] {
+	const files = new Set(changedFiles);
+	const changed = Array.from(graph.nodes.values())
+		.filter((node) => files.has(node.filePath))
+		.sort((a, b) => (a.id < b.id ? -1 : a.id > b.id ? 1 : 0));
+	const affected = computeImpactSet(
+		graph,
+		changed.map((node) => node.id),
+		options,
+	);
+
+	const byFeature = new Map<string, FeatureImpact>();
+	const entryFor = (feature: string) => {
+		let entry = byFeature.get(feature);
+		if (!entry) {
+			entry = { feature, changed: [], affected: [] };
+			byFeature.set(feature, entry);
+		}
+		return entry;
+	};
+
+	for (const node of changed) {
+		for (const feature of getFeatures(node)) {
+			entryFor(feature).changed.push(node);
+		}
+	}
+	for (const impact of affected) {
+		for (const feature of getFeatures(impact.node)) {
+			entryFor(feature).affected.push(impact);
+		}
+	}
+
+	return Array.from(byFeature.values()).sort((a, b) =>
+		a.feature < b.feature ? -1 : a.feature > b.feature ? 1 : 0,
+	);
+}
@@ -0,0 +1,132 @@
+/**
+ * File Directives
+ * 파일 머리 주석의 @file-tags, @file-description을 파일의 모든 심볼에 적용
+ *
+ * 예:
+ *   // @file-tags: generated, legacy
+ *   // @file-description: protoc이 생성한 코드
+ *   package userpb
+ *
+ * 심볼의 태그/설명은 아래 순서로 정해진다.
+ *
+ * 1. 심볼 자신의 @semantic-tags (항상 유지, 맨 앞)
+ * 2. @file-tags 중 심볼에 아직 없는 태그를 뒤에 추가. 단, 심볼 태그와
+ *    배타적 그룹(DEFAULT_EXCLUSIVE_TAG_GROUPS)을 이루는 태그는 심볼 태그가
+ *    우선하므로 건너뛴다 (파일이 public-api여도 internal 심볼은 internal).
+ * 3. 설명은 심볼의 @description, 없으면 @file-description
+ * 4. 그 위에 상위 심볼에서 상속되는 태그(getEffectiveTags)와 테스트 파일의
+ *    TEST_TAG가 더해진다.
+ */
+
+import { parseDirectives, stripCommentMarkers } from "./annotations";
+import { DEFAULT_EXCLUSIVE_TAG_GROUPS } from "./checks/tag-exclusivity";
+import type { SemanticNode } from "./types";
+
+/**
+ * 파일 머리 주석의 파일 단위 directive
+ */
+export interface FileDirectives {
+	/** @file-tags 목록 (중복 제거, 등장 순서) */
+	tags: string[];
+	/** @file-description 텍스트 */
+	description?: string;
+}
+
+/** 파일 directive를 적용하지 않는 종류 (파일 자체와 여러 파일이 공유하는 노드) */
+const FILE_SCOPE_EXCLUDED_KINDS = new Set([
+	"file",
+	"module",
+	"package",
+	"external",
+	"table",
+]);
+
+/**
+ * 파일 머리(첫 코드 라인 전까지의 빈 줄과 주석)에서 파일 directive 파싱
+ */
+export function parseFileDirectives(sourceCode: string): FileDirectives {
+	const lines: string[] = [];
+	let inBlock = false;
+
+	for (const rawLine of sourceCode.split("\n")) {
+		const line = rawLine.trim();
+		if (inBlock) {
+			lines.push(line.replace(/\*\/.*$/, "").replace(/^\*?\s?/, ""));
+			inBlock = !line.includes("*/");
+			continue;
+		}
+		if (line === "") continue;
+		if (line.startsWith("//") || line.startsWith("#")) {
+			lines.push(...stripCommentMarkers(line));
+			continue;
+		}
+		if (line.startsWith("/*")) {
+			lines.push(...stripCommentMarkers(line));
+			inBlock = !line.includes("*/");
+			continue;
+		}
+		break;
+	}
+
+	const tags = new Set<string>();
+	let description: string | undefined;
+	for (const { name, value } of parseDirectives(lines)) {
+		if (name === "file-tags") {
+			for (const tag of value.split(",")) {
+				if (tag.trim()) tags.add(tag.trim());
+			}
+		} else if (name === "file-description" && description === undefined) {
+			description = value;
+		}
+	}
+
+	return description === undefined
+		? { tags: Array.from(tags) }
+		: { tags: Array.from(tags), description };
+}
+
+/**
+ * 파일 directive를 파일의 심볼 노드에 적용 (파일 머리 주석 참고)
+ *
+ * 파일에서 추가된 태그는 metadata.fileTags에 기록한다.
+ */
+export function applyFileDirectives(
+	nodes: SemanticNode[],
+	directives: FileDirectives,
+	exclusiveGroups: string[][] = DEFAULT_EXCLUSIVE_TAG_GROUPS,
+): SemanticNode[] {
+	if (directives.tags.length === 0 && directives.description === undefined) {
+		return nodes;
+	}
+
+	return nodes.map((node) => {
+		if (FILE_SCOPE_EXCLUDED_KINDS.has(node.kind)) {
+			return node;
+		}
+
+		const own = new Set(node.semanticTags);
+		const added = directives.tags.filter(
+			(tag) =>
+				!own.has(tag) &&
+				!exclusiveGroups.some(
+					(group) =>
+						group.includes(tag) &&
+						group.some((other) => other !== tag && own.has(other)),
+				),
+		);
+		const description = node.description || directives.description;
+		if (added.length === 0 && description === node.description) {
+			return node;
+		}
+
+		return {
+			...node,
+			semanticTags: [...node.semanticTags, ...added],
+			description,
+			metadata:
+				added.length > 0
+					? { ...node.metadata, fileTags: added }
+					: node.metadata,
+		};
+	});
+}
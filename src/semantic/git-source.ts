@@ -0,0 +1,241 @@
+/**
+ * Git Source
+ * 작업 트리 체크아웃 없이 git 객체 저장소에서 특정 ref의 파일 읽기
+ */
+
+import { execFile, spawn } from "node:child_process";
+import path from "node:path";
+import { promisify } from "node:util";
+import {
+	type IgnoreRule,
+	isIgnored,
+	LINKER_IGNORE_FILE,
+	parseIgnoreFile,
+} from "./ignore";
+
+const execFileAsync = promisify(execFile);
+
+/** ls-tree 출력이 큰 저장소를 위한 버퍼 한도 */
+const MAX_BUFFER = 256 * 1024 * 1024;
+
+/** 이진 파일 판별에 살펴보는 앞부분 길이 (git과 같은 기준) */
+const BINARY_PROBE_LENGTH = 8000;
+
+/**
+ * ref 트리의 blob 항목
+ */
+export interface GitTreeEntry {
+	/** 파일 모드 (예: "100644") */
+	mode: string;
+	/** blob 객체 ID */
+	oid: string;
+	/** blob 크기 (바이트) */
+	size: number;
+	/** 저장소 루트 기준 경로 ("/" 구분자) */
+	path: string;
+}
+
+/**
+ * ref를 커밋 ID로 확인 (없는 ref면 예외)
+ */
+export async function resolveGitRef(
+	repoPath: string,
+	ref: string,
+): Promise<string> {
+	try {
+		const { stdout } = await execFileAsync(
+			"git",
+			["rev-parse", "--verify", "--end-of-options", `${ref}^{commit}`],
+			{ cwd: repoPath },
+		);
+		return stdout.trim();
+	} catch {
+		throw new Error(`Unknown git ref: ${ref}`);
+	}
+}
+
+/**
+ * ref 트리의 모든 blob 항목 (경로 순)
+ *
+ * 서브모듈(gitlink)과 심볼릭 링크는 작업 트리 파일이 아니므로 제외한다.
+ * 크기도 함께 읽으므로 내용을 읽기 전에 큰 blob을 걸러낼 수 있다.
+ */
+export async function listGitTree(
+	repoPath: string,
+	ref: string,
+): Promise<GitTreeEntry[]> {
+	const commit = await resolveGitRef(repoPath, ref);
+	const { stdout } = await execFileAsync(
+		"git",
+		["ls-tree", "-r", "-l", "-z", "--full-tree", commit],
+		{ cwd: repoPath, maxBuffer: MAX_BUFFER },
+	);
+
+	const entries: GitTreeEntry[] = [];
+	for (const record of stdout.split("\0")) {
+		const tab = record.indexOf("\t");
+		if (tab < 0) continue;
+		// "<mode> <type> <oid> <size>\t<path>" (size는 왼쪽이 공백으로 채워짐)
+		const [mode, type, oid, size] = record.slice(0, tab).split(/ +/);
+		if (type !== "blob" || mode === "120000") continue;
+		entries.push({
+			mode,
+			oid,
+			size: Number(size),
+			path: record.slice(tab + 1),
+		});
+	}
+	return entries.sort((a, b) => (a.path < b.path ? -1 : 1));
+}
+
+/**
+ * blob 내용을 한 번의 git cat-file --batch 호출로 읽기
+ */
+export function readGitBlobs(
+	repoPath: string,
+	oids: string[],
+): Promise<Map<string, Buffer>> {
+	const unique = [...new Set(oids)];
+	if (unique.length === 0) {
+		return Promise.resolve(new Map());
+	}
+
+	return new Promise((resolve, reject) => {
+		const child = spawn("git", ["cat-file", "--batch"], { cwd: repoPath });
+		const chunks: Buffer[] = [];
+		let stderr = "";
+
+		child.stdout.on("data", (chunk: Buffer) => chunks.push(chunk));
+		child.stderr.on("data", (chunk: Buffer) => {
+			stderr += chunk.toString();
+		});
+		child.on("error", reject);
+		child.on("close", (code) => {
+			if (code !== 0) {
+				reject(new Error(`git cat-file failed: ${stderr.trim()}`));
+				return;
+			}
+			try {
+				resolve(parseBatchOutput(Buffer.concat(chunks)));
+			} catch (error) {
+				reject(error);
+			}
+		});
+
+		child.stdin.end(`${unique.join("\n")}\n`);
+	});
+}
+
+/**
+ * 이진 파일인지 확인 (앞부분에 NUL 바이트가 있으면 이진으로 본다)
+ */
+export function isBinaryContent(content: Buffer): boolean {
+	return content.subarray(0, BINARY_PROBE_LENGTH).includes(0);
+}
+
+/**
+ * ref 트리에서 분석 대상 경로만 남김
+ *
+ * 디렉토리마다 커밋된 .linkerignore를 상위 규칙 뒤에 이어 붙여 적용하고,
+ * analyzeDirectory와 같이 "."으로 시작하는 디렉토리와 skipDirectories는
+ * 들어가지 않는다. ignoreContents는 .linkerignore가 있는 디렉토리
+ * (루트는 "") -> 파일 내용이다.
+ */
+export function filterGitTree(
+	entries: GitTreeEntry[],
+	ignoreContents: Map<string, string>,
+	skipDirectories: ReadonlySet<string> = new Set(),
+): GitTreeEntry[] {
+	const rulesByDirectory = new Map<string, IgnoreRule[]>();
+	const excluded = new Map<string, boolean>();
+
+	const rulesFor = (directory: string): IgnoreRule[] => {
+		let rules = rulesByDirectory.get(directory);
+		if (rules) return rules;
+		const inherited =
+			directory === "" ? [] : rulesFor(parentDirectory(directory));
+		const content = ignoreContents.get(directory);
+		rules = content
+			? [...inherited, ...parseIgnoreFile(content, directory)]
+			: inherited;
+		rulesByDirectory.set(directory, rules);
+		return rules;
+	};
+
+	const isExcluded = (directory: string): boolean => {
+		if (directory === "") return false;
+		const cached = excluded.get(directory);
+		if (cached !== undefined) return cached;
+		const parent = parentDirectory(directory);
+		const name = path.posix.basename(directory);
+		const result =
+			isExcluded(parent) ||
+			name.startsWith(".") ||
+			skipDirectories.has(name) ||
+			isIgnored(directory, true, rulesFor(parent));
+		excluded.set(directory, result);
+		return result;
+	};
+
+	return entries.filter((entry) => {
+		const directory = parentDirectory(entry.path);
+		return (
+			!isExcluded(directory) &&
+			!isIgnored(entry.path, false, rulesFor(directory))
+		);
+	});
+}
+
+/**
+ * ref 트리의 .linkerignore 내용 (디렉토리 -> 내용)
+ */
+export async function readGitIgnoreFiles(
+	repoPath: string,
+	entries: GitTreeEntry[],
+): Promise<Map<string, string>> {
+	const ignoreEntries = entries.filter(
+		(entry) => path.posix.basename(entry.path) === LINKER_IGNORE_FILE,
+	);
+	const blobs = await readGitBlobs(
+		repoPath,
+		ignoreEntries.map((entry) => entry.oid),
+	);
+
+	const contents = new Map<string, string>();
+	for (const entry of ignoreEntries) {
+		const blob = blobs.get(entry.oid);
+		if (blob) {
+			contents.set(parentDirectory(entry.path), blob.toString("utf-8"));
+		}
+	}
+	return contents;
+}
+
+function parentDirectory(filePath: string): string {
+	const directory = path.posix.dirname(filePath);
+	return directory === "." ? "" : directory;
+}
+
+/**
+ * "<oid> blob <size>\n<내용>\n" 반복 출력 파싱
+ */
+function parseBatchOutput(output: Buffer): Map<string, Buffer> {
+	const blobs = new Map<string, Buffer>();
+	let offset = 0;
+
+	while (offset < output.length) {
+		const newline = output.indexOf(0x0a, offset);
+		if (newline < 0) break;
+		const header = output.subarray(offset, newline).toString();
+		const [oid, type, size] = header.split(" ");
+		if (type === "missing" || size === undefined) {
+			throw new Error(`Missing git object: ${oid}`);
+		}
+		const start = newline + 1;
+		const end = start + Number(size);
+		blobs.set(oid, output.subarray(start, end));
+		offset = end + 1;
+	}
+
+	return blobs;
+}
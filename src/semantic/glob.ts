@@ -0,0 +1,71 @@
+/**
+ * Glob Matching Utilities
+ * gitignore/CODEOWNERS 스타일 glob 패턴을 정규식으로 변환
+ */
+
+/** 변환 결과를 보관할 최대 패턴 수 (넘으면 가장 오래 쓰지 않은 항목부터 제거) */
+export const GLOB_CACHE_SIZE = 512;
+
+const regexCache = new Map<string, RegExp>();
+
+/**
+ * glob 패턴을 정규식으로 변환
+ *
+ * 지원 문법: `**` (디렉토리 경계를 넘는 매칭), `*` (경로 구분자 제외),
+ * `?` (단일 문자), `[abc]` (문자 클래스)
+ */
+export function globToRegExp(pattern: string): RegExp {
+	const cached = regexCache.get(pattern);
+	if (cached) {
+		// Map 순서를 최근 사용 순으로 유지
+		regexCache.delete(pattern);
+		regexCache.set(pattern, cached);
+		return cached;
+	}
+
+	let source = "";
+	for (let i = 0; i < pattern.length; i++) {
+		const char = pattern[i];
+		if (char === "*") {
+			if (pattern[i + 1] === "*") {
+				// "**/" 는 0개 이상의 디렉토리, 단독 "**" 는 모든 문자
+				if (pattern[i + 2] === "/") {
+					source += "(?:.*/)?";
+					i += 2;
+				} else {
+					source += ".*";
+					i += 1;
+				}
+			} else {
+				source += "[^/]*";
+			}
+		} else if (char === "?") {
+			source += "[^/]";
+		} else if (char === "[") {
+			const end = pattern.indexOf("]", i + 1);
+			if (end === -1) {
+				source += "\\[";
+			} else {
+				const body = pattern.slice(i + 1, end).replace(/^!/, "^");
+				source += `[${body.replace(/\\/g, "\\\\")}]`;
+				i = end;
+			}
+		} else {
+			source += char.replace(/[.+^${}()|\\]/g, "\\$&");
+		}
+	}
+
+	const regex = new RegExp(`^${source}$`);
+	if (regexCache.size >= GLOB_CACHE_SIZE) {
+		regexCache.delete(regexCache.keys().next().value as string);
+	}
+	regexCache.set(pattern, regex);
+	return regex;
+}
+
+/**
+ * 경로가 glob 패턴과 일치하는지 확인
+ */
+export function matchesGlob(value: string, pattern: string): boolean {
+	return globToRegExp(pattern).test(value.replace(/\\/g, "/"));
+}
@@ -0,0 +1,118 @@
+/**
+ * Semantic Graph Diff
+ * 두 심볼 그래프 사이의 구조적 변경 비교
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/**
+ * 변경된 노드
+ */
+export interface ChangedNode {
+	before: SemanticNode;
+	after: SemanticNode;
+	/** 달라진 항목 (예: "semanticTags", "calls") */
+	changes: string[];
+}
+
+/**
+ * 그래프 비교 결과 (모두 ID 순 정렬)
+ */
+export interface GraphDiff {
+	addedNodes: SemanticNode[];
+	removedNodes: SemanticNode[];
+	changedNodes: ChangedNode[];
+	addedEdges: SemanticEdge[];
+	removedEdges: SemanticEdge[];
+}
+
+/**
+ * 두 그래프 비교
+ *
+ * 노드는 ID로 대응시키며, 라인 이동만으로는 변경으로 보지 않는다.
+ * 엣지는 from/to/type이 같으면 같은 엣지로 본다.
+ */
+export function diffGraphs(
+	before: SemanticGraph,
+	after: SemanticGraph,
+): GraphDiff {
+	const diff: GraphDiff = {
+		addedNodes: [],
+		removedNodes: [],
+		changedNodes: [],
+		addedEdges: [],
+		removedEdges: [],
+	};
+
+	for (const node of after.nodes.values()) {
+		const previous = before.getNode(node.id);
+		if (!previous) {
+			diff.addedNodes.push(node);
+			continue;
+		}
+		const changes = compareNodes(previous, node);
+		if (changes.length > 0) {
+			diff.changedNodes.push({ before: previous, after: node, changes });
+		}
+	}
+	for (const node of before.nodes.values()) {
+		if (!after.hasNode(node.id)) {
+			diff.removedNodes.push(node);
+		}
+	}
+
+	const beforeKeys = new Set(before.edges.map(edgeKey));
+	const afterKeys = new Set(after.edges.map(edgeKey));
+	diff.addedEdges = after.edges.filter((edge) => !beforeKeys.has(edgeKey(edge)));
+	diff.removedEdges = before.edges.filter(
+		(edge) => !afterKeys.has(edgeKey(edge)),
+	);
+
+	diff.addedNodes.sort(byId);
+	diff.removedNodes.sort(byId);
+	diff.changedNodes.sort((a, b) => byId(a.after, b.after));
+	diff.addedEdges.sort(byEdge);
+	diff.removedEdges.sort(byEdge);
+	return diff;
+}
+
+/**
+ * 두 노드의 비교 대상 항목 중 달라진 항목 목록
+ */
+function compareNodes(before: SemanticNode, after: SemanticNode): string[] {
+	const fields: Array<[string, (node: SemanticNode) => unknown]> = [
+		["kind", (node) => node.kind],
+		["filePath", (node) => node.filePath],
+		["semanticTags", (node) => [...node.semanticTags].sort()],
+		["description", (node) => node.description ?? null],
+		["annotations", (node) => node.metadata.annotations ?? null],
+		[
+			"calls",
+			(node) =>
+				((node.metadata.callSites as Array<{ callee: string }>) ?? []).map(
+					(site) => site.callee,
+				),
+		],
+	];
+
+	return fields
+		.filter(
+			([, read]) => JSON.stringify(read(before)) !== JSON.stringify(read(after)),
+		)
+		.map(([name]) => name);
+}
+
+export function edgeKey(edge: SemanticEdge): string {
+	return `${edge.from}\0${edge.to}\0${edge.type}`;
+}
+
+function byId(a: { id: string }, b: { id: string }): number {
+	return a.id < b.id ? -1 : a.id > b.id ? 1 : 0;
+}
+
+function byEdge(a: SemanticEdge, b: SemanticEdge): number {
+	const left = edgeKey(a);
+	const right = edgeKey(b);
+	return left < right ? -1 : left > right ? 1 : 0;
+}
@@ -0,0 +1,297 @@
+/**
+ * Graph Metrics
+ * 엣지 가중치를 반영한 결합도(instability), PageRank, fan-in/fan-out 핫스팟 계산
+ */
+
+import { type EdgeKind, edgeKindOf } from "./edge-kinds";
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/**
+ * 엣지 가중치 함수 (0 이하는 엣지를 무시)
+ */
+export type EdgeWeightFunc = (edge: SemanticEdge) => number;
+
+/** 기본 가중치: 모든 엣지를 1로 취급 */
+export const DEFAULT_EDGE_WEIGHT: EdgeWeightFunc = () => 1;
+
+/**
+ * 엣지 타입별 가중치 표로 가중치 함수 생성 (표에 없는 타입은 fallback)
+ */
+export function edgeWeightsByType(
+	weights: Record<string, number>,
+	fallback = 1,
+): EdgeWeightFunc {
+	return (edge) =>
+		Object.prototype.hasOwnProperty.call(weights, edge.type)
+			? weights[edge.type]
+			: fallback;
+}
+
+/**
+ * 그래프 메트릭 옵션
+ */
+export interface GraphMetricsOptions {
+	/** 의존 관계로 볼 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+	/** 엣지 가중치 (기본: 모두 1) */
+	edgeWeight?: EdgeWeightFunc;
+}
+
+/**
+ * 노드별 결합도 메트릭
+ */
+export interface NodeMetrics {
+	/** 들어오는 의존 가중치 합 (Ca) */
+	afferent: number;
+	/** 나가는 의존 가중치 합 (Ce) */
+	efferent: number;
+	/** Ce / (Ca + Ce), 의존 관계가 없으면 0 */
+	instability: number;
+}
+
+/**
+ * PageRank 옵션
+ */
+export interface PageRankOptions extends GraphMetricsOptions {
+	/** 감쇠 계수 (기본: 0.85) */
+	damping?: number;
+	/** 최대 반복 횟수 (기본: 100) */
+	maxIterations?: number;
+	/** 수렴 판정 기준 (점수 변화량 합, 기본: 1e-9) */
+	tolerance?: number;
+}
+
+/**
+ * 한 방향의 차수 (엣지 분류별 내역 포함)
+ */
+export interface DegreeBreakdown {
+	/** 엣지 가중치 합 */
+	total: number;
+	/** 분류별 가중치 합 (해당 엣지가 없는 분류는 생략) */
+	byKind: Partial<Record<EdgeKind, number>>;
+}
+
+/**
+ * 핫스팟 옵션
+ */
+export interface HotspotOptions extends GraphMetricsOptions {
+	/** 점수에서 fan-in 비중 (기본: 1) */
+	fanInWeight?: number;
+	/** 점수에서 fan-out 비중 (기본: 1) */
+	fanOutWeight?: number;
+}
+
+/**
+ * 핫스팟 심볼 하나
+ */
+export interface Hotspot {
+	node: SemanticNode;
+	/** fanInWeight * fanIn.total + fanOutWeight * fanOut.total */
+	score: number;
+	/** 많을수록 변경 위험이 큼 */
+	fanIn: DegreeBreakdown;
+	/** 많을수록 분리 후보 */
+	fanOut: DegreeBreakdown;
+}
+
+/**
+ * 노드별 가중 결합도 계산
+ *
+ * 자기 자신을 가리키는 엣지와 그래프에 없는 노드를 가리키는 엣지는 무시한다.
+ */
+export function computeMetrics(
+	graph: SemanticGraph,
+	options: GraphMetricsOptions = {},
+): Map<string, NodeMetrics> {
+	const metrics = new Map<string, NodeMetrics>();
+	for (const id of graph.nodes.keys()) {
+		metrics.set(id, { afferent: 0, efferent: 0, instability: 0 });
+	}
+
+	for (const { edge, weight } of weightedEdges(graph, options)) {
+		(metrics.get(edge.from) as NodeMetrics).efferent += weight;
+		(metrics.get(edge.to) as NodeMetrics).afferent += weight;
+	}
+
+	for (const entry of metrics.values()) {
+		const total = entry.afferent + entry.efferent;
+		entry.instability = total > 0 ? entry.efferent / total : 0;
+	}
+
+	return metrics;
+}
+
+/**
+ * 가중 PageRank 계산 (점수 합은 1)
+ *
+ * 의존 엣지 방향(의존하는 노드 -> 의존 대상)으로 점수가 흐르므로 많이,
+ * 무겁게 의존되는 심볼일수록 점수가 높다. 나가는 엣지가 없는 노드의 점수는
+ * 모든 노드에 고르게 나눈다.
+ */
+export function computePageRank(
+	graph: SemanticGraph,
+	options: PageRankOptions = {},
+): Map<string, number> {
+	const damping = options.damping ?? 0.85;
+	const maxIterations = options.maxIterations ?? 100;
+	const tolerance = options.tolerance ?? 1e-9;
+	if (damping < 0 || damping > 1) {
+		throw new Error(`Invalid damping factor: ${damping}`);
+	}
+
+	const ids = Array.from(graph.nodes.keys());
+	const count = ids.length;
+	if (count === 0) return new Map();
+
+	const outgoing = new Map<string, { to: string; weight: number }[]>();
+	const outWeight = new Map<string, number>();
+	for (const { edge, weight } of weightedEdges(graph, options)) {
+		const targets = outgoing.get(edge.from) ?? [];
+		targets.push({ to: edge.to, weight });
+		outgoing.set(edge.from, targets);
+		outWeight.set(edge.from, (outWeight.get(edge.from) ?? 0) + weight);
+	}
+
+	let ranks = new Map(ids.map((id) => [id, 1 / count]));
+	for (let iteration = 0; iteration < maxIterations; iteration++) {
+		let dangling = 0;
+		for (const id of ids) {
+			if (!outWeight.has(id)) dangling += ranks.get(id) as number;
+		}
+
+		const base = (1 - damping) / count + (damping * dangling) / count;
+		const next = new Map(ids.map((id) => [id, base]));
+		for (const [from, targets] of outgoing) {
+			const share =
+				(damping * (ranks.get(from) as number)) /
+				(outWeight.get(from) as number);
+			for (const { to, weight } of targets) {
+				next.set(to, (next.get(to) as number) + share * weight);
+			}
+		}
+
+		let delta = 0;
+		for (const id of ids) {
+			delta += Math.abs((next.get(id) as number) - (ranks.get(id) as number));
+		}
+		ranks = next;
+		if (delta < tolerance) break;
+	}
+
+	return ranks;
+}
+
+/**
+ * 노드로 들어오는 의존 차수 (fan-in)
+ */
+export function fanIn(
+	graph: SemanticGraph,
+	id: string,
+	options: GraphMetricsOptions = {},
+): DegreeBreakdown {
+	return computeDegrees(graph, options).get(id)?.in ?? emptyDegree();
+}
+
+/**
+ * 노드에서 나가는 의존 차수 (fan-out)
+ */
+export function fanOut(
+	graph: SemanticGraph,
+	id: string,
+	options: GraphMetricsOptions = {},
+): DegreeBreakdown {
+	return computeDegrees(graph, options).get(id)?.out ?? emptyDegree();
+}
+
+/**
+ * fan-in/fan-out 가중 합이 큰 상위 count개 심볼 (점수 내림차순, 같으면 ID 순)
+ *
+ * 의존 엣지가 하나도 없는 노드는 제외한다. fanInWeight/fanOutWeight로
+ * 변경 위험(fan-in)과 분리 후보(fan-out) 중 어느 쪽을 볼지 조절한다.
+ */
+export function computeHotspots(
+	graph: SemanticGraph,
+	count: number,
+	options: HotspotOptions = {},
+): Hotspot[] {
+	if (!Number.isInteger(count) || count < 1) {
+		throw new Error(`Invalid hotspot count: ${count}`);
+	}
+	const fanInWeight = options.fanInWeight ?? 1;
+	const fanOutWeight = options.fanOutWeight ?? 1;
+
+	const hotspots: Hotspot[] = [];
+	for (const [id, degree] of computeDegrees(graph, options)) {
+		if (degree.in.total === 0 && degree.out.total === 0) continue;
+		hotspots.push({
+			node: graph.getNode(id) as SemanticNode,
+			score: fanInWeight * degree.in.total + fanOutWeight * degree.out.total,
+			fanIn: degree.in,
+			fanOut: degree.out,
+		});
+	}
+
+	return hotspots
+		.sort(
+			(a, b) =>
+				b.score - a.score ||
+				(a.node.id < b.node.id ? -1 : a.node.id > b.node.id ? 1 : 0),
+		)
+		.slice(0, count);
+}
+
+/**
+ * 노드별 fan-in/fan-out
+ */
+function computeDegrees(
+	graph: SemanticGraph,
+	options: GraphMetricsOptions,
+): Map<string, { in: DegreeBreakdown; out: DegreeBreakdown }> {
+	const degrees = new Map<
+		string,
+		{ in: DegreeBreakdown; out: DegreeBreakdown }
+	>();
+	for (const id of graph.nodes.keys()) {
+		degrees.set(id, { in: emptyDegree(), out: emptyDegree() });
+	}
+
+	const add = (degree: DegreeBreakdown, kind: EdgeKind, weight: number) => {
+		degree.total += weight;
+		degree.byKind[kind] = (degree.byKind[kind] ?? 0) + weight;
+	};
+	for (const { edge, weight } of weightedEdges(graph, options)) {
+		const kind = edgeKindOf(edge);
+		add(degrees.get(edge.to)?.in as DegreeBreakdown, kind, weight);
+		add(degrees.get(edge.from)?.out as DegreeBreakdown, kind, weight);
+	}
+	return degrees;
+}
+
+function emptyDegree(): DegreeBreakdown {
+	return { total: 0, byKind: {} };
+}
+
+/**
+ * 메트릭 계산에 포함되는 엣지와 가중치
+ */
+function weightedEdges(
+	graph: SemanticGraph,
+	options: GraphMetricsOptions,
+): { edge: SemanticEdge; weight: number }[] {
+	const follows = (type: string) =>
+		options.edgeTypes
+			? options.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+	const edgeWeight = options.edgeWeight ?? DEFAULT_EDGE_WEIGHT;
+
+	const result: { edge: SemanticEdge; weight: number }[] = [];
+	for (const edge of graph.edges) {
+		if (!follows(edge.type) || edge.from === edge.to) continue;
+		if (!graph.hasNode(edge.from) || !graph.hasNode(edge.to)) continue;
+		const weight = edgeWeight(edge);
+		if (weight > 0) result.push({ edge, weight });
+	}
+	return result;
+}
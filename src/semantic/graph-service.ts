@@ -0,0 +1,135 @@
+/**
+ * Graph Service
+ * 분석한 그래프를 메모리에 두고 다시 읽기/감시로 최신 상태를 유지
+ */
+
+import path from "node:path";
+import { ExtractionCache } from "./extraction-cache";
+import type { FileExtraction } from "./extractors/LanguageExtractor";
+import { SemanticAnalyzer, type WatchOptions } from "./SemanticAnalyzer";
+import { SemanticGraph } from "./SemanticGraph";
+
+/**
+ * 서버가 조회하는 그래프 공급원
+ */
+export interface GraphSource {
+	/** 현재 그래프 (reload/감시로 통째로 교체된다) */
+	readonly graph: SemanticGraph;
+	/** 그래프를 다시 읽기 (지원하지 않으면 생략) */
+	reload?(): Promise<SemanticGraph>;
+}
+
+/**
+ * 그래프 서비스 옵션
+ */
+export interface GraphServiceOptions {
+	/**
+	 * 분석기 (생략 시 directory를 projectRoot로, 메모리 추출 캐시를 쓰는 분석기)
+	 *
+	 * 추출 캐시가 있으면 reload는 바뀐 파일만 다시 파싱한다.
+	 */
+	analyzer?: SemanticAnalyzer;
+	/** 디렉토리 분석 대신 그래프를 읽는 함수 (저장된 그래프 등, 감시 불가) */
+	load?: () => Promise<SemanticGraph>;
+}
+
+/**
+ * 디렉토리 하나의 그래프를 보관하는 서비스
+ *
+ * 조회는 항상 완성된 그래프를 보고, reload/감시는 새 그래프를 다 만든 뒤
+ * 한 번에 교체한다. reload가 실패하면 이전 그래프를 유지한다.
+ */
+export class GraphService implements GraphSource {
+	readonly directory: string;
+	private analyzer: SemanticAnalyzer;
+	private load?: () => Promise<SemanticGraph>;
+	private current = new SemanticGraph();
+	private pending?: Promise<SemanticGraph>;
+
+	constructor(directory: string, options: GraphServiceOptions = {}) {
+		this.directory = path.resolve(directory);
+		this.analyzer =
+			options.analyzer ??
+			new SemanticAnalyzer({
+				projectRoot: this.directory,
+				cache: new ExtractionCache(),
+			});
+		this.load = options.load;
+	}
+
+	get graph(): SemanticGraph {
+		return this.current;
+	}
+
+	/**
+	 * 그래프 다시 읽기 (진행 중인 reload가 있으면 그 결과를 기다림)
+	 */
+	reload(): Promise<SemanticGraph> {
+		if (!this.pending) {
+			this.pending = (
+				this.load ? this.load() : this.analyzer.analyzeDirectory(this.directory)
+			)
+				.then((graph) => this.replace(graph))
+				.finally(() => {
+					this.pending = undefined;
+				});
+		}
+		return this.pending;
+	}
+
+	/**
+	 * 디렉토리를 감시하며 바뀐 파일을 반영한 그래프로 교체
+	 *
+	 * 처음 분석이 끝나면("ready") 그래프를 만들고, 이후 분석/삭제
+	 * 이벤트마다 파일별 추출 결과로 그래프를 다시 병합한다. 분석에 실패한
+	 * 파일은 마지막 성공 결과를 유지한다. signal이 없으면 반환하지 않는다.
+	 */
+	async watch(options: WatchOptions = {}): Promise<void> {
+		if (this.load) {
+			throw new Error("Cannot watch a graph that is not analyzed from source");
+		}
+
+		const extractions = new Map<string, FileExtraction>();
+		let ready = false;
+		await this.analyzer.watch(
+			this.directory,
+			async (event) => {
+				if (event.type === "analyzed") {
+					extractions.set(event.filePath, event.extraction);
+				} else if (event.type === "removed") {
+					extractions.delete(event.filePath);
+				} else if (event.type === "ready") {
+					ready = true;
+				} else {
+					return;
+				}
+				if (ready) {
+					this.replace(await this.rebuild(extractions));
+				}
+			},
+			options,
+		);
+	}
+
+	/**
+	 * 파일별 추출 결과를 경로 순으로 병합 (병합이 결과를 바꾸지 않도록 복사본 사용)
+	 *
+	 * 처음 분석과 같이 외부 의존성 보강까지 거친다.
+	 */
+	private rebuild(
+		extractions: Map<string, FileExtraction>,
+	): Promise<SemanticGraph> {
+		return this.analyzer.completeGraph(
+			Array.from(extractions.keys())
+				.sort()
+				.map((filePath) =>
+					structuredClone(extractions.get(filePath) as FileExtraction),
+				),
+		);
+	}
+
+	private replace(graph: SemanticGraph): SemanticGraph {
+		this.current = graph;
+		return graph;
+	}
+}
@@ -0,0 +1,111 @@
+/**
+ * Ignore Files
+ * .linkerignore (gitignore 문법) 패턴으로 분석 대상 제외
+ */
+
+import { promises as fs } from "node:fs";
+import path from "node:path";
+import { matchesGlob } from "./glob";
+
+/** 스캔 디렉토리마다 읽는 ignore 파일 이름 */
+export const LINKER_IGNORE_FILE = ".linkerignore";
+
+/**
+ * ignore 규칙 한 줄
+ */
+export interface IgnoreRule {
+	/** 원본 패턴 ("!"와 후행 "/" 제거 전) */
+	pattern: string;
+	/** "!"로 시작해 앞선 규칙의 제외를 취소하는 규칙 */
+	negated: boolean;
+	/** 후행 "/"로 디렉토리에만 적용되는 규칙 */
+	directoryOnly: boolean;
+	/** 규칙이 선언된 ignore 파일의 디렉토리 (스캔 루트 기준, 루트는 "") */
+	base: string;
+	line: number;
+}
+
+/**
+ * ignore 파일 내용 파싱
+ *
+ * 빈 줄과 "#" 주석은 건너뛰며, "\#"과 "\!"는 문자 그대로의 패턴이다.
+ */
+export function parseIgnoreFile(content: string, base = ""): IgnoreRule[] {
+	const rules: IgnoreRule[] = [];
+
+	content.split("\n").forEach((rawLine, index) => {
+		let line = rawLine.replace(/\r$/, "").replace(/(?<!\\)\s+$/, "");
+		if (!line || line.startsWith("#")) return;
+
+		const negated = line.startsWith("!");
+		if (negated) line = line.slice(1);
+		line = line.replace(/^\\([#!])/, "$1");
+
+		const directoryOnly = line.endsWith("/");
+		if (directoryOnly) line = line.slice(0, -1);
+		if (!line) return;
+
+		rules.push({
+			pattern: line,
+			negated,
+			directoryOnly,
+			base,
+			line: index + 1,
+		});
+	});
+
+	return rules;
+}
+
+/**
+ * 디렉토리의 ignore 파일 로드 (없으면 빈 목록)
+ */
+export async function loadIgnoreFile(
+	directory: string,
+	base: string,
+): Promise<IgnoreRule[]> {
+	try {
+		const content = await fs.readFile(
+			path.join(directory, LINKER_IGNORE_FILE),
+			"utf-8",
+		);
+		return parseIgnoreFile(content, base);
+	} catch (error) {
+		if ((error as NodeJS.ErrnoException).code === "ENOENT") return [];
+		throw error;
+	}
+}
+
+/**
+ * 경로가 제외 대상인지 확인 (스캔 루트 기준 경로)
+ *
+ * gitignore와 같이 마지막으로 일치한 규칙이 우선하므로, 상위 디렉토리
+ * 규칙 뒤에 하위 디렉토리 규칙을 넘기면 하위 규칙이 우선한다.
+ * 중간 "/"가 없는 패턴은 규칙 디렉토리 아래 모든 깊이의 이름과 매칭한다.
+ */
+export function isIgnored(
+	relativePath: string,
+	isDirectory: boolean,
+	rules: IgnoreRule[],
+): boolean {
+	const normalized = relativePath.replace(/\\/g, "/").replace(/^\.?\//, "");
+	let ignored = false;
+
+	for (const rule of rules) {
+		if (rule.directoryOnly && !isDirectory) continue;
+
+		const prefix = rule.base ? `${rule.base}/` : "";
+		if (!normalized.startsWith(prefix)) continue;
+		const local = normalized.slice(prefix.length);
+
+		const anchored = rule.pattern.includes("/");
+		const glob = anchored
+			? rule.pattern.replace(/^\//, "")
+			: `**/${rule.pattern}`;
+		if (matchesGlob(local, glob)) {
+			ignored = !rule.negated;
+		}
+	}
+
+	return ignored;
+}
@@ -0,0 +1,87 @@
+/**
+ * Impact Analysis
+ * 변경된 심볼에 전이적으로 의존하는 심볼 계산
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** 의존 관계로 보지 않는 구조 엣지 */
+const STRUCTURAL_EDGE_TYPES = new Set(["contains", "declares"]);
+
+/**
+ * 의존 관계 엣지인지 확인 (contains/declares 같은 구조 엣지 제외)
+ */
+export function isDependencyEdge(type: string): boolean {
+	return !STRUCTURAL_EDGE_TYPES.has(type);
+}
+
+/**
+ * 영향 범위 계산 옵션
+ */
+export interface ImpactOptions {
+	/** 따라갈 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+	/** 최대 탐색 깊이 (기본: 제한 없음) */
+	maxDepth?: number;
+}
+
+/**
+ * 영향 받는 심볼
+ */
+export interface ImpactEntry {
+	node: SemanticNode;
+	/** 가장 가까운 변경 심볼까지의 거리 (직접 의존 = 1) */
+	depth: number;
+	/** 이 심볼이 의존하는, 변경 심볼 쪽으로 한 단계 가까운 노드 ID */
+	via: string;
+}
+
+/**
+ * 시작 심볼들에 전이적으로 의존하는 심볼 집합 (시작 심볼 제외)
+ *
+ * 들어오는 엣지를 거슬러 BFS하며 깊이, ID 순으로 정렬해 반환한다.
+ * 이미 방문한 노드는 다시 넣지 않으므로 순환이 있어도 종료한다.
+ */
+export function computeImpactSet(
+	graph: SemanticGraph,
+	seeds: string[],
+	options: ImpactOptions = {},
+): ImpactEntry[] {
+	const follows = (type: string) =>
+		options.edgeTypes
+			? options.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+	const maxDepth = options.maxDepth ?? Number.POSITIVE_INFINITY;
+
+	const depths = new Map<string, number>(seeds.map((id) => [id, 0]));
+	const parents = new Map<string, string>();
+	const queue = [...seeds];
+
+	while (queue.length > 0) {
+		const id = queue.shift() as string;
+		const depth = depths.get(id) as number;
+		if (depth >= maxDepth) continue;
+
+		for (const edge of graph.getIncomingEdges(id)) {
+			if (!follows(edge.type) || depths.has(edge.from)) continue;
+			depths.set(edge.from, depth + 1);
+			parents.set(edge.from, id);
+			queue.push(edge.from);
+		}
+	}
+
+	const entries: ImpactEntry[] = [];
+	for (const [id, depth] of depths) {
+		const node = graph.getNode(id);
+		if (depth > 0 && node) {
+			entries.push({ node, depth, via: parents.get(id) as string });
+		}
+	}
+
+	return entries.sort(
+		(a, b) =>
+			a.depth - b.depth ||
+			(a.node.id < b.node.id ? -1 : a.node.id > b.node.id ? 1 : 0),
+	);
+}
@@ -0,0 +1,265 @@
+/**
+ * Import Resolution
+ * import 경로를 분석된 파일/모듈 노드로 해석해 의존성 엣지를 다시 연결
+ */
+
+import { promises as fs } from "node:fs";
+import path from "node:path";
+import { typeScriptModuleCandidates } from "./extractors/TypeScriptExtractor";
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** 해석 대상 엣지 타입 */
+export const DEFAULT_IMPORT_EDGE_TYPES = ["imports", "imports_type"];
+
+/**
+ * 언어별 해석기에 전달되는 정보
+ */
+export interface ImportResolutionContext {
+	graph: SemanticGraph;
+	/** import 하는 파일/모듈 노드 */
+	from: SemanticNode;
+	/** 원본 import 경로 (예: "github.com/acme/shop/user", "./http", "app.db") */
+	importPath: string;
+	/** 모듈 경로 접두사 -> 스캔 루트 기준 디렉토리 */
+	modulePaths: Record<string, string>;
+	/** 스캔 루트 기준 디렉토리 -> 그 디렉토리에서 분석된 파일 경로 */
+	directories: Map<string, string[]>;
+}
+
+/**
+ * 언어별 import 해석기
+ */
+export interface ImportResolver {
+	/** 처리할 노드 language 값 */
+	languages: string[];
+	/** 분석된 대상 노드 ID (코퍼스 밖이면 undefined) */
+	resolve(context: ImportResolutionContext): string | undefined;
+}
+
+/**
+ * import 해석 옵션
+ */
+export interface ImportResolutionOptions {
+	/**
+	 * 모듈 경로 매핑 (접두사 -> 스캔 루트 기준 디렉토리)
+	 *
+	 * 예: { "github.com/acme/shop": "" } (Go 모듈), { "@/": "web/src/" }
+	 * (TypeScript paths 별칭), { "": "src" } (Python 소스 루트)
+	 */
+	modulePaths?: Record<string, string>;
+	/** 기본 해석기보다 먼저 시도할 해석기 */
+	resolvers?: ImportResolver[];
+	/** 해석할 엣지 타입 (기본: DEFAULT_IMPORT_EDGE_TYPES) */
+	edgeTypes?: string[];
+}
+
+/**
+ * 모듈 경로 매핑 적용 (가장 긴 접두사 우선, 일치하지 않으면 undefined)
+ *
+ * "/"로 끝나는 접두사는 그대로 이어 붙이고, 그 외에는 경로 요소 단위로
+ * 일치해야 한다 ("github.com/acme/shop"은 "github.com/acme/shopping"과 불일치).
+ */
+export function mapModulePath(
+	importPath: string,
+	modulePaths: Record<string, string>,
+): string | undefined {
+	const prefixes = Object.keys(modulePaths).sort((a, b) => b.length - a.length);
+	for (const prefix of prefixes) {
+		let rest: string;
+		if (prefix === "" || prefix.endsWith("/")) {
+			if (!importPath.startsWith(prefix)) continue;
+			rest = importPath.slice(prefix.length);
+		} else if (importPath === prefix) {
+			rest = "";
+		} else if (importPath.startsWith(`${prefix}/`)) {
+			rest = importPath.slice(prefix.length + 1);
+		} else {
+			continue;
+		}
+		return normalizePath(path.posix.join(modulePaths[prefix], rest));
+	}
+	return undefined;
+}
+
+/**
+ * Go 해석기: 모듈 경로 -> 디렉토리의 패키지 노드 (없으면 첫 파일 노드)
+ *
+ * go-imports 추출기의 파일 노드(metadata.goPackage)로 패키지를 찾는다.
+ */
+export const GO_IMPORT_RESOLVER: ImportResolver = {
+	languages: ["go"],
+	resolve({ graph, importPath, modulePaths, directories }) {
+		const directory = mapModulePath(importPath, modulePaths);
+		if (directory === undefined) return undefined;
+
+		const fileNodes = (directories.get(directory) ?? [])
+			.map((file) => graph.getNode(file))
+			.filter((node) => node?.kind === "file" && node.language === "go");
+		for (const file of fileNodes) {
+			const packageNode = graph.getNode(file?.metadata.goPackage as string);
+			if (packageNode?.kind === "package") return packageNode.id;
+		}
+		return fileNodes[0]?.id;
+	},
+};
+
+/**
+ * TypeScript/JavaScript 해석기: 상대 경로와 매핑된 별칭 -> 모듈 파일 노드
+ *
+ * 확장자 생략, 디렉토리 index 파일, ESM 스타일 ".js" 지정자를 처리한다.
+ */
+export const TYPESCRIPT_IMPORT_RESOLVER: ImportResolver = {
+	languages: ["typescript", "javascript"],
+	resolve({ graph, from, importPath, modulePaths }) {
+		const base = importPath.startsWith(".")
+			? normalizePath(
+					path.posix.join(path.posix.dirname(from.filePath), importPath),
+				)
+			: mapModulePath(importPath, modulePaths);
+		if (base === undefined) return undefined;
+
+		return typeScriptModuleCandidates(base).find(
+			(candidate) => graph.getNode(candidate)?.kind === "module",
+		);
+	},
+};
+
+/**
+ * Python 해석기: 모듈 이름 그대로 또는 소스 루트 매핑 후 모듈 노드
+ *
+ * 패키지 상대 import는 추출 단계에서 이미 절대 모듈 이름으로 바뀐다.
+ */
+export const PYTHON_IMPORT_RESOLVER: ImportResolver = {
+	languages: ["python"],
+	resolve({ graph, importPath, modulePaths }) {
+		const mapped = mapModulePath(importPath.replace(/\./g, "/"), modulePaths);
+		const candidates = [
+			importPath,
+			...(mapped ? [mapped.replace(/\//g, ".")] : []),
+		];
+		return candidates.find(
+			(candidate) => graph.getNode(candidate)?.kind === "module",
+		);
+	},
+};
+
+/** 기본 해석기 */
+export const DEFAULT_IMPORT_RESOLVERS: ImportResolver[] = [
+	GO_IMPORT_RESOLVER,
+	TYPESCRIPT_IMPORT_RESOLVER,
+	PYTHON_IMPORT_RESOLVER,
+];
+
+/**
+ * import 엣지를 분석된 노드로 다시 연결한 새 그래프 생성
+ *
+ * "external" 자리표시 노드를 가리키는 import 엣지마다 import 하는 노드의
+ * 언어에 맞는 해석기로 대상을 찾는다. 찾으면 엣지를 실제 노드로 옮기고
+ * metadata.importPath에 원본 경로를 남기며, 더 이상 참조되지 않는 자리표시
+ * 노드는 제거한다. 해석하지 못한 대상은 원본 경로(metadata.importPath)를
+ * 가진 external 노드로 남는다.
+ */
+export function resolveImports(
+	graph: SemanticGraph,
+	options: ImportResolutionOptions = {},
+): SemanticGraph {
+	const edgeTypes = options.edgeTypes ?? DEFAULT_IMPORT_EDGE_TYPES;
+	const modulePaths = options.modulePaths ?? {};
+	const resolvers = [...(options.resolvers ?? []), ...DEFAULT_IMPORT_RESOLVERS];
+
+	const directories = new Map<string, string[]>();
+	for (const node of graph.nodes.values()) {
+		if (node.kind === "external") continue;
+		const directory = normalizePath(path.posix.dirname(node.filePath));
+		const files = directories.get(directory) ?? [];
+		if (!files.includes(node.filePath)) files.push(node.filePath);
+		directories.set(directory, files);
+	}
+
+	const edges = graph.edges.map((edge) => {
+		const target = graph.getNode(edge.to);
+		const from = graph.getNode(edge.from);
+		if (
+			!edgeTypes.includes(edge.type) ||
+			!from ||
+			(target && target.kind !== "external")
+		) {
+			return edge;
+		}
+
+		// 같은 자리표시 노드도 파일마다 지정자가 다를 수 있으므로 엣지 쪽을 우선
+		const importPath =
+			(edge.metadata?.specifier as string | undefined) ??
+			(target?.metadata.importPath as string | undefined) ??
+			edge.to;
+		const resolver = resolvers.find((candidate) =>
+			candidate.languages.includes(from.language ?? ""),
+		);
+		const resolved = resolver?.resolve({
+			graph,
+			from,
+			importPath,
+			modulePaths,
+			directories,
+		});
+		if (!resolved || resolved === edge.to || !graph.hasNode(resolved)) {
+			return edge;
+		}
+		return {
+			...edge,
+			to: resolved,
+			metadata: { ...edge.metadata, importPath },
+		};
+	});
+
+	// 다시 연결된 엣지로만 참조되던 자리표시 노드는 버린다
+	const referenced = new Set(edges.map((edge) => edge.to));
+	const orphaned = new Set(
+		graph.edges
+			.filter((edge, index) => edges[index] !== edge)
+			.map((edge) => edge.to)
+			.filter((id) => !referenced.has(id)),
+	);
+	const result = new SemanticGraph();
+	for (const node of graph.nodes.values()) {
+		if (node.kind !== "external") {
+			result.addNode(node);
+		} else if (!orphaned.has(node.id)) {
+			result.addNode(
+				node.metadata.importPath === undefined
+					? { ...node, metadata: { ...node.metadata, importPath: node.id } }
+					: node,
+			);
+		}
+	}
+	for (const edge of edges) {
+		result.addEdge(edge);
+	}
+	return result;
+}
+
+/**
+ * 스캔 루트의 go.mod에서 모듈 경로 매핑 읽기 (없으면 빈 매핑)
+ */
+export async function loadModulePaths(
+	root: string,
+): Promise<Record<string, string>> {
+	let content: string;
+	try {
+		content = await fs.readFile(path.join(root, "go.mod"), "utf-8");
+	} catch {
+		return {};
+	}
+
+	const modulePath = content.match(/^\s*module\s+"?([^\s"]+)"?/m)?.[1];
+	return modulePath ? { [modulePath]: "" } : {};
+}
+
+/**
+ * posix 경로 정규화 ("." -> "", 앞의 "./" 제거)
+ */
+function normalizePath(directory: string): string {
+	const normalized = path.posix.normalize(directory);
+	return normalized === "." ? "" : normalized.replace(/^\.\//, "");
+}
@@ -0,0 +1,658 @@
+/**
+ * Semantic Graph Module
+ * @semantic-tags 기반 심볼 그래프 모듈의 메인 익스포트
+ */
+
+// Analyzer
+export type {
+	AnalysisStreamRecord,
+	AnalysisStreamSummary,
+	AnalysisStreamWriter,
+	AnalyzeOptions,
+	AnalyzeRootsOptions,
+	AnalyzeSourceOptions,
+	FileAnalysisRecord,
+	FileErrorRecord,
+	FqnCollisionPolicy,
+	PartialGraph,
+	SemanticAnalyzerOptions,
+	WatchEvent,
+	WatchOptions,
+} from "./SemanticAnalyzer";
+export {
+	AnalysisAbortedError,
+	createSemanticAnalyzer,
+	DEFAULT_MAX_FILE_BYTES,
+	DEFAULT_PARSE_TIMEOUT_MS,
+	DEFAULT_WATCH_DEBOUNCE_MS,
+	SemanticAnalyzer,
+} from "./SemanticAnalyzer";
+// Annotations
+export type {
+	AnnotationParseContext,
+	AnnotationParser,
+	Directive,
+	DocAnnotations,
+	DocComment,
+} from "./annotations";
+export {
+	createDirectiveParser,
+	createDocComment,
+	DEFAULT_ANNOTATION_PARSERS,
+	descriptionParser,
+	getAnnotationValues,
+	hasAnnotation,
+	mergeAnnotationParsers,
+	parseDirectives,
+	parseDocAnnotations,
+	parseDocComments,
+	parseSemanticTags,
+	semanticTagsParser,
+	stripCommentMarkers,
+} from "./annotations";
+// API changes
+export type {
+	ApiChangeOptions,
+	ApiChangeReport,
+	ApiSignatureChange,
+	ApiSymbol,
+	ApiTagChange,
+} from "./api-changes";
+export { diffApi, renderApiChangeMarkdown } from "./api-changes";
+// API versions
+export { getApiVersions, groupByApiVersion } from "./api-version";
+// Caching
+export type { CachePolicy, CacheReport, CacheReportOptions } from "./caching";
+export {
+	getCachePolicy,
+	parseCachePolicy,
+	reportCacheUsage,
+} from "./caching";
+// Checks
+export { checkAmbiguousReferences } from "./checks/ambiguous-references";
+export type { AuditCheckOptions } from "./checks/audit";
+export { checkAuditRequirements, isAuditRequired } from "./checks/audit";
+export type { DataClassificationOptions } from "./checks/data-classification";
+export { checkClassifiedDataFlows } from "./checks/data-classification";
+export type { DescriptionCheckOptions } from "./checks/descriptions";
+export { checkDescriptions } from "./checks/descriptions";
+export { checkDIScopes } from "./checks/di-scope";
+export type { ErrorWrappingOptions } from "./checks/error-wrapping";
+export { checkErrorWrapping } from "./checks/error-wrapping";
+export { checkIdempotency, isIdempotent } from "./checks/idempotency";
+export type { LayeringConfig, LayerRule } from "./checks/layering";
+export { checkLayering, createLayeringRule } from "./checks/layering";
+export type {
+	LogCall,
+	LoggerConfig,
+	LogLevel,
+} from "./checks/log-statements";
+export {
+	checkLogStatements,
+	DEFAULT_LOGGERS,
+	findLogCalls,
+	resolveLogLevel,
+} from "./checks/log-statements";
+export { checkPanicFlows } from "./checks/panic-flow";
+export type { ResiliencePolicyCheckOptions } from "./checks/resilience-policy";
+export { checkResiliencePolicies } from "./checks/resilience-policy";
+export type { ResourceOwnershipOptions } from "./checks/resource-ownership";
+export {
+	checkResourceOwnership,
+	transfersOwnership,
+} from "./checks/resource-ownership";
+export type { SemanticCheck } from "./checks/run-checks";
+export {
+	DEFAULT_CHECKS,
+	DEFAULT_TAG_RULES,
+	runChecks,
+} from "./checks/run-checks";
+export { checkSkippedFiles } from "./checks/skipped-files";
+export type { SlaConsistencyOptions } from "./checks/sla-consistency";
+export { checkSLAConsistency } from "./checks/sla-consistency";
+export type { TagCombinationConfig } from "./checks/tag-combinations";
+export {
+	checkTagCombinations,
+	createTagCombinationRule,
+	DEFAULT_REQUIRED_TAG_COMPANIONS,
+} from "./checks/tag-combinations";
+export type { TagExclusivityConfig } from "./checks/tag-exclusivity";
+export {
+	checkTagTaxonomy,
+	createTagTaxonomyRule,
+} from "./checks/tag-taxonomy";
+export {
+	checkTagExclusivity,
+	createTagExclusivityRule,
+	DEFAULT_EXCLUSIVE_TAG_GROUPS,
+} from "./checks/tag-exclusivity";
+export { checkTagKinds, createTagKindRule } from "./checks/tag-kinds";
+export type { TransactionBoundaryOptions } from "./checks/transaction-boundary";
+export {
+	checkTransactionBoundaries,
+	isTransactional,
+} from "./checks/transaction-boundary";
+export type { UniqueTagOptions } from "./checks/unique-tags";
+export { checkUniqueTags, DEFAULT_UNIQUE_TAGS } from "./checks/unique-tags";
+export { checkUnusedReceivers } from "./checks/unused-receiver";
+export { checkVersionConsistency } from "./checks/version-consistency";
+// Classification
+export {
+	DEFAULT_SENSITIVE_CLASSIFICATIONS,
+	getClassification,
+	parseClassification,
+} from "./classification";
+// Closure
+export type { ClosureEntry, ClosureOptions } from "./closure";
+export { computeClosure } from "./closure";
+// CODEOWNERS
+export type { CodeOwnersRule } from "./codeowners";
+export {
+	assignOwners,
+	loadCodeOwners,
+	parseCodeOwners,
+	resolveOwners,
+} from "./codeowners";
+// Compaction
+export type { CompactionOptions } from "./compaction";
+export {
+	compactGraph,
+	DEFAULT_COMPACTION_EDGE_TYPES,
+} from "./compaction";
+// Complexity
+export type {
+	ComplexityGrammar,
+	ComplexityMetric,
+	ComplexityMetrics,
+} from "./complexity";
+export {
+	computeComplexity,
+	GO_COMPLEXITY_GRAMMAR,
+	getComplexity,
+	PYTHON_COMPLEXITY_GRAMMAR,
+	rankByComplexity,
+	sortByComplexity,
+} from "./complexity";
+// Component grouping
+export type { ComponentConfig } from "./component-grouping";
+export {
+	getNodePackage,
+	groupByComponent,
+	resolveComponent,
+} from "./component-grouping";
+// Constants
+export { resolveStringValue } from "./constants";
+// Cycles
+export type {
+	CycleDetectionOptions,
+	DependencyCycle,
+	ImportCycleOptions,
+	ImportCycleReport,
+} from "./cycles";
+export {
+	DEFAULT_MAX_IMPORT_CYCLES,
+	DEFAULT_STRUCTURAL_EDGE_TYPES,
+	detectCycles,
+	findImportCycles,
+	stronglyConnectedComponents,
+} from "./cycles";
+// Dependency paths
+export type { DependencyPath, PathOptions } from "./dependency-path";
+export { findShortestPath, findShortestPaths } from "./dependency-path";
+// Deprecation
+export type { DeprecationTimeline, OverdueDeprecation } from "./deprecation";
+export {
+	compareVersions,
+	findOverdueDeprecations,
+	getDeprecation,
+	parseDeprecation,
+} from "./deprecation";
+// Deterministic JSON
+export type { DeterministicJsonOptions } from "./deterministic-json";
+export {
+	DEFAULT_FLOAT_PRECISION,
+	marshalDeterministic,
+} from "./deterministic-json";
+// DI scopes
+export { DI_SCOPE_LIFETIMES, getScope, parseScope } from "./di-scopes";
+// Diagnostic formats
+export type {
+	DiagnosticFormatOptions,
+	SarifOptions,
+} from "./diagnostic-formats";
+export { formatGitHubAnnotations, formatSarif } from "./diagnostic-formats";
+// Diagnostics
+export {
+	applySuppressions,
+	countFailures,
+	isAtLeast,
+	isDiagnosticSeverity,
+	SEVERITY_RANK,
+} from "./diagnostics";
+// DOT export
+export type { DotExportOptions, DotWriter } from "./dot-export";
+export {
+	createEdgeFilter,
+	DEFAULT_DOT_EDGE_TYPES,
+	exportDot,
+	renderDot,
+} from "./dot-export";
+// Edge kinds
+export {
+	EDGE_KIND_COLORS,
+	EDGE_TYPE_KINDS,
+	EdgeKind,
+	edgeKindOf,
+	isEdgeKind,
+} from "./edge-kinds";
+// Edge removal
+export type { RemovalImpact } from "./edge-removal";
+export { simulateRemoveEdge } from "./edge-removal";
+// Error handling
+export type { ErrorHandlingMetrics } from "./error-handling";
+export { analyzeErrorHandling, getErrorHandling } from "./error-handling";
+// Experiments
+export type { ExpiredExperiment, Experiment } from "./experiments";
+export {
+	findExpiredExperiments,
+	getExperiment,
+	parseExperiment,
+	parseExpiry,
+} from "./experiments";
+// External resolution
+export type {
+	ExternalInfo,
+	ExternalResolutionError,
+	ExternalResolver,
+} from "./external-resolution";
+export {
+	createExternalResolver,
+	DEFAULT_EXTERNAL_CONCURRENCY,
+	resolveExternals,
+} from "./external-resolution";
+// Extraction cache
+export type {
+	CacheBackend,
+	ExtractionCacheEntry,
+	ExtractionCacheStats,
+} from "./extraction-cache";
+export {
+	EXTRACTION_CACHE_VERSION,
+	ExtractionCache,
+	extractionCacheKey,
+	FileCacheBackend,
+	hashContent,
+} from "./extraction-cache";
+// Extraction pool
+export type { ExtractionWorkerOptions } from "./extraction-pool";
+export { ExtractionPool, MIN_FILES_PER_WORKER } from "./extraction-pool";
+// Extractors
+export type { GoPackage } from "./extractors/GoExtractor";
+export {
+	collectCallSites,
+	collectDocComment,
+	collectDocCommentTexts,
+	createGoExtractor,
+	findPackageName,
+	formatMethodShape,
+	GoExtractor,
+	getGoPackageId,
+	parseReceiver,
+	parseStringLiteral,
+	parseStructTag,
+	resolveGoPackage,
+} from "./extractors/GoExtractor";
+export type { GoImport } from "./extractors/GoImportExtractor";
+export {
+	collectGoImports,
+	createGoImportExtractor,
+	GoImportExtractor,
+	isStdlibImport,
+} from "./extractors/GoImportExtractor";
+export type {
+	GoSqlAssignment,
+	GoSqlExtractorOptions,
+} from "./extractors/GoSqlExtractor";
+export {
+	collectSqlAssignments,
+	createGoSqlExtractor,
+	DEFAULT_QUERY_VARIABLE_PATTERN,
+	GoSqlExtractor,
+} from "./extractors/GoSqlExtractor";
+export type {
+	JavaExtractorOptions,
+	JavaImport,
+} from "./extractors/JavaExtractor";
+export {
+	collectJavaImports,
+	createJavaExtractor,
+	findJavaPackage,
+	JavaExtractor,
+} from "./extractors/JavaExtractor";
+export type {
+	ExtractionContext,
+	FileExtraction,
+	FileSkip,
+	LanguageExtractor,
+	ParseError,
+} from "./extractors/LanguageExtractor";
+export type {
+	PatternExtractorOptions,
+	SymbolCallPattern,
+} from "./extractors/PatternExtractor";
+export {
+	createPatternExtractor,
+	PatternExtractor,
+} from "./extractors/PatternExtractor";
+export {
+	createProtoExtractor,
+	ProtoExtractor,
+} from "./extractors/ProtoExtractor";
+export type { PythonImport } from "./extractors/PythonExtractor";
+export {
+	collectPythonImports,
+	createPythonExtractor,
+	PythonExtractor,
+	pythonModuleName,
+} from "./extractors/PythonExtractor";
+export type {
+	TypeScriptImport,
+	TypeScriptImportForm,
+} from "./extractors/TypeScriptExtractor";
+export {
+	collectTypeScriptImports,
+	createTypeScriptExtractor,
+	TYPESCRIPT_MODULE_EXTENSIONS,
+	TypeScriptExtractor,
+	typeScriptImportTarget,
+	typeScriptLanguageOf,
+	typeScriptModuleCandidates,
+} from "./extractors/TypeScriptExtractor";
+// Feature flags
+export type { FeatureFlagConfig } from "./feature-flags";
+export {
+	DEFAULT_FEATURE_FLAG_CONFIG,
+	flagNodeId,
+	linkFeatureFlags,
+} from "./feature-flags";
+// Features
+export type { FeatureImpact } from "./features";
+export { getFeatures, impactByFeature } from "./features";
+// File directives
+export type { FileDirectives } from "./file-directives";
+export {
+	applyFileDirectives,
+	parseFileDirectives,
+} from "./file-directives";
+// Git source
+export type { GitTreeEntry } from "./git-source";
+export {
+	filterGitTree,
+	isBinaryContent,
+	listGitTree,
+	readGitBlobs,
+	resolveGitRef,
+} from "./git-source";
+// Glob
+export { GLOB_CACHE_SIZE, globToRegExp, matchesGlob } from "./glob";
+// Graph
+export type { ChangedNode, GraphDiff } from "./graph-diff";
+export { diffGraphs, edgeKey } from "./graph-diff";
+export type { GraphSnapshot } from "./SemanticGraph";
+export { createSemanticGraph, SemanticGraph } from "./SemanticGraph";
+// Graph service
+export type { GraphServiceOptions, GraphSource } from "./graph-service";
+export { GraphService } from "./graph-service";
+// Graph metrics
+export type {
+	DegreeBreakdown,
+	EdgeWeightFunc,
+	GraphMetricsOptions,
+	Hotspot,
+	HotspotOptions,
+	NodeMetrics,
+	PageRankOptions,
+} from "./graph-metrics";
+export {
+	computeHotspots,
+	computeMetrics,
+	computePageRank,
+	DEFAULT_EDGE_WEIGHT,
+	edgeWeightsByType,
+	fanIn,
+	fanOut,
+} from "./graph-metrics";
+// Ignore files
+export type { IgnoreRule } from "./ignore";
+export {
+	isIgnored,
+	LINKER_IGNORE_FILE,
+	loadIgnoreFile,
+	parseIgnoreFile,
+} from "./ignore";
+// Impact
+export type { ImpactEntry, ImpactOptions } from "./impact";
+export { computeImpactSet, isDependencyEdge } from "./impact";
+// Import resolution
+export type {
+	ImportResolutionContext,
+	ImportResolutionOptions,
+	ImportResolver,
+} from "./import-resolution";
+export {
+	DEFAULT_IMPORT_EDGE_TYPES,
+	DEFAULT_IMPORT_RESOLVERS,
+	GO_IMPORT_RESOLVER,
+	loadModulePaths,
+	mapModulePath,
+	PYTHON_IMPORT_RESOLVER,
+	resolveImports,
+	TYPESCRIPT_IMPORT_RESOLVER,
+} from "./import-resolution";
+// Kind inference
+export type {
+	TagKindConfig,
+	TagKindMap,
+	TagKindResolution,
+} from "./kind-inference";
+export {
+	applyTagKinds,
+	DEFAULT_KIND_REFINEMENTS,
+	DEFAULT_TAG_KIND_CONFIG,
+	DEFAULT_TAG_KINDS,
+	resolveTagKind,
+} from "./kind-inference";
+// Kind merge
+export type { KindMergeMap } from "./kind-merge";
+export { mergeKinds } from "./kind-merge";
+// Layout
+export type { LayoutOptions } from "./layout";
+export { computeLayers } from "./layout";
+// Mermaid export
+export type { MermaidExportOptions } from "./mermaid-export";
+export { exportMermaid, renderMermaid } from "./mermaid-export";
+// Metrics
+export type { MissingMetrics } from "./metrics";
+export { findMissingMetrics, getMetrics } from "./metrics";
+// Multi-root
+export type { AnalysisRoot } from "./multi-root";
+export {
+	mergeRootGraphs,
+	normalizeRoots,
+	rootScopedId,
+	scopeRootGraph,
+} from "./multi-root";
+// Packages
+export type { PackageCoupling, PackageGraphOptions } from "./packages";
+export {
+	couplingScore,
+	findPackageId,
+	graphByPackage,
+	mostCoupledPairs,
+} from "./packages";
+// Parse errors
+export { collectParseErrors } from "./parse-errors";
+// Partitioning
+export type { PartitionOptions } from "./partitioning";
+export { partitionFiles } from "./partitioning";
+// PR report
+export type { PrReport } from "./pr-report";
+export { createPrReport, renderPrReportMarkdown } from "./pr-report";
+// Projection
+export type { ExportProjection } from "./projection";
+export { projectEdge, projectNode } from "./projection";
+// Public surface
+export { isPublicSymbol, publicSurface } from "./public-surface";
+// Query
+export type {
+	NameMatchOptions,
+	SemanticQuery,
+	StreamQueryOptions,
+	SymbolRef,
+	TagMatchOptions,
+} from "./SemanticQueryEngine";
+export {
+	createSemanticQueryEngine,
+	DEFAULT_PAGE_LIMIT,
+	DEFAULT_STREAM_BATCH_SIZE,
+	decodeCursor,
+	encodeCursor,
+	paginate,
+	SemanticQueryEngine,
+} from "./SemanticQueryEngine";
+// Rate limits
+export type { MissingRateLimit, RateLimit } from "./rate-limit";
+export {
+	findMissingRateLimits,
+	getRateLimit,
+	parseRateLimit,
+} from "./rate-limit";
+// Reachability
+export type { ReachabilityOptions } from "./reachability";
+export { computeReachability, ReachabilityIndex } from "./reachability";
+// Resilience
+export type { ResiliencePolicy } from "./resilience";
+export {
+	getResiliencePolicy,
+	parseDuration,
+	parseResiliencePolicy,
+} from "./resilience";
+// Rule engine
+export type { TagRule, TagRuleContext } from "./rule-engine";
+export { createRuleEngine, RuleEngine } from "./rule-engine";
+// Server
+export type { GraphServerOptions, NeighborsResponse } from "./server";
+export {
+	createGraphRequestHandler,
+	createGraphServer,
+	MAX_NEIGHBOR_HOPS,
+} from "./server";
+// Service calls
+export type { HttpClientConfig } from "./service-calls";
+export {
+	DEFAULT_HTTP_CLIENT_CONFIG,
+	linkServiceCalls,
+	parseServiceHost,
+	serviceNodeId,
+} from "./service-calls";
+// Service summary
+export type {
+	ServiceCategory,
+	ServiceOperation,
+	ServiceSummary,
+	ServiceSummaryOptions,
+} from "./service-summary";
+export {
+	formatOperation,
+	renderServiceSummaryMarkdown,
+	summarizeServices,
+	UNCATEGORIZED,
+} from "./service-summary";
+// Sharded export
+export type {
+	ShardEntry,
+	ShardExportOptions,
+	ShardExportResult,
+	ShardIndex,
+} from "./sharded-export";
+export {
+	exportShards,
+	readShardIndex,
+	SHARD_INDEX_FILE,
+} from "./sharded-export";
+// SLA
+export type { SlaPolicy } from "./sla";
+export { DEFAULT_SLA_TIERS, getSlaPolicy, parseSlaPolicy } from "./sla";
+// SQL
+export type { SqlStatement } from "./sql";
+export {
+	collectSqlTables,
+	extractSqlStatements,
+	parseSqlStatement,
+	tableNodeId,
+} from "./sql";
+// Source ranges
+export {
+	getSourceRange,
+	getTextRange,
+	setSourceRanges,
+} from "./source-range";
+// Stable IDs
+export type { StableIdFields } from "./stable-id";
+export {
+	STABLE_ID_VERSION,
+	stableIdFields,
+	stableNodeId,
+	stableNodeIds,
+} from "./stable-id";
+// Store
+export * from "./store";
+// Tag taxonomy
+export type {
+	TagCategory,
+	TagTaxonomy,
+	TaxonomyFormat,
+} from "./tag-taxonomy";
+export {
+	getTaxonomyVocabulary,
+	loadTagTaxonomy,
+	parseTagTaxonomy,
+} from "./tag-taxonomy";
+// Tags
+export { getEffectiveTags, getParentIds } from "./tags";
+// Test files
+export {
+	excludeTests,
+	isTestFile,
+	isTestNode,
+	TEST_TAG,
+} from "./test-files";
+// Timeline
+export type {
+	LabeledGraph,
+	TimelineEvent,
+	TimelinePoint,
+} from "./timeline";
+export { buildSymbolTimeline } from "./timeline";
+// Topological ordering
+export type {
+	TopoGranularity,
+	TopoSortOptions,
+	TopoSortResult,
+} from "./topo-sort";
+export { topoSort } from "./topo-sort";
+// Types
+export type {
+	AmbiguousReference,
+	CallSite,
+	DiagnosticSeverity,
+	Page,
+	PagedResult,
+	SemanticDiagnostic,
+	SemanticEdge,
+	SemanticNode,
+	SourceRange,
+} from "./types";
+// Unreferenced symbols
+export type { UnreferencedOptions } from "./unreferenced";
+export { findUnreferenced } from "./unreferenced";
@@ -0,0 +1,117 @@
+/**
+ * Kind Inference
+ * 시맨틱 태그가 뜻하는 심볼 종류로 구문 트리의 거친 종류를 정규화
+ */
+
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 태그 -> 그 태그가 뜻하는 노드 종류
+ *
+ * 예: { "constructor-function": "constructor" }
+ */
+export type TagKindMap = Record<string, string>;
+
+/**
+ * 태그 종류 추론 설정
+ */
+export interface TagKindConfig {
+	tagKinds: TagKindMap;
+	/**
+	 * 추출된 종류 -> 태그로 좁힐 수 있는 종류
+	 *
+	 * 추출된 종류와 같거나 여기 등록된 종류만 구체화로 보고, 나머지는 충돌이다.
+	 */
+	refinements: Record<string, string[]>;
+}
+
+/** 데모 태그 규칙의 기본 태그 -> 종류 */
+export const DEFAULT_TAG_KINDS: TagKindMap = {
+	"constructor-function": "constructor",
+	"service-struct": "struct",
+	"interface-type": "interface",
+};
+
+/** 추출기가 구분하지 못하는 기본 구체화 */
+export const DEFAULT_KIND_REFINEMENTS: Record<string, string[]> = {
+	function: ["constructor"],
+	type: ["struct", "interface", "enum"],
+};
+
+/** 기본 설정 */
+export const DEFAULT_TAG_KIND_CONFIG: TagKindConfig = {
+	tagKinds: DEFAULT_TAG_KINDS,
+	refinements: DEFAULT_KIND_REFINEMENTS,
+};
+
+/**
+ * 노드 하나의 종류 추론 결과
+ */
+export interface TagKindResolution {
+	/** 정규화된 종류 (충돌이면 추출된 종류) */
+	kind: string;
+	/** 추출기가 정한 종류 */
+	astKind: string;
+	/** 노드에 직접 붙은 태그 중 종류를 뜻하는 태그 (태그 순) */
+	implied: Array<{ tag: string; kind: string }>;
+	/** 태그가 뜻하는 종류끼리, 또는 추출된 종류와 맞지 않음 */
+	conflict: boolean;
+}
+
+/**
+ * 노드의 정규화된 종류
+ *
+ * 상위 심볼에서 상속된 태그는 종류를 뜻하지 않으므로 직접 붙은 태그만 본다.
+ */
+export function resolveTagKind(
+	node: SemanticNode,
+	config: TagKindConfig = DEFAULT_TAG_KIND_CONFIG,
+): TagKindResolution {
+	const astKind = node.kind;
+	const implied = Array.from(new Set(node.semanticTags))
+		.filter((tag) =>
+			Object.prototype.hasOwnProperty.call(config.tagKinds, tag),
+		)
+		.map((tag) => ({ tag, kind: config.tagKinds[tag] }));
+
+	const kinds = new Set(implied.map((entry) => entry.kind));
+	if (kinds.size === 0) {
+		return { kind: astKind, astKind, implied, conflict: false };
+	}
+
+	const [kind] = kinds;
+	const compatible =
+		kinds.size === 1 &&
+		(kind === astKind || (config.refinements[astKind] ?? []).includes(kind));
+	return compatible
+		? { kind, astKind, implied, conflict: false }
+		: { kind: astKind, astKind, implied, conflict: true };
+}
+
+/**
+ * 태그가 뜻하는 종류로 노드 종류를 바꾼 새 그래프 생성
+ *
+ * 종류가 바뀐 노드는 원래 종류를 metadata.astKind에 남긴다. 충돌하는
+ * 노드는 바꾸지 않는다 (checkTagKinds로 보고).
+ */
+export function applyTagKinds(
+	graph: SemanticGraph,
+	config: TagKindConfig = DEFAULT_TAG_KIND_CONFIG,
+): SemanticGraph {
+	const result = new SemanticGraph();
+
+	for (const node of graph.nodes.values()) {
+		const { kind, astKind } = resolveTagKind(node, config);
+		result.addNode(
+			kind === astKind
+				? node
+				: { ...node, kind, metadata: { ...node.metadata, astKind } },
+		);
+	}
+	for (const edge of graph.edges) {
+		result.addEdge(edge);
+	}
+
+	return result;
+}
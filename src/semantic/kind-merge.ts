@@ -0,0 +1,96 @@
+/**
+ * Kind Merge
+ * 세분화된 심볼 종류(필드, 매개변수 등)를 상위 심볼로 병합한 단순화 그래프
+ */
+
+import { SemanticGraph } from "./SemanticGraph";
+import { getParentIds } from "./tags";
+import type { SemanticNode } from "./types";
+
+/**
+ * 병합할 노드 종류 → 병합 대상 상위 노드 종류
+ *
+ * 값이 "*"이면 종류와 관계없이 가장 가까운 남는 상위 노드로 병합한다.
+ * 예: { field: "struct", parameter: "*" }
+ */
+export type KindMergeMap = Record<string, string>;
+
+/**
+ * 지정한 종류의 노드를 가장 가까운 상위 노드로 병합한 새 그래프 생성
+ *
+ * 상위 노드는 contains 엣지와 메서드 리시버를 따라 가까운 순으로 찾으며,
+ * 그 자신도 병합 대상인 상위 노드는 건너뛴다. 병합된 노드의 엣지는 대상
+ * 노드로 다시 연결되고, 병합으로 생긴 자기 참조는 버린다.
+ * 병합된 노드 ID는 대상 노드의 metadata.mergedNodes에 기록되며,
+ * 남는 상위 노드가 없으면 노드와 그 엣지를 버린다.
+ */
+export function mergeKinds(
+	graph: SemanticGraph,
+	mergeMap: KindMergeMap,
+): SemanticGraph {
+	const merged = (kind: string) =>
+		Object.prototype.hasOwnProperty.call(mergeMap, kind);
+	const targetOf = new Map<string, string | undefined>();
+	const mergedInto = new Map<string, string[]>();
+
+	for (const node of graph.nodes.values()) {
+		if (!merged(node.kind)) continue;
+
+		const wanted = mergeMap[node.kind];
+		const visited = new Set([node.id]);
+		let frontier = [node];
+		let target: string | undefined;
+		while (frontier.length > 0 && !target) {
+			const next: SemanticNode[] = [];
+			for (const current of frontier) {
+				for (const parentId of getParentIds(graph, current).sort()) {
+					const parent = graph.getNode(parentId);
+					if (!parent || visited.has(parentId)) continue;
+					visited.add(parentId);
+
+					const survives = !merged(parent.kind);
+					if (survives && (wanted === "*" || parent.kind === wanted)) {
+						target = parentId;
+						break;
+					}
+					next.push(parent);
+				}
+				if (target) break;
+			}
+			frontier = next;
+		}
+
+		targetOf.set(node.id, target);
+		if (target) {
+			mergedInto.set(target, [...(mergedInto.get(target) ?? []), node.id]);
+		}
+	}
+
+	const result = new SemanticGraph();
+	for (const node of graph.nodes.values()) {
+		if (merged(node.kind)) continue;
+
+		const mergedNodes = mergedInto.get(node.id);
+		result.addNode(
+			mergedNodes
+				? {
+						...node,
+						metadata: { ...node.metadata, mergedNodes: mergedNodes.sort() },
+					}
+				: node,
+		);
+	}
+
+	const resolve = (id: string) => (targetOf.has(id) ? targetOf.get(id) : id);
+	for (const edge of graph.edges) {
+		const from = resolve(edge.from);
+		const to = resolve(edge.to);
+		if (!from || !to || from === to) continue;
+
+		result.addEdge(
+			from === edge.from && to === edge.to ? edge : { ...edge, from, to },
+		);
+	}
+
+	return result;
+}
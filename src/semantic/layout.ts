@@ -0,0 +1,68 @@
+/**
+ * Layered Layout Hints
+ * 시각화 도구를 위한 계층(layer) 배치 계산 (Sugiyama 방식의 계층 할당 단계)
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+
+/**
+ * 계층 배치 옵션
+ */
+export interface LayoutOptions {
+	/** 의존 관계로 볼 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+}
+
+/**
+ * 노드별 계층 번호 계산 (최장 경로 계층화)
+ *
+ * 의존하는 노드가 없는 노드는 0층이고, 그 외 노드는 의존 대상 중
+ * 가장 높은 층 + 1에 배치되므로 의존 대상은 항상 의존하는 노드보다 낮은 층에 있다.
+ * 순환은 DFS 중 되돌아가는 엣지를 무시해 끊으며, 탐색은 ID 순으로 해
+ * 결과가 결정적이다.
+ */
+export function computeLayers(
+	graph: SemanticGraph,
+	options: LayoutOptions = {},
+): Map<string, number> {
+	const follows = (type: string) =>
+		options.edgeTypes
+			? options.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+
+	const dependencies = new Map<string, string[]>();
+	for (const edge of graph.edges) {
+		if (!follows(edge.type) || edge.from === edge.to) continue;
+		if (!graph.hasNode(edge.from) || !graph.hasNode(edge.to)) continue;
+		dependencies.set(edge.from, [
+			...(dependencies.get(edge.from) ?? []),
+			edge.to,
+		]);
+	}
+
+	const layers = new Map<string, number>();
+	const visiting = new Set<string>();
+
+	const visit = (id: string): number => {
+		const known = layers.get(id);
+		if (known !== undefined) return known;
+
+		visiting.add(id);
+		let layer = 0;
+		for (const dependency of [...(dependencies.get(id) ?? [])].sort()) {
+			if (visiting.has(dependency)) continue;
+			layer = Math.max(layer, visit(dependency) + 1);
+		}
+		visiting.delete(id);
+
+		layers.set(id, layer);
+		return layer;
+	};
+
+	for (const id of Array.from(graph.nodes.keys()).sort()) {
+		visit(id);
+	}
+
+	return layers;
+}
@@ -0,0 +1,170 @@
+/**
+ * Mermaid Export
+ * 문서 삽입용 Mermaid flowchart(graph LR) 내보내기
+ */
+
+import { getNodePackage } from "./component-grouping";
+import { createEdgeFilter, type DotWriter } from "./dot-export";
+import { EDGE_KIND_COLORS, type EdgeKind, edgeKindOf } from "./edge-kinds";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * Mermaid 내보내기 옵션
+ */
+export interface MermaidExportOptions {
+	/** 심볼을 subgraph로 묶는 기준 (기본: 묶지 않음) */
+	groupBy?: "file" | "package";
+	/** 내보낼 엣지 타입 (기본: kinds가 없으면 imports, calls, references) */
+	edgeTypes?: string[];
+	/** 내보낼 엣지 분류 */
+	kinds?: EdgeKind[];
+	/** 탐색 시작 노드 (기본: 들어오는 엣지가 없는 노드) */
+	roots?: string[];
+	/** 시작 노드에서 따라갈 최대 홉 수 (기본: 제한 없음) */
+	maxDepth?: number;
+	/** ```mermaid 코드 펜스로 감싸기 (기본: false) */
+	fence?: boolean;
+}
+
+/**
+ * 그래프를 Mermaid flowchart 문자열로 렌더링
+ *
+ * 노드 ID는 n0, n1 ... 로 바꾸고 원래 이름은 따옴표 라벨로 쓰며, Mermaid가
+ * 해석하는 따옴표/괄호/꺾쇠는 HTML 엔티티 코드로 이스케이프한다.
+ * maxDepth를 주면 시작 노드에서 그 홉 수 안에 닿는 노드만 포함한다.
+ * 엣지 색은 분류(EdgeKind)별 linkStyle로 지정한다.
+ */
+export function renderMermaid(
+	graph: SemanticGraph,
+	options: MermaidExportOptions = {},
+): string {
+	const includes = createEdgeFilter(options);
+	const edges = graph.edges.filter(
+		(edge) =>
+			includes(edge) && graph.hasNode(edge.from) && graph.hasNode(edge.to),
+	);
+
+	const included = selectNodes(graph, edges, options);
+	const ids = new Map(
+		Array.from(included)
+			.sort()
+			.map((id, index) => [id, `n${index}`]),
+	);
+
+	const lines = ["graph LR"];
+	const groups = new Map<string, SemanticNode[]>();
+	for (const id of ids.keys()) {
+		const node = graph.getNode(id) as SemanticNode;
+		const group =
+			options.groupBy === "file"
+				? node.filePath
+				: options.groupBy === "package"
+					? getNodePackage(node)
+					: "";
+		groups.set(group, [...(groups.get(group) ?? []), node]);
+	}
+
+	let groupIndex = 0;
+	for (const group of Array.from(groups.keys()).sort()) {
+		const members = groups.get(group) as SemanticNode[];
+		const indent = group ? "\t\t" : "\t";
+		if (group) {
+			lines.push(`\tsubgraph g${groupIndex++}["${escapeLabel(group)}"]`);
+		}
+		for (const node of members) {
+			lines.push(`${indent}${ids.get(node.id)}["${escapeLabel(node.name)}"]`);
+		}
+		if (group) lines.push("\tend");
+	}
+
+	const seen = new Set<string>();
+	const linksByKind = new Map<EdgeKind, number[]>();
+	for (const edge of edges) {
+		const from = ids.get(edge.from);
+		const to = ids.get(edge.to);
+		const line = `\t${from} -->|${edge.type}| ${to}`;
+		if (!from || !to || seen.has(line)) continue;
+		const kind = edgeKindOf(edge);
+		linksByKind.set(kind, [...(linksByKind.get(kind) ?? []), seen.size]);
+		seen.add(line);
+		lines.push(line);
+	}
+	for (const [kind, links] of linksByKind) {
+		lines.push(
+			`\tlinkStyle ${links.join(",")} stroke:${EDGE_KIND_COLORS[kind]}`,
+		);
+	}
+
+	const body = `${lines.join("\n")}\n`;
+	return options.fence ? `\`\`\`mermaid\n${body}\`\`\`\n` : body;
+}
+
+/**
+ * 그래프를 Mermaid 형식으로 출력 대상에 쓰기
+ */
+export function exportMermaid(
+	graph: SemanticGraph,
+	writer: DotWriter,
+	options?: MermaidExportOptions,
+): void {
+	writer.write(renderMermaid(graph, options));
+}
+
+/**
+ * maxDepth 안에 닿는 노드 선택 (maxDepth가 없으면 전체 노드)
+ */
+function selectNodes(
+	graph: SemanticGraph,
+	edges: Array<{ from: string; to: string }>,
+	options: MermaidExportOptions,
+): Set<string> {
+	if (options.maxDepth === undefined && options.roots === undefined) {
+		return new Set(graph.nodes.keys());
+	}
+
+	const targets = new Set(edges.map((edge) => edge.to));
+	const successors = new Map<string, string[]>();
+	for (const edge of edges) {
+		successors.set(edge.from, [...(successors.get(edge.from) ?? []), edge.to]);
+	}
+	const roots =
+		options.roots ??
+		Array.from(graph.nodes.keys()).filter((id) => !targets.has(id));
+	const maxDepth = options.maxDepth ?? Number.POSITIVE_INFINITY;
+
+	const depths = new Map<string, number>();
+	const queue: string[] = [];
+	for (const id of roots) {
+		if (graph.hasNode(id) && !depths.has(id)) {
+			depths.set(id, 0);
+			queue.push(id);
+		}
+	}
+	while (queue.length > 0) {
+		const id = queue.shift() as string;
+		const depth = depths.get(id) as number;
+		if (depth >= maxDepth) continue;
+		for (const next of successors.get(id) ?? []) {
+			if (depths.has(next)) continue;
+			depths.set(next, depth + 1);
+			queue.push(next);
+		}
+	}
+
+	return new Set(depths.keys());
+}
+
+/**
+ * Mermaid 라벨 이스케이프 (따옴표, 괄호, 꺾쇠, 파이프)
+ */
+function escapeLabel(value: string): string {
+	return value
+		.replace(/"/g, "#quot;")
+		.replace(/\(/g, "#40;")
+		.replace(/\)/g, "#41;")
+		.replace(/</g, "#lt;")
+		.replace(/>/g, "#gt;")
+		.replace(/\|/g, "#124;")
+		.replace(/\r?\n/g, " ");
+}
@@ -0,0 +1,67 @@
+/**
+ * Metrics Instrumentation Annotations
+ * @metric 어노테이션 조회 및 필수 계측 누락 리포트
+ */
+
+import { getAnnotationValues } from "./annotations";
+import type { SemanticGraph } from "./SemanticGraph";
+import { getEffectiveTags } from "./tags";
+import type { SemanticNode } from "./types";
+
+/**
+ * 필수 메트릭이 누락된 심볼
+ */
+export interface MissingMetrics {
+	node: SemanticNode;
+	/** 누락된 메트릭 이름 (이름 순) */
+	missing: string[];
+	/** 누락 메트릭을 요구한 태그 */
+	requiredBy: string[];
+}
+
+/**
+ * 노드가 선언한 메트릭 목록 (`@metric request_count, latency_ms`)
+ */
+export function getMetrics(node: SemanticNode): string[] {
+	const metrics = getAnnotationValues(node, "metric").flatMap((value) =>
+		value.split(/[\s,]+/).filter((metric) => metric.length > 0),
+	);
+	return Array.from(new Set(metrics));
+}
+
+/**
+ * 태그별 필수 메트릭이 누락된 심볼 찾기
+ *
+ * requiredByTag는 태그 -> 필수 메트릭 목록이며, 상속된 태그도 적용된다.
+ * 결과는 노드 ID 순이다.
+ */
+export function findMissingMetrics(
+	graph: SemanticGraph,
+	requiredByTag: Record<string, string[]>,
+): MissingMetrics[] {
+	const results: MissingMetrics[] = [];
+
+	for (const node of graph.nodes.values()) {
+		const tags = getEffectiveTags(graph, node);
+		const declared = new Set(getMetrics(node));
+		const missing = new Set<string>();
+		const requiredBy: string[] = [];
+
+		for (const [tag, required] of Object.entries(requiredByTag)) {
+			if (!tags.has(tag)) continue;
+			const absent = required.filter((metric) => !declared.has(metric));
+			if (absent.length === 0) continue;
+
+			requiredBy.push(tag);
+			for (const metric of absent) missing.add(metric);
+		}
+
+		if (missing.size > 0) {
+			results.push({ node, missing: Array.from(missing).sort(), requiredBy });
+		}
+	}
+
+	return results.sort((a, b) =>
+		a.node.id < b.node.id ? -1 : a.node.id > b.node.id ? 1 : 0,
+	);
+}
@@ -0,0 +1,144 @@
+/**
+ * Multi-Root Graphs
+ * 나란히 체크아웃된 여러 루트(저장소)의 그래프를 하나로 병합
+ */
+
+import path from "node:path";
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 분석 루트 하나
+ */
+export interface AnalysisRoot {
+	/** 노드 ID/파일 경로 접두사와 metadata.root에 쓰는 이름 */
+	label: string;
+	/** 루트 디렉토리 */
+	path: string;
+}
+
+/** 루트 사이에서 공유하는 자리표시 노드 종류 */
+const SHARED_KINDS = new Set(["external", "table"]);
+
+/**
+ * 루트 목록 정규화 (문자열은 디렉토리 이름을 이름표로 사용)
+ *
+ * 이름표가 비었거나 "/"를 포함하거나 겹치면 예외를 던진다.
+ */
+export function normalizeRoots(
+	roots: Array<string | AnalysisRoot>,
+): AnalysisRoot[] {
+	const labels = new Set<string>();
+	return roots.map((root) => {
+		const normalized =
+			typeof root === "string"
+				? { label: path.basename(path.resolve(root)), path: root }
+				: root;
+		if (!normalized.label || normalized.label.includes("/")) {
+			throw new Error(`Invalid root label: ${normalized.label}`);
+		}
+		if (labels.has(normalized.label)) {
+			throw new Error(`Duplicate root label: ${normalized.label}`);
+		}
+		labels.add(normalized.label);
+		return normalized;
+	});
+}
+
+/**
+ * 루트 이름표를 붙인 노드 ID ("<이름표>/<ID>")
+ */
+export function rootScopedId(label: string, id: string): string {
+	return `${label}/${id}`;
+}
+
+/**
+ * 한 루트의 그래프를 이름표로 구분한 새 그래프 생성
+ *
+ * 자리표시 노드를 제외한 모든 노드의 ID와 파일 경로 앞에 이름표를 붙이고
+ * metadata.root에 이름표를 기록한다. 파일 노드의 ID는 파일 경로와 계속
+ * 같고, Go 파일 노드의 metadata.goPackage와 Go 심볼의 metadata.packageId도
+ * 바뀐 패키지 노드 ID를 가리킨다.
+ * 자리표시 노드는 ID를 유지하고 metadata.roots에 참조하는 루트를 남긴다.
+ */
+export function scopeRootGraph(
+	graph: SemanticGraph,
+	label: string,
+): SemanticGraph {
+	const scope = (id: string) => {
+		const node = graph.getNode(id);
+		return node && SHARED_KINDS.has(node.kind) ? id : rootScopedId(label, id);
+	};
+
+	const result = new SemanticGraph();
+	for (const node of graph.nodes.values()) {
+		if (SHARED_KINDS.has(node.kind)) {
+			result.addNode({
+				...node,
+				metadata: { ...node.metadata, roots: [label] },
+			});
+			continue;
+		}
+
+		const metadata: Record<string, any> = { ...node.metadata, root: label };
+		if (node.kind === "file" && typeof metadata.goPackage === "string") {
+			metadata.goPackage = rootScopedId(label, metadata.goPackage);
+		}
+		if (typeof metadata.packageId === "string") {
+			metadata.packageId = rootScopedId(label, metadata.packageId);
+		}
+		result.addNode({
+			...node,
+			id: scope(node.id),
+			filePath: rootScopedId(label, node.filePath),
+			metadata,
+		});
+	}
+	for (const edge of graph.edges) {
+		result.addEdge({ ...edge, from: scope(edge.from), to: scope(edge.to) });
+	}
+
+	return result;
+}
+
+/**
+ * 이름표로 구분한 루트 그래프 병합
+ *
+ * 같은 ID의 자리표시 노드(공유 외부 의존성, 테이블)는 하나로 합치고
+ * metadata.roots에 참조하는 루트를 모두 남긴다.
+ */
+export function mergeRootGraphs(graphs: SemanticGraph[]): SemanticGraph {
+	const result = new SemanticGraph();
+
+	for (const graph of graphs) {
+		for (const node of graph.nodes.values()) {
+			const existing = result.getNode(node.id);
+			if (existing && SHARED_KINDS.has(node.kind)) {
+				result.addNode(mergeSharedNode(existing, node));
+			} else {
+				result.addNode(node);
+			}
+		}
+	}
+	for (const graph of graphs) {
+		for (const edge of graph.edges) {
+			result.addEdge(edge);
+		}
+	}
+
+	return result;
+}
+
+function mergeSharedNode(
+	existing: SemanticNode,
+	node: SemanticNode,
+): SemanticNode {
+	const roots = new Set<string>([
+		...((existing.metadata.roots as string[] | undefined) ?? []),
+		...((node.metadata.roots as string[] | undefined) ?? []),
+	]);
+	return {
+		...existing,
+		metadata: { ...existing.metadata, roots: Array.from(roots).sort() },
+	};
+}
@@ -0,0 +1,196 @@
+/**
+ * Package Rollup
+ * 심볼 그래프를 선언 패키지 단위 의존 그래프로 집계
+ */
+
+import { isDependencyEdge } from "./impact";
+import { SemanticGraph } from "./SemanticGraph";
+
+/**
+ * 패키지 집계 옵션
+ */
+export interface PackageGraphOptions {
+	/** 집계할 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+}
+
+/**
+ * 노드가 속한 패키지 노드 ID (없으면 undefined)
+ *
+ * contains 엣지를 거슬러 올라가 처음 만나는 kind "package" 노드를 찾으므로
+ * 메서드는 리시버 타입을, 필드는 구조체를 거쳐 패키지에 닿는다.
+ */
+export function findPackageId(
+	graph: SemanticGraph,
+	id: string,
+): string | undefined {
+	const visited = new Set<string>();
+	const queue = [id];
+	while (queue.length > 0) {
+		const current = queue.shift() as string;
+		if (visited.has(current)) continue;
+		visited.add(current);
+
+		if (graph.getNode(current)?.kind === "package") {
+			return current;
+		}
+		for (const edge of graph.getIncomingEdges(current, ["contains"])) {
+			queue.push(edge.from);
+		}
+	}
+	return undefined;
+}
+
+/**
+ * 패키지 단위 의존 그래프 생성
+ *
+ * 각 패키지 노드를 복사하고 소속 심볼 ID를 metadata.nodeIds에 기록한다.
+ * 패키지 사이의 엣지는 방향별로 "depends_on" 엣지 하나로 합쳐지며,
+ * 합쳐진 엣지 수는 metadata.count, 원래 관계 타입별 개수는
+ * metadata.types에 기록된다. 같은 패키지 내부 엣지는
+ * metadata.internalEdges로만 집계하고, 패키지에 속하지 않는 노드
+ * (외부 패키지, 파일, 테이블 등)와 연결된 엣지는 버린다.
+ */
+export function graphByPackage(
+	graph: SemanticGraph,
+	options: PackageGraphOptions = {},
+): SemanticGraph {
+	const follows = (type: string) =>
+		options.edgeTypes
+			? options.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+	const packageOf = new Map<string, string>();
+	const result = new SemanticGraph();
+
+	for (const node of graph.nodes.values()) {
+		if (node.kind === "package") {
+			result.addNode({
+				...node,
+				metadata: { ...node.metadata, nodeIds: [], internalEdges: 0 },
+			});
+		}
+	}
+	for (const node of graph.nodes.values()) {
+		const packageId = findPackageId(graph, node.id);
+		if (packageId === undefined) continue;
+
+		packageOf.set(node.id, packageId);
+		if (packageId !== node.id) {
+			result.getNode(packageId)?.metadata.nodeIds.push(node.id);
+		}
+	}
+
+	const aggregated = new Map<
+		string,
+		{ count: number; types: Record<string, number> }
+	>();
+	for (const edge of graph.edges) {
+		if (!follows(edge.type)) continue;
+		const from = packageOf.get(edge.from);
+		const to = packageOf.get(edge.to);
+		if (from === undefined || to === undefined) continue;
+
+		if (from === to) {
+			const node = result.getNode(from);
+			if (node) node.metadata.internalEdges++;
+			continue;
+		}
+
+		const key = `${from}\u0000${to}`;
+		let metadata = aggregated.get(key);
+		if (!metadata) {
+			metadata = { count: 0, types: {} };
+			aggregated.set(key, metadata);
+			result.addEdge({ from, to, type: "depends_on", metadata });
+		}
+		metadata.count++;
+		metadata.types[edge.type] = (metadata.types[edge.type] || 0) + 1;
+	}
+
+	return result;
+}
+
+/**
+ * 두 패키지 사이의 결합도
+ */
+export interface PackageCoupling {
+	packageA: string;
+	packageB: string;
+	/** A의 심볼이 B의 심볼에 의존하는 엣지 수 */
+	aToB: number;
+	/** B의 심볼이 A의 심볼에 의존하는 엣지 수 */
+	bToA: number;
+	/** 정규화 점수 (0-1, 두 패키지에 걸친 의존 중 경계를 넘는 비율) */
+	score: number;
+}
+
+/**
+ * 패키지 단위 의존 그래프(graphByPackage 결과)에서 두 패키지의 결합도 계산
+ *
+ * score = (aToB + bToA) / (aToB + bToA + 두 패키지의 내부 엣지 수).
+ * 의존이 하나도 없으면 0이며, 인자 순서를 바꾸면 방향별 개수만 바뀐다.
+ */
+export function couplingScore(
+	packageGraph: SemanticGraph,
+	packageA: string,
+	packageB: string,
+): PackageCoupling {
+	const internal = [packageA, packageB].map((id) => {
+		const node = packageGraph.getNode(id);
+		if (!node) {
+			throw new Error(`Unknown package: ${id}`);
+		}
+		return (node.metadata.internalEdges as number | undefined) ?? 0;
+	});
+	const count = (from: string, to: string) =>
+		packageGraph
+			.getOutgoingEdges(from, ["depends_on"])
+			.filter((edge) => edge.to === to)
+			.reduce((sum, edge) => sum + (edge.metadata?.count ?? 1), 0);
+
+	const aToB = packageA === packageB ? 0 : count(packageA, packageB);
+	const bToA = packageA === packageB ? 0 : count(packageB, packageA);
+	const crossing = aToB + bToA;
+	const total = crossing + internal[0] + internal[1];
+	return {
+		packageA,
+		packageB,
+		aToB,
+		bToA,
+		score: total === 0 ? 0 : crossing / total,
+	};
+}
+
+/**
+ * 결합도가 가장 높은 패키지 쌍 n개 (점수, 경계 엣지 수, 이름 순)
+ *
+ * 의존 엣지가 있는 쌍만 계산하며 각 쌍은 이름 순으로 한 번만 나온다.
+ */
+export function mostCoupledPairs(
+	packageGraph: SemanticGraph,
+	n: number,
+): PackageCoupling[] {
+	if (!Number.isInteger(n) || n < 0) {
+		throw new Error(`Invalid pair count: ${n}`);
+	}
+
+	const pairs = new Map<string, PackageCoupling>();
+	for (const edge of packageGraph.edges) {
+		if (edge.type !== "depends_on" || edge.from === edge.to) continue;
+		const [a, b] = [edge.from, edge.to].sort();
+		const key = `${a}\u0000${b}`;
+		if (!pairs.has(key)) {
+			pairs.set(key, couplingScore(packageGraph, a, b));
+		}
+	}
+
+	return Array.from(pairs.values())
+		.sort(
+			(x, y) =>
+				y.score - x.score ||
+				y.aToB + y.bToA - (x.aToB + x.bToA) ||
+				(x.packageA < y.packageA ? -1 : x.packageA > y.packageA ? 1 : 0) ||
+				(x.packageB < y.packageB ? -1 : x.packageB > y.packageB ? 1 : 0),
+		)
+		.slice(0, n);
+}
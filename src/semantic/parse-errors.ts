@@ -0,0 +1,66 @@
+/**
+ * Parse Errors
+ * tree-sitter 구문 트리의 ERROR/MISSING 노드를 구조화된 오류로 수집
+ */
+
+import type Parser from "tree-sitter";
+import type { ParseError } from "./extractors/LanguageExtractor";
+
+/** 오류 메시지에 인용할 원문 최대 길이 */
+const MAX_QUOTED_TEXT = 40;
+
+/**
+ * 구문 트리의 구문 오류 목록 (소스 순)
+ *
+ * ERROR 노드는 그 구간 전체를 오류 하나로 보고 안쪽은 더 살피지 않으며,
+ * 파서가 보충한 MISSING 노드는 "Missing <종류>" 오류가 된다. hasError가
+ * 없는 서브트리는 건너뛰므로 오류가 없는 파일은 루트만 확인한다.
+ */
+export function collectParseErrors(tree: Parser.Tree): ParseError[] {
+	const errors: ParseError[] = [];
+	const visit = (node: Parser.SyntaxNode) => {
+		if (node.isError) {
+			const quoted = node.text.replace(/\s+/g, " ").trim();
+			errors.push(
+				toParseError(
+					node,
+					quoted.length === 0
+						? "Syntax error"
+						: `Syntax error near "${
+								quoted.length > MAX_QUOTED_TEXT
+									? `${quoted.slice(0, MAX_QUOTED_TEXT)}…`
+									: quoted
+							}"`,
+					node.text,
+				),
+			);
+			return;
+		}
+		if (node.isMissing) {
+			errors.push(toParseError(node, `Missing ${node.type}`, ""));
+			return;
+		}
+		if (!node.hasError) return;
+		for (const child of node.children) {
+			visit(child);
+		}
+	};
+
+	visit(tree.rootNode);
+	return errors;
+}
+
+function toParseError(
+	node: Parser.SyntaxNode,
+	message: string,
+	text: string,
+): ParseError {
+	return {
+		startIndex: node.startIndex,
+		endIndex: node.endIndex,
+		line: node.startPosition.row + 1,
+		column: node.startPosition.column + 1,
+		message,
+		text,
+	};
+}
@@ -0,0 +1,69 @@
+/**
+ * Analysis Partitioning
+ * 분산 분석을 위해 파일 목록을 균형 잡힌 샤드로 분할
+ */
+
+import path from "node:path";
+
+/**
+ * 분할 옵션
+ */
+export interface PartitionOptions {
+	/**
+	 * 분할 단위
+	 * - directory: 같은 디렉토리의 파일은 항상 같은 샤드 (기본값)
+	 * - cost: 파일 단위로 비용이 균형을 이루도록 분배
+	 */
+	strategy?: "directory" | "cost";
+	/** 파일별 예상 분석 비용 (기본: 파일당 1) */
+	cost?: (filePath: string) => number;
+}
+
+/**
+ * 파일 목록을 샤드로 분할
+ *
+ * 비용이 큰 묶음부터 현재 비용이 가장 작은 샤드에 배정한다 (LPT).
+ * 같은 비용이면 경로 순, 같은 부하면 샤드 번호 순으로 배정해 결과가 결정적이다.
+ * 각 샤드의 파일은 경로 순으로 정렬되며, 빈 샤드가 있을 수 있다.
+ */
+export function partitionFiles(
+	filePaths: string[],
+	shardCount: number,
+	options: PartitionOptions = {},
+): string[][] {
+	if (!Number.isInteger(shardCount) || shardCount < 1) {
+		throw new Error(`Invalid shard count: ${shardCount}`);
+	}
+
+	const cost = options.cost ?? (() => 1);
+	const groups = new Map<string, { files: string[]; cost: number }>();
+	for (const filePath of [...filePaths].sort()) {
+		const key =
+			options.strategy === "cost"
+				? filePath
+				: path.posix.dirname(filePath.replace(/\\/g, "/"));
+		const group = groups.get(key) ?? { files: [], cost: 0 };
+		group.files.push(filePath);
+		group.cost += cost(filePath);
+		groups.set(key, group);
+	}
+
+	const ordered = Array.from(groups.entries()).sort(
+		([a, left], [b, right]) =>
+			right.cost - left.cost || (a < b ? -1 : a > b ? 1 : 0),
+	);
+
+	const shards = Array.from({ length: shardCount }, () => ({
+		files: [] as string[],
+		cost: 0,
+	}));
+	for (const [, group] of ordered) {
+		const target = shards.reduce((best, shard) =>
+			shard.cost < best.cost ? shard : best,
+		);
+		target.files.push(...group.files);
+		target.cost += group.cost;
+	}
+
+	return shards.map((shard) => shard.files.sort());
+}
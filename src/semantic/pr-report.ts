@@ -0,0 +1,130 @@
+/**
+ * PR Impact Report
+ * 그래프 diff와 영향 범위를 합쳐 PR 코멘트용 리포트 생성
+ */
+
+import { diffGraphs, type GraphDiff } from "./graph-diff";
+import {
+	computeImpactSet,
+	type ImpactEntry,
+	type ImpactOptions,
+} from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge } from "./types";
+
+/**
+ * PR 리포트
+ */
+export interface PrReport {
+	diff: GraphDiff;
+	/** 변경/삭제된 심볼과 바뀐 엣지의 출발 심볼에 의존하는 심볼 */
+	affected: ImpactEntry[];
+}
+
+/**
+ * 변경 전후 그래프로 PR 리포트 생성
+ *
+ * 영향 범위는 변경 후 그래프에서, 삭제된 심볼은 변경 전 그래프에서 계산한다.
+ * 직접 변경된 심볼 자체는 affected에 포함하지 않는다.
+ */
+export function createPrReport(
+	before: SemanticGraph,
+	after: SemanticGraph,
+	options: ImpactOptions = {},
+): PrReport {
+	const diff = diffGraphs(before, after);
+
+	const changedIds = new Set([
+		...diff.changedNodes.map((change) => change.after.id),
+		...diff.addedEdges.map((edge) => edge.from),
+		...diff.removedEdges.map((edge) => edge.from),
+	]);
+	const removedIds = diff.removedNodes.map((node) => node.id);
+	const directIds = new Set([
+		...changedIds,
+		...removedIds,
+		...diff.addedNodes.map((node) => node.id),
+	]);
+
+	const affected = new Map<string, ImpactEntry>();
+	const collect = (entries: ImpactEntry[]) => {
+		for (const entry of entries) {
+			if (directIds.has(entry.node.id)) continue;
+			const existing = affected.get(entry.node.id);
+			if (!existing || entry.depth < existing.depth) {
+				affected.set(entry.node.id, entry);
+			}
+		}
+	};
+	collect(
+		computeImpactSet(
+			after,
+			Array.from(changedIds).filter((id) => after.hasNode(id)),
+			options,
+		),
+	);
+	collect(computeImpactSet(before, removedIds, options));
+
+	return {
+		diff,
+		affected: Array.from(affected.values()).sort(
+			(a, b) =>
+				a.depth - b.depth ||
+				(a.node.id < b.node.id ? -1 : a.node.id > b.node.id ? 1 : 0),
+		),
+	};
+}
+
+/**
+ * PR 리포트를 Markdown으로 렌더링
+ */
+export function renderPrReportMarkdown(report: PrReport): string {
+	const { diff, affected } = report;
+	const lines: string[] = ["## Dependency impact", ""];
+
+	const total =
+		diff.addedNodes.length +
+		diff.removedNodes.length +
+		diff.changedNodes.length +
+		diff.addedEdges.length +
+		diff.removedEdges.length;
+	if (total === 0) {
+		lines.push("No structural changes.");
+		return `${lines.join("\n")}\n`;
+	}
+
+	const section = (title: string, items: string[]) => {
+		if (items.length === 0) return;
+		lines.push(`### ${title} (${items.length})`, "", ...items, "");
+	};
+
+	section(
+		"Added symbols",
+		diff.addedNodes.map((node) => `- \`${node.fqn}\` (${node.kind})`),
+	);
+	section(
+		"Removed symbols",
+		diff.removedNodes.map((node) => `- \`${node.fqn}\` (${node.kind})`),
+	);
+	section(
+		"Changed symbols",
+		diff.changedNodes.map(
+			(change) => `- \`${change.after.fqn}\`: ${change.changes.join(", ")}`,
+		),
+	);
+	section("New edges", diff.addedEdges.map(formatEdge));
+	section("Removed edges", diff.removedEdges.map(formatEdge));
+	section(
+		"Affected dependents",
+		affected.map(
+			(entry) =>
+				`- \`${entry.node.fqn}\` (${entry.depth === 1 ? "direct" : `depth ${entry.depth}`})`,
+		),
+	);
+
+	return `${lines.join("\n").trimEnd()}\n`;
+}
+
+function formatEdge(edge: SemanticEdge): string {
+	return `- \`${edge.from}\` → \`${edge.to}\` (${edge.type})`;
+}
@@ -0,0 +1,69 @@
+/**
+ * Export Projection
+ * 익스포트 시 노드/엣지 속성 중 선택한 것만 남기는 프로젝션
+ */
+
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/**
+ * 익스포트 속성 프로젝션
+ *
+ * 속성 이름은 노드/엣지의 최상위 필드 이름이며, "metadata.<key>"로
+ * 메타데이터의 일부 키만 선택할 수 있다. 목록을 생략하면 전체 속성을 유지한다.
+ */
+export interface ExportProjection {
+	/** 포함할 노드 속성 (예: ["fqn", "semanticTags"]) */
+	node?: string[];
+	/** 포함할 엣지 속성 (예: ["from", "to", "type"]) */
+	edge?: string[];
+}
+
+/**
+ * 노드 프로젝션 (프로젝션이 없으면 원본 그대로)
+ */
+export function projectNode<T extends SemanticNode>(
+	node: T,
+	projection?: ExportProjection,
+): Partial<T> {
+	return projection?.node ? pick(node, projection.node) : node;
+}
+
+/**
+ * 엣지 프로젝션 (프로젝션이 없으면 원본 그대로)
+ */
+export function projectEdge(
+	edge: SemanticEdge,
+	projection?: ExportProjection,
+): Partial<SemanticEdge> {
+	return projection?.edge ? pick(edge, projection.edge) : edge;
+}
+
+/**
+ * 선택한 속성만 복사 (값이 undefined인 속성은 생략)
+ */
+function pick<T extends { metadata?: Record<string, any> }>(
+	record: T,
+	fields: string[],
+): Partial<T> {
+	const result: Record<string, unknown> = {};
+
+	for (const field of fields) {
+		if (field.startsWith("metadata.")) {
+			const key = field.slice("metadata.".length);
+			const value = record.metadata?.[key];
+			if (value === undefined) continue;
+
+			const metadata = (result.metadata ?? {}) as Record<string, unknown>;
+			metadata[key] = value;
+			result.metadata = metadata;
+			continue;
+		}
+
+		const value = (record as Record<string, unknown>)[field];
+		if (value !== undefined) {
+			result[field] = value;
+		}
+	}
+
+	return result as Partial<T>;
+}
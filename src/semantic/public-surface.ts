@@ -0,0 +1,60 @@
+/**
+ * Public Surface
+ * 외부에 공개된 심볼만 남긴 그래프 (API 안정성 추적용)
+ */
+
+import { getGoPackageId } from "./extractors/GoExtractor";
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** 공개 여부와 관계없이 유지하는 컨테이너/자리표시 노드 종류 */
+const STRUCTURAL_KINDS = new Set([
+	"package",
+	"module",
+	"file",
+	"external",
+	"table",
+]);
+
+/**
+ * 공개 심볼인지 확인
+ *
+ * - Go: 패키지 이후 이름의 모든 부분이 대문자로 시작 (메서드는 리시버
+ *   타입도, 필드는 소유 타입도 공개여야 함)
+ * - 그 외: 추출기가 기록한 metadata.exported를 따르며, 공개 여부 개념이
+ *   없어 기록하지 않는 언어(proto 등)는 공개로 본다.
+ *
+ * 패키지/모듈 같은 컨테이너와 자리표시 노드는 항상 공개로 본다.
+ */
+export function isPublicSymbol(node: SemanticNode): boolean {
+	if (STRUCTURAL_KINDS.has(node.kind)) {
+		return true;
+	}
+	if (node.language === "go") {
+		const packageId = getGoPackageId(node);
+		const localName =
+			packageId && node.fqn.startsWith(`${packageId}.`)
+				? node.fqn.slice(packageId.length + 1)
+				: node.name;
+		return localName.split(".").every((part) => /^\p{Lu}/u.test(part));
+	}
+	return node.metadata.exported !== false;
+}
+
+/**
+ * 비공개 심볼과 그 심볼에 닿는 엣지를 제거한 그래프
+ */
+export function publicSurface(graph: SemanticGraph): SemanticGraph {
+	const result = new SemanticGraph();
+	for (const node of graph.nodes.values()) {
+		if (isPublicSymbol(node)) {
+			result.addNode(node);
+		}
+	}
+	for (const edge of graph.edges) {
+		if (result.hasNode(edge.from) && result.hasNode(edge.to)) {
+			result.addEdge(edge);
+		}
+	}
+	return result;
+}
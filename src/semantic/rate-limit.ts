@@ -0,0 +1,101 @@
+/**
+ * Rate Limit Annotations
+ * @rate-limit 어노테이션 파싱 및 필수 속도 제한 누락 리포트
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import { getEffectiveTags } from "./tags";
+import type { SemanticNode } from "./types";
+
+/**
+ * 심볼에 선언된 속도 제한 (`@rate-limit 100/min`)
+ */
+export interface RateLimit {
+	/** 윈도우당 허용 요청 수 */
+	limit: number;
+	/** 정규화된 윈도우 단위 */
+	window: "second" | "minute" | "hour" | "day";
+	/** 윈도우 밀리초 값 */
+	windowMs: number;
+	/** 어노테이션 원본 값 */
+	raw: string;
+}
+
+const RATE_WINDOWS: Record<string, [RateLimit["window"], number]> = {
+	s: ["second", 1000],
+	sec: ["second", 1000],
+	second: ["second", 1000],
+	m: ["minute", 60_000],
+	min: ["minute", 60_000],
+	minute: ["minute", 60_000],
+	h: ["hour", 3_600_000],
+	hour: ["hour", 3_600_000],
+	d: ["day", 86_400_000],
+	day: ["day", 86_400_000],
+};
+
+/**
+ * 필수 속도 제한이 없는 심볼
+ */
+export interface MissingRateLimit {
+	node: SemanticNode;
+	/** 속도 제한을 요구한 태그 */
+	requiredBy: string[];
+}
+
+/**
+ * 어노테이션에서 속도 제한 파싱 (없거나 형식이 잘못되면 undefined)
+ *
+ * 같은 어노테이션이 여러 번 있으면 첫 번째 값을 사용한다.
+ */
+export function parseRateLimit(
+	annotations: Record<string, string[]>,
+): RateLimit | undefined {
+	const raw = annotations["rate-limit"]?.[0]?.trim();
+	const match = raw?.match(/^(\d+)\s*\/\s*([a-z]+)$/i);
+	if (!raw || !match) return undefined;
+
+	const window = RATE_WINDOWS[match[2].toLowerCase()];
+	if (!window) return undefined;
+
+	return {
+		limit: Number(match[1]),
+		window: window[0],
+		windowMs: window[1],
+		raw,
+	};
+}
+
+/**
+ * 노드의 속도 제한 조회 (metadata.rateLimit)
+ */
+export function getRateLimit(node: SemanticNode): RateLimit | undefined {
+	return node.metadata.rateLimit as RateLimit | undefined;
+}
+
+/**
+ * 속도 제한이 필요한 태그가 있지만 @rate-limit이 없는 함수/메서드 찾기
+ *
+ * 상속된 태그도 적용되며, 결과는 노드 ID 순이다.
+ */
+export function findMissingRateLimits(
+	graph: SemanticGraph,
+	requiredByTag: string[] = ["public-api"],
+): MissingRateLimit[] {
+	const results: MissingRateLimit[] = [];
+
+	for (const node of graph.nodes.values()) {
+		if (node.kind !== "function" && node.kind !== "method") continue;
+		if (getRateLimit(node)) continue;
+
+		const tags = getEffectiveTags(graph, node);
+		const requiredBy = requiredByTag.filter((tag) => tags.has(tag));
+		if (requiredBy.length > 0) {
+			results.push({ node, requiredBy });
+		}
+	}
+
+	return results.sort((a, b) =>
+		a.node.id < b.node.id ? -1 : a.node.id > b.node.id ? 1 : 0,
+	);
+}
@@ -0,0 +1,190 @@
+/**
+ * Reachability
+ * 노드별 도달 가능 집합 계산 및 엣지 변경 시 증분 갱신
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge } from "./types";
+
+/**
+ * 도달 가능성 옵션
+ */
+export interface ReachabilityOptions {
+	/** 따라갈 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+	/**
+	 * 엣지 변경 시 영향을 받는 집합만 갱신 (기본: false, 매번 전체 재계산)
+	 */
+	incremental?: boolean;
+}
+
+/**
+ * 모든 노드의 도달 가능 집합 전체 계산
+ *
+ * 집합에 시작 노드 자신은 순환으로 되돌아올 때만 포함된다.
+ */
+export function computeReachability(
+	graph: SemanticGraph,
+	options: ReachabilityOptions = {},
+): Map<string, Set<string>> {
+	return new ReachabilityIndex(graph, {
+		...options,
+		incremental: false,
+	}).snapshot();
+}
+
+/**
+ * 도달 가능성 인덱스
+ *
+ * 그래프 엣지 변경은 이 인덱스의 addEdge/removeEdge를 통해야 반영된다.
+ * 증분 모드에서 엣지 추가는 출발 노드에 도달할 수 있는 노드들의 집합에
+ * 도착 노드의 집합을 합치고, 엣지 제거는 출발 노드에 도달할 수 있는
+ * 노드들의 집합만 다시 계산한다.
+ */
+export class ReachabilityIndex {
+	private graph: SemanticGraph;
+	private follows: (type: string) => boolean;
+	private incremental: boolean;
+	/** from -> to -> 따라가는 엣지 수 */
+	private adjacency = new Map<string, Map<string, number>>();
+	private reach = new Map<string, Set<string>>();
+
+	constructor(graph: SemanticGraph, options: ReachabilityOptions = {}) {
+		this.graph = graph;
+		const { edgeTypes } = options;
+		this.follows = (type) =>
+			edgeTypes ? edgeTypes.includes(type) : isDependencyEdge(type);
+		this.incremental = options.incremental ?? false;
+
+		for (const edge of graph.edges) {
+			this.link(edge);
+		}
+		this.recomputeAll();
+	}
+
+	/**
+	 * 노드에서 도달 가능한 노드 집합
+	 */
+	reachable(id: string): ReadonlySet<string> {
+		return this.reach.get(id) ?? new Set();
+	}
+
+	/**
+	 * from에서 to에 도달할 수 있는지 확인
+	 */
+	canReach(from: string, to: string): boolean {
+		return this.reachable(from).has(to);
+	}
+
+	/**
+	 * 전체 도달 가능 집합 복사본
+	 */
+	snapshot(): Map<string, Set<string>> {
+		return new Map(
+			Array.from(this.reach, ([id, targets]) => [id, new Set(targets)]),
+		);
+	}
+
+	/**
+	 * 그래프에 엣지를 추가하고 도달 가능성 갱신
+	 */
+	addEdge(edge: SemanticEdge): boolean {
+		if (!this.graph.addEdge(edge)) {
+			return false;
+		}
+		if (!this.follows(edge.type)) {
+			return true;
+		}
+
+		this.link(edge);
+		if (!this.incremental) {
+			this.recomputeAll();
+			return true;
+		}
+
+		const gained = new Set(this.reachable(edge.to));
+		gained.add(edge.to);
+		for (const source of this.sourcesReaching(edge.from)) {
+			const targets = this.reach.get(source) ?? new Set<string>();
+			for (const id of gained) targets.add(id);
+			this.reach.set(source, targets);
+		}
+		return true;
+	}
+
+	/**
+	 * 그래프에서 엣지를 제거하고 도달 가능성 갱신
+	 */
+	removeEdge(from: string, to: string, type: string): boolean {
+		if (!this.graph.removeEdge(from, to, type)) {
+			return false;
+		}
+		if (!this.follows(type)) {
+			return true;
+		}
+
+		this.unlink(from, to);
+		if (!this.incremental) {
+			this.recomputeAll();
+			return true;
+		}
+
+		for (const source of this.sourcesReaching(from)) {
+			this.reach.set(source, this.search(source));
+		}
+		return true;
+	}
+
+	/**
+	 * id 자신과 id에 도달할 수 있는 노드 (갱신 전 집합 기준)
+	 */
+	private sourcesReaching(id: string): string[] {
+		const sources = [id];
+		for (const [source, targets] of this.reach) {
+			if (source !== id && targets.has(id)) sources.push(source);
+		}
+		return sources;
+	}
+
+	private recomputeAll(): void {
+		this.reach.clear();
+		const ids = new Set([
+			...this.graph.nodes.keys(),
+			...this.adjacency.keys(),
+		]);
+		for (const id of ids) {
+			this.reach.set(id, this.search(id));
+		}
+	}
+
+	private search(start: string): Set<string> {
+		const visited = new Set<string>();
+		const stack = Array.from(this.adjacency.get(start)?.keys() ?? []);
+		while (stack.length > 0) {
+			const id = stack.pop() as string;
+			if (visited.has(id)) continue;
+			visited.add(id);
+			stack.push(...(this.adjacency.get(id)?.keys() ?? []));
+		}
+		return visited;
+	}
+
+	private link(edge: SemanticEdge): void {
+		if (!this.follows(edge.type)) return;
+		const targets = this.adjacency.get(edge.from) ?? new Map<string, number>();
+		targets.set(edge.to, (targets.get(edge.to) ?? 0) + 1);
+		this.adjacency.set(edge.from, targets);
+	}
+
+	private unlink(from: string, to: string): void {
+		const targets = this.adjacency.get(from);
+		const count = targets?.get(to) ?? 0;
+		if (!targets || count === 0) return;
+		if (count === 1) {
+			targets.delete(to);
+		} else {
+			targets.set(to, count - 1);
+		}
+	}
+}
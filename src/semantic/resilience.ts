@@ -0,0 +1,81 @@
+/**
+ * Resilience Policy Annotations
+ * @retry / @timeout 어노테이션을 구조화된 복원력 정책으로 변환
+ */
+
+import type { SemanticNode } from "./types";
+
+/**
+ * 심볼에 선언된 복원력 정책
+ */
+export interface ResiliencePolicy {
+	/** @retry 재시도 횟수 */
+	retries?: number;
+	/** @timeout 원본 값 (예: "5s") */
+	timeout?: string;
+	/** @timeout 밀리초 값 */
+	timeoutMs?: number;
+}
+
+const DURATION_UNITS: Record<string, number> = {
+	ns: 1e-6,
+	us: 1e-3,
+	"µs": 1e-3,
+	ms: 1,
+	s: 1000,
+	m: 60_000,
+	h: 3_600_000,
+};
+
+/**
+ * Go 형식 duration 문자열을 밀리초로 변환 (예: "5s", "1m30s", "250ms")
+ *
+ * 형식이 올바르지 않으면 undefined를 반환한다.
+ */
+export function parseDuration(value: string): number | undefined {
+	const trimmed = value.trim();
+	if (!/^(\d+(\.\d+)?(ns|us|µs|ms|s|m|h))+$/.test(trimmed)) {
+		return undefined;
+	}
+
+	let total = 0;
+	for (const match of trimmed.matchAll(/(\d+(?:\.\d+)?)(ns|us|µs|ms|s|m|h)/g)) {
+		total += Number(match[1]) * DURATION_UNITS[match[2]];
+	}
+	return total;
+}
+
+/**
+ * 어노테이션에서 복원력 정책 파싱 (정책 어노테이션이 없으면 undefined)
+ *
+ * 값이 올바르지 않은 어노테이션은 무시한다. 같은 어노테이션이
+ * 여러 번 있으면 첫 번째 값을 사용한다.
+ */
+export function parseResiliencePolicy(
+	annotations: Record<string, string[]>,
+): ResiliencePolicy | undefined {
+	const policy: ResiliencePolicy = {};
+
+	const retry = annotations.retry?.[0];
+	if (retry !== undefined && /^\d+$/.test(retry)) {
+		policy.retries = Number(retry);
+	}
+
+	const timeout = annotations.timeout?.[0];
+	const timeoutMs = timeout !== undefined ? parseDuration(timeout) : undefined;
+	if (timeout !== undefined && timeoutMs !== undefined) {
+		policy.timeout = timeout;
+		policy.timeoutMs = timeoutMs;
+	}
+
+	return Object.keys(policy).length > 0 ? policy : undefined;
+}
+
+/**
+ * 노드의 복원력 정책 조회 (metadata.resilience)
+ */
+export function getResiliencePolicy(
+	node: SemanticNode,
+): ResiliencePolicy | undefined {
+	return node.metadata.resilience as ResiliencePolicy | undefined;
+}
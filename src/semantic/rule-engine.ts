@@ -0,0 +1,84 @@
+/**
+ * Tag Rule Engine
+ * 등록된 태그 규칙을 심볼 그래프 한 번 순회로 모두 실행
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import { getEffectiveTags } from "./tags";
+import type { SemanticDiagnostic, SemanticNode } from "./types";
+
+/**
+ * 규칙이 심볼마다 받는 컨텍스트
+ */
+export interface TagRuleContext {
+	graph: SemanticGraph;
+	/** 상속을 포함한 유효 태그 (태그 → 출처 노드 ID) */
+	tags: Map<string, string>;
+}
+
+/**
+ * 태그 규칙
+ */
+export interface TagRule {
+	/** 규칙 ID (진단의 ruleId와 같을 필요는 없음) */
+	readonly id: string;
+	/** 심볼 하나를 검사해 위반 목록 반환 */
+	check(node: SemanticNode, context: TagRuleContext): SemanticDiagnostic[];
+}
+
+/**
+ * 태그 규칙 엔진
+ *
+ * 유효 태그는 심볼마다 한 번만 계산해 모든 규칙이 공유한다.
+ */
+export class RuleEngine {
+	private rules: TagRule[] = [];
+
+	constructor(rules: TagRule[] = []) {
+		for (const rule of rules) {
+			this.register(rule);
+		}
+	}
+
+	/**
+	 * 규칙 등록 (같은 ID는 예외)
+	 */
+	register(rule: TagRule): void {
+		if (this.rules.some((registered) => registered.id === rule.id)) {
+			throw new Error(`Tag rule already registered: ${rule.id}`);
+		}
+		this.rules.push(rule);
+	}
+
+	/**
+	 * 등록된 규칙 ID 목록
+	 */
+	getRuleIds(): string[] {
+		return this.rules.map((rule) => rule.id);
+	}
+
+	/**
+	 * 모든 심볼에 모든 규칙 실행 (심볼 순서, 같은 심볼은 등록 순서)
+	 */
+	run(graph: SemanticGraph): SemanticDiagnostic[] {
+		const diagnostics: SemanticDiagnostic[] = [];
+		if (this.rules.length === 0) {
+			return diagnostics;
+		}
+
+		for (const node of graph.nodes.values()) {
+			const context = { graph, tags: getEffectiveTags(graph, node) };
+			for (const rule of this.rules) {
+				diagnostics.push(...rule.check(node, context));
+			}
+		}
+		return diagnostics;
+	}
+}
+
+/**
+ * 규칙 엔진 팩토리 함수
+ */
+export function createRuleEngine(rules?: TagRule[]): RuleEngine {
+	return new RuleEngine(rules);
+}
@@ -0,0 +1,350 @@
+/**
+ * Semantic Graph Server
+ * 메모리에 올린 그래프를 조회하는 HTTP 엔드포인트 (포커스 모드, 쿼리, reload)
+ */
+
+import http from "node:http";
+import type { GraphSource } from "./graph-service";
+import { type ExportProjection, projectEdge, projectNode } from "./projection";
+import { SemanticQueryEngine } from "./SemanticQueryEngine";
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/** 한 번에 펼칠 수 있는 최대 홉 수 */
+export const MAX_NEIGHBOR_HOPS = 3;
+
+/**
+ * GET /node/{fqn}/neighbors 응답
+ */
+export interface NeighborsResponse {
+	node: Partial<SemanticNode>;
+	hops: number;
+	/** 이웃 노드 (ID 순, 페이지 단위) */
+	neighbors: Partial<SemanticNode>[];
+	/** 중심 노드와 현재 페이지 이웃 사이의 엣지 */
+	edges: Partial<SemanticEdge>[];
+	/** 다음 페이지 커서 */
+	nextCursor?: string;
+}
+
+/**
+ * 그래프 서버 옵션
+ */
+export interface GraphServerOptions {
+	/** 응답에 포함할 노드/엣지 속성 (생략 시 전체) */
+	projection?: ExportProjection;
+}
+
+type RequestHandler = (
+	request: http.IncomingMessage,
+	response: http.ServerResponse,
+) => void;
+
+/** 조회 경로별 처리 함수 (응답 본문 반환, 잘못된 요청은 HttpError) */
+type QueryRoute = (
+	url: URL,
+	engine: SemanticQueryEngine,
+	projection?: ExportProjection,
+) => unknown;
+
+const NEIGHBORS_ROUTE = /^\/node\/(.+)\/neighbors$/;
+
+/**
+ * 상태 코드가 있는 요청 오류
+ */
+class HttpError extends Error {
+	constructor(
+		readonly status: number,
+		message: string,
+	) {
+		super(message);
+	}
+}
+
+const QUERY_ROUTES: Record<string, QueryRoute> = {
+	"/find-by-tag": (url, engine) => ({
+		symbols: engine.findByTag(requiredParam(url, "tag"), {
+			caseInsensitive: flagParam(url, "caseInsensitive"),
+		}),
+	}),
+	"/find-by-name": (url, engine) => ({
+		symbols: engine.findByName(requiredParam(url, "pattern"), {
+			regex: flagParam(url, "regex"),
+			fqn: flagParam(url, "fqn"),
+		}),
+	}),
+	"/reverse-deps": (url, engine, projection) => {
+		const symbol = requiredParam(url, "symbol");
+		const entries = engine.reverseDeps(symbol, integerParam(url, "depth"));
+		return {
+			symbol,
+			dependents: entries.map((entry) => ({
+				node: projectNode(entry.node, projection),
+				depth: entry.depth,
+				via: entry.via,
+			})),
+		};
+	},
+	"/closure": (url, engine, projection) => {
+		const symbol = requiredParam(url, "symbol");
+		const depth = integerParam(url, "depth");
+		const entries = engine.closure(symbol, {
+			edgeTypes: listParam(url, "edgeTypes"),
+			maxDepth: depth === 0 ? undefined : depth,
+			includePaths: flagParam(url, "paths"),
+		});
+		return {
+			symbol,
+			dependencies: entries.map((entry) => ({
+				node: projectNode(entry.node, projection),
+				depth: entry.depth,
+				path: entry.path,
+			})),
+		};
+	},
+	"/path": (url, engine) => {
+		const from = requiredParam(url, "from");
+		const to = requiredParam(url, "to");
+		return {
+			from,
+			to,
+			paths: engine.paths(from, to, {
+				edgeTypes: listParam(url, "edgeTypes"),
+				all: flagParam(url, "all"),
+			}),
+		};
+	},
+};
+
+/**
+ * 그래프 조회 요청 핸들러 생성
+ *
+ * source가 GraphSource이면 요청마다 현재 그래프를 조회하므로 reload나
+ * 감시로 교체된 그래프가 바로 반영된다. 지원 경로:
+ * - GET /health
+ * - GET /node/{fqn}/neighbors?hops=1&limit=100&cursor=...
+ * - GET /find-by-tag?tag=...&caseInsensitive=true
+ * - GET /find-by-name?pattern=...&regex=true&fqn=true
+ * - GET /reverse-deps?symbol=...&depth=0
+ * - GET /closure?symbol=...&depth=0&edgeTypes=calls,references&paths=true
+ * - GET /path?from=...&to=...&edgeTypes=...&all=true
+ * - POST /reload (source가 reload를 지원할 때)
+ *
+ * 불리언 파라미터는 true/false, depth 0은 제한 없음이다.
+ */
+export function createGraphRequestHandler(
+	source: SemanticGraph | GraphSource,
+	options: GraphServerOptions = {},
+): RequestHandler {
+	const { projection } = options;
+	const graphSource: GraphSource =
+		source instanceof SemanticGraph ? { graph: source } : source;
+
+	// 그래프가 교체될 때만 쿼리 엔진을 새로 만든다
+	let engineGraph = graphSource.graph;
+	let engine = new SemanticQueryEngine(engineGraph);
+	const currentEngine = () => {
+		if (engineGraph !== graphSource.graph) {
+			engineGraph = graphSource.graph;
+			engine = new SemanticQueryEngine(engineGraph);
+		}
+		return engine;
+	};
+
+	return (request, response) => {
+		const url = new URL(request.url ?? "/", "http://localhost");
+
+		if (url.pathname === "/reload") {
+			if (request.method !== "POST") {
+				sendMethodNotAllowed(response, request.method);
+				return;
+			}
+			handleReload(graphSource, response);
+			return;
+		}
+
+		const route = QUERY_ROUTES[url.pathname];
+		const match = url.pathname.match(NEIGHBORS_ROUTE);
+		if (!route && !match && url.pathname !== "/health") {
+			sendJson(response, 404, { error: `Not found: ${url.pathname}` });
+			return;
+		}
+		if (request.method !== "GET") {
+			sendMethodNotAllowed(response, request.method);
+			return;
+		}
+
+		const graph = graphSource.graph;
+		if (url.pathname === "/health") {
+			sendJson(response, 200, {
+				status: "ok",
+				nodes: graph.nodes.size,
+				edges: graph.edges.length,
+			});
+			return;
+		}
+
+		try {
+			const body = route
+				? route(url, currentEngine(), projection)
+				: queryNeighbors(
+						url,
+						currentEngine(),
+						graph,
+						decodeURIComponent((match as RegExpMatchArray)[1]),
+						projection,
+					);
+			sendJson(response, 200, body);
+		} catch (error) {
+			const message = (error as Error).message;
+			const status =
+				error instanceof HttpError
+					? error.status
+					: message.startsWith("Unknown symbol:")
+						? 404
+						: 400;
+			sendJson(response, status, { error: message });
+		}
+	};
+}
+
+/**
+ * 그래프 조회 HTTP 서버 생성 (listen은 호출자가 수행)
+ */
+export function createGraphServer(
+	source: SemanticGraph | GraphSource,
+	options?: GraphServerOptions,
+): http.Server {
+	return http.createServer(createGraphRequestHandler(source, options));
+}
+
+function queryNeighbors(
+	url: URL,
+	engine: SemanticQueryEngine,
+	graph: SemanticGraph,
+	fqn: string,
+	projection?: ExportProjection,
+): NeighborsResponse {
+	const node = graph.getNode(fqn) ?? findByFqn(graph, fqn);
+	if (!node) {
+		throw new HttpError(404, `Node not found: ${fqn}`);
+	}
+
+	const hops = Number(url.searchParams.get("hops") ?? "1");
+	if (!Number.isInteger(hops) || hops < 1 || hops > MAX_NEIGHBOR_HOPS) {
+		throw new HttpError(
+			400,
+			`hops must be an integer between 1 and ${MAX_NEIGHBOR_HOPS}`,
+		);
+	}
+
+	const limit = url.searchParams.get("limit");
+	const result = engine.queryNeighbors(node.id, hops, {
+		cursor: url.searchParams.get("cursor") ?? undefined,
+		limit: limit !== null ? Number(limit) : undefined,
+	});
+
+	const visible = new Set([node.id, ...result.items.map((n) => n.id)]);
+	return {
+		node: projectNode(node, projection),
+		hops,
+		neighbors: result.items.map((n) => projectNode(n, projection)),
+		edges: graph.edges
+			.filter((edge) => visible.has(edge.from) && visible.has(edge.to))
+			.map((edge) => projectEdge(edge, projection)),
+		nextCursor: result.nextCursor,
+	};
+}
+
+/**
+ * 그래프를 다시 읽고 새 그래프 크기를 응답 (실패하면 이전 그래프 유지)
+ */
+function handleReload(
+	source: GraphSource,
+	response: http.ServerResponse,
+): void {
+	if (!source.reload) {
+		sendJson(response, 501, { error: "Reload is not supported" });
+		return;
+	}
+	source.reload().then(
+		(graph) =>
+			sendJson(response, 200, {
+				status: "reloaded",
+				nodes: graph.nodes.size,
+				edges: graph.edges.length,
+			}),
+		(error: Error) =>
+			sendJson(response, 500, { error: `Reload failed: ${error.message}` }),
+	);
+}
+
+function requiredParam(url: URL, name: string): string {
+	const value = url.searchParams.get(name);
+	if (value === null || value === "") {
+		throw new HttpError(400, `Missing query parameter: ${name}`);
+	}
+	return value;
+}
+
+function flagParam(url: URL, name: string): boolean {
+	const value = url.searchParams.get(name);
+	if (value === null || value === "false") return false;
+	if (value === "" || value === "true") return true;
+	throw new HttpError(400, `Invalid ${name}: ${value}`);
+}
+
+/**
+ * 0 이상의 정수 파라미터 (생략 시 0)
+ */
+function integerParam(url: URL, name: string): number {
+	const value = url.searchParams.get(name);
+	if (value === null) return 0;
+	const parsed = Number(value);
+	if (value === "" || !Number.isInteger(parsed) || parsed < 0) {
+		throw new HttpError(400, `Invalid ${name}: ${value}`);
+	}
+	return parsed;
+}
+
+/**
+ * 쉼표로 구분한 목록 파라미터 (생략 시 undefined)
+ */
+function listParam(url: URL, name: string): string[] | undefined {
+	const value = url.searchParams.get(name);
+	if (value === null) return undefined;
+	const items = value
+		.split(",")
+		.map((item) => item.trim())
+		.filter(Boolean);
+	if (items.length === 0) {
+		throw new HttpError(400, `Invalid ${name}: ${value}`);
+	}
+	return items;
+}
+
+function sendMethodNotAllowed(
+	response: http.ServerResponse,
+	method: string | undefined,
+): void {
+	sendJson(response, 405, { error: `Method not allowed: ${method}` });
+}
+
+function findByFqn(
+	graph: SemanticGraph,
+	fqn: string,
+): SemanticNode | undefined {
+	for (const node of graph.nodes.values()) {
+		if (node.fqn === fqn) return node;
+	}
+	return undefined;
+}
+
+function sendJson(
+	response: http.ServerResponse,
+	status: number,
+	body: unknown,
+): void {
+	response.writeHead(status, { "Content-Type": "application/json" });
+	response.end(JSON.stringify(body));
+}
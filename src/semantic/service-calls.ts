@@ -0,0 +1,111 @@
+/**
+ * Service Call Linking
+ * HTTP 클라이언트 호출의 대상 호스트를 service 노드와 연결
+ */
+
+import { resolveStringValue } from "./constants";
+import { getGoPackageId } from "./extractors/GoExtractor";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { CallSite } from "./types";
+
+/**
+ * HTTP 클라이언트 호출 설정
+ */
+export interface HttpClientConfig {
+	/** 호출 대상 → URL 인자 위치 (예: { "http.Get": 0 }) */
+	functions: Record<string, number>;
+}
+
+export const DEFAULT_HTTP_CLIENT_CONFIG: HttpClientConfig = {
+	functions: {
+		"http.Get": 0,
+		"http.Head": 0,
+		"http.Post": 0,
+		"http.PostForm": 0,
+		"http.NewRequest": 1,
+		"http.NewRequestWithContext": 2,
+	},
+};
+
+/**
+ * service 노드 ID
+ */
+export function serviceNodeId(host: string): string {
+	return `service:${host}`;
+}
+
+/**
+ * URL 문자열에서 호스트 추출 (절대 URL이 아니면 undefined)
+ */
+export function parseServiceHost(url: string): string | undefined {
+	try {
+		const { host } = new URL(url);
+		return host || undefined;
+	} catch {
+		return undefined;
+	}
+}
+
+/**
+ * HTTP 클라이언트 호출을 찾아 service 노드와 "calls-service" 엣지 추가
+ *
+ * URL 인자는 리터럴과 상수를 해석하며, 호스트 단위로 service 노드를 만든다.
+ * 해석하지 못한 호출은 호출 함수의 metadata.unresolvedServiceCalls에
+ * 원본 식으로 남긴다. 추가된 service 노드 수를 반환한다.
+ */
+export function linkServiceCalls(
+	graph: SemanticGraph,
+	config: HttpClientConfig = DEFAULT_HTTP_CLIENT_CONFIG,
+): number {
+	let created = 0;
+
+	for (const node of Array.from(graph.nodes.values())) {
+		const callSites = node.metadata.callSites as CallSite[] | undefined;
+		if (!callSites) continue;
+
+		const unresolved: string[] = [];
+		for (const site of callSites) {
+			if (
+				!Object.prototype.hasOwnProperty.call(config.functions, site.callee)
+			) {
+				continue;
+			}
+
+			const expression = site.arguments[config.functions[site.callee]];
+			if (expression === undefined) continue;
+
+			const url = resolveStringValue(graph, expression, getGoPackageId(node));
+			const host = url === undefined ? undefined : parseServiceHost(url);
+			if (host === undefined) {
+				unresolved.push(expression);
+				continue;
+			}
+
+			const id = serviceNodeId(host);
+			if (!graph.hasNode(id)) {
+				graph.addNode({
+					id,
+					fqn: id,
+					name: host,
+					kind: "service",
+					filePath: node.filePath,
+					semanticTags: [],
+					metadata: {},
+				});
+				created++;
+			}
+			graph.addEdge({
+				from: node.id,
+				to: id,
+				type: "calls-service",
+				metadata: { line: site.line, url },
+			});
+		}
+
+		if (unresolved.length > 0) {
+			node.metadata.unresolvedServiceCalls = unresolved;
+		}
+	}
+
+	return created;
+}
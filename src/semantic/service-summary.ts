@@ -0,0 +1,193 @@
+/**
+ * Service Summary
+ * 동사 태그(create-method, read-method 등)로 서비스 메서드를 묶은 API 요약
+ */
+
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 서비스 요약 옵션
+ */
+export interface ServiceSummaryOptions {
+	/** 동사 태그 패턴 (첫 번째 캡처 그룹이 분류 이름, 기본: /^(\w+)-method$/) */
+	verbPattern?: RegExp;
+}
+
+/**
+ * 서비스 메서드 하나
+ */
+export interface ServiceOperation {
+	id: string;
+	name: string;
+	/** 동사 태그에서 얻은 분류 (태그가 없으면 빈 배열) */
+	categories: string[];
+	parameters: Array<{ name: string; type: string }>;
+	results: string[];
+	filePath: string;
+	line?: number;
+	description?: string;
+}
+
+/**
+ * 분류 하나에 속한 메서드
+ */
+export interface ServiceCategory {
+	/** 분류 이름 (동사 태그가 없는 메서드는 "other") */
+	category: string;
+	operations: ServiceOperation[];
+}
+
+/**
+ * 서비스(리시버 타입) 하나의 요약
+ */
+export interface ServiceSummary {
+	/** 서비스 타입 노드 ID */
+	service: string;
+	name: string;
+	filePath: string;
+	description?: string;
+	categories: ServiceCategory[];
+}
+
+/** 동사 태그가 없는 메서드의 분류 */
+export const UNCATEGORIZED = "other";
+
+/** 다른 분류보다 먼저 나오는 CRUD 분류 */
+const CRUD_ORDER = ["create", "read", "update", "delete"];
+
+/**
+ * 동사 태그가 붙은 메서드가 하나 이상인 서비스의 API 요약 (서비스 ID 순)
+ *
+ * 서비스의 모든 메서드를 분류별로 나열하며, 동사 태그가 여러 개인 메서드는
+ * 각 분류에 모두 나오고 태그가 없는 메서드는 "other"에 모인다. 분류는
+ * CRUD 순서, 나머지 이름 순, "other" 순이며 분류 안의 메서드는 라인 순이다.
+ * 매개변수와 반환 타입은 추출기가 기록한 metadata.parameters/results를 쓴다.
+ */
+export function summarizeServices(
+	graph: SemanticGraph,
+	options: ServiceSummaryOptions = {},
+): ServiceSummary[] {
+	const verbPattern = options.verbPattern ?? /^(\w+)-method$/;
+	const summaries: ServiceSummary[] = [];
+
+	for (const service of graph.nodes.values()) {
+		const methods = graph
+			.getOutgoingEdges(service.id, ["contains"])
+			.map((edge) => graph.getNode(edge.to))
+			.filter((node): node is SemanticNode => node?.kind === "method");
+		const operations: ServiceOperation[] = methods.map((method) => {
+			const categories: string[] = [];
+			for (const tag of method.semanticTags) {
+				const category = tag.match(verbPattern)?.[1];
+				if (category && !categories.includes(category)) {
+					categories.push(category);
+				}
+			}
+			return {
+				id: method.id,
+				name: method.name,
+				categories,
+				parameters: method.metadata.parameters ?? [],
+				results: method.metadata.results ?? [],
+				filePath: method.filePath,
+				line: method.line,
+				description: method.description,
+			};
+		});
+		if (!operations.some((operation) => operation.categories.length > 0)) {
+			continue;
+		}
+
+		const grouped = new Map<string, ServiceOperation[]>();
+		for (const operation of operations.sort(
+			(a, b) => (a.line ?? 0) - (b.line ?? 0),
+		)) {
+			const categories =
+				operation.categories.length > 0
+					? operation.categories
+					: [UNCATEGORIZED];
+			for (const category of categories) {
+				const list = grouped.get(category) ?? [];
+				list.push(operation);
+				grouped.set(category, list);
+			}
+		}
+
+		summaries.push({
+			service: service.id,
+			name: service.name,
+			filePath: service.filePath,
+			description: service.description,
+			categories: Array.from(grouped.keys())
+				.sort(compareCategories)
+				.map((category) => ({
+					category,
+					operations: grouped.get(category) ?? [],
+				})),
+		});
+	}
+
+	return summaries.sort((a, b) =>
+		a.service < b.service ? -1 : a.service > b.service ? 1 : 0,
+	);
+}
+
+/**
+ * 서비스 요약을 Markdown으로 렌더링
+ */
+export function renderServiceSummaryMarkdown(
+	summaries: ServiceSummary[],
+): string {
+	const lines = ["# Service Summary"];
+	if (summaries.length === 0) {
+		lines.push("", "_No tagged service methods._");
+	}
+
+	for (const summary of summaries) {
+		lines.push("", `## ${summary.name}`, "");
+		if (summary.description) {
+			lines.push(summary.description, "");
+		}
+		lines.push(`\`${summary.service}\` (${summary.filePath})`);
+
+		for (const { category, operations } of summary.categories) {
+			lines.push("", `### ${category}`, "");
+			for (const operation of operations) {
+				const description = operation.description
+					? ` - ${operation.description}`
+					: "";
+				lines.push(`- \`${formatOperation(operation)}\`${description}`);
+			}
+		}
+	}
+
+	return `${lines.join("\n")}\n`;
+}
+
+/**
+ * 메서드 형태 (예: "GetUser(ctx context.Context, id int64) (*User, error)")
+ */
+export function formatOperation(operation: ServiceOperation): string {
+	const parameters = operation.parameters
+		.map((parameter) =>
+			parameter.name ? `${parameter.name} ${parameter.type}` : parameter.type,
+		)
+		.join(", ");
+	const results =
+		operation.results.length === 0
+			? ""
+			: operation.results.length === 1
+				? ` ${operation.results[0]}`
+				: ` (${operation.results.join(", ")})`;
+	return `${operation.name}(${parameters})${results}`;
+}
+
+function compareCategories(a: string, b: string): number {
+	const rank = (category: string) => {
+		if (category === UNCATEGORIZED) return CRUD_ORDER.length + 1;
+		const index = CRUD_ORDER.indexOf(category);
+		return index >= 0 ? index : CRUD_ORDER.length;
+	};
+	return rank(a) - rank(b) || (a < b ? -1 : a > b ? 1 : 0);
+}
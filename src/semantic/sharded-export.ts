@@ -0,0 +1,214 @@
+/**
+ * Sharded Graph Export
+ * 패키지 단위 JSON 샤드 + 인덱스 파일로 그래프를 내보내는 증분 익스포트
+ */
+
+import * as crypto from "node:crypto";
+import * as fs from "node:fs/promises";
+import * as path from "node:path";
+import { getNodePackage } from "./component-grouping";
+import { type KindMergeMap, mergeKinds } from "./kind-merge";
+import { computeLayers } from "./layout";
+import { type ExportProjection, projectEdge, projectNode } from "./projection";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "./types";
+
+/** 인덱스 파일 이름 */
+export const SHARD_INDEX_FILE = "index.json";
+
+/**
+ * 인덱스에 기록되는 샤드 정보
+ */
+export interface ShardEntry {
+	/** 출력 디렉토리 기준 샤드 파일 경로 */
+	file: string;
+	/** 샤드 내용 해시 (변경 감지용) */
+	hash: string;
+	nodeCount: number;
+	edgeCount: number;
+}
+
+/**
+ * 샤드 인덱스 파일 형식
+ */
+export interface ShardIndex {
+	version: 1;
+	/** 패키지 경로 -> 샤드 정보 */
+	shards: Record<string, ShardEntry>;
+}
+
+/**
+ * 증분 익스포트 결과 (출력 디렉토리 기준 경로)
+ */
+export interface ShardExportResult {
+	/** 새로 쓰거나 다시 쓴 파일 (인덱스 포함) */
+	written: string[];
+	/** 변경이 없어 건너뛴 샤드 */
+	unchanged: string[];
+	/** 패키지가 사라져 삭제한 샤드 */
+	removed: string[];
+}
+
+/**
+ * 샤드 익스포트 옵션
+ */
+export interface ShardExportOptions {
+	/** 샤드에 기록할 노드/엣지 속성 (생략 시 전체) */
+	projection?: ExportProjection;
+	/** 노드마다 계층 배치 힌트(layer 속성) 추가 */
+	layout?: boolean;
+	/** 상위 노드로 병합해 내보내지 않을 노드 종류 */
+	kindMerge?: KindMergeMap;
+}
+
+/**
+ * 그래프를 패키지별 JSON 샤드로 내보내기
+ *
+ * 노드는 선언 파일의 패키지 샤드에, 엣지는 출발 노드의 샤드에 기록된다.
+ * 이전 인덱스의 해시와 비교해 내용이 바뀐 샤드만 다시 쓰며,
+ * 하나라도 바뀐 경우에만 인덱스 파일을 다시 쓴다.
+ * 분류와 정렬은 프로젝션 전의 전체 속성 기준으로 수행한다.
+ */
+export async function exportShards(
+	source: SemanticGraph,
+	outputDir: string,
+	options: ShardExportOptions = {},
+): Promise<ShardExportResult> {
+	const graph = options.kindMerge
+		? mergeKinds(source, options.kindMerge)
+		: source;
+	const result: ShardExportResult = { written: [], unchanged: [], removed: [] };
+	const previous = await readShardIndex(outputDir);
+	const next: ShardIndex = { version: 1, shards: {} };
+	const layers = options.layout ? computeLayers(graph) : undefined;
+
+	for (const [pkg, shard] of collectShards(graph)) {
+		const projected = {
+			package: shard.package,
+			nodes: shard.nodes.map((node) =>
+				projectNode(
+					layers ? { ...node, layer: layers.get(node.id) } : node,
+					options.projection,
+				),
+			),
+			edges: shard.edges.map((edge) => projectEdge(edge, options.projection)),
+		};
+		const content = `${JSON.stringify(projected, null, 2)}\n`;
+		const entry: ShardEntry = {
+			file: shardFileName(pkg),
+			hash: crypto.createHash("sha256").update(content).digest("hex"),
+			nodeCount: shard.nodes.length,
+			edgeCount: shard.edges.length,
+		};
+		next.shards[pkg] = entry;
+
+		const filePath = path.join(outputDir, entry.file);
+		const previousHash = previous?.shards[pkg]?.hash;
+		if (previousHash === entry.hash && (await exists(filePath))) {
+			result.unchanged.push(entry.file);
+			continue;
+		}
+
+		await fs.mkdir(path.dirname(filePath), { recursive: true });
+		await fs.writeFile(filePath, content, "utf-8");
+		result.written.push(entry.file);
+	}
+
+	for (const [pkg, entry] of Object.entries(previous?.shards ?? {})) {
+		if (next.shards[pkg]) continue;
+		await fs.rm(path.join(outputDir, entry.file), { force: true });
+		result.removed.push(entry.file);
+	}
+
+	if (!previous || result.written.length > 0 || result.removed.length > 0) {
+		await fs.mkdir(outputDir, { recursive: true });
+		await fs.writeFile(
+			path.join(outputDir, SHARD_INDEX_FILE),
+			`${JSON.stringify(next, null, 2)}\n`,
+			"utf-8",
+		);
+		result.written.push(SHARD_INDEX_FILE);
+	}
+
+	return result;
+}
+
+/**
+ * 출력 디렉토리의 인덱스 파일 읽기 (없으면 undefined)
+ */
+export async function readShardIndex(
+	outputDir: string,
+): Promise<ShardIndex | undefined> {
+	try {
+		const content = await fs.readFile(
+			path.join(outputDir, SHARD_INDEX_FILE),
+			"utf-8",
+		);
+		return JSON.parse(content) as ShardIndex;
+	} catch (error) {
+		if ((error as NodeJS.ErrnoException).code === "ENOENT") {
+			return undefined;
+		}
+		throw error;
+	}
+}
+
+interface Shard {
+	package: string;
+	nodes: SemanticNode[];
+	edges: SemanticEdge[];
+}
+
+/**
+ * 패키지별 노드/엣지 분류 (패키지, 노드, 엣지 모두 정렬된 순서)
+ */
+function collectShards(graph: SemanticGraph): Map<string, Shard> {
+	const shards = new Map<string, Shard>();
+
+	const nodes = Array.from(graph.nodes.values()).sort((a, b) =>
+		a.id < b.id ? -1 : a.id > b.id ? 1 : 0,
+	);
+	for (const node of nodes) {
+		const pkg = getNodePackage(node);
+		let shard = shards.get(pkg);
+		if (!shard) {
+			shard = { package: pkg, nodes: [], edges: [] };
+			shards.set(pkg, shard);
+		}
+		shard.nodes.push(node);
+	}
+
+	for (const edge of graph.edges) {
+		const from = graph.getNode(edge.from);
+		if (!from) continue;
+		shards.get(getNodePackage(from))?.edges.push(edge);
+	}
+
+	for (const shard of shards.values()) {
+		shard.edges.sort((a, b) => {
+			const left = `${a.from}\0${a.to}\0${a.type}`;
+			const right = `${b.from}\0${b.to}\0${b.type}`;
+			return left < right ? -1 : left > right ? 1 : 0;
+		});
+	}
+
+	const packages = Array.from(shards.keys()).sort();
+	return new Map(packages.map((pkg) => [pkg, shards.get(pkg) as Shard]));
+}
+
+/**
+ * 패키지 경로를 샤드 파일 이름으로 변환
+ */
+function shardFileName(pkg: string): string {
+	const slug = pkg === "." ? "_root" : pkg.replace(/[\\/]/g, "__");
+	return path.posix.join("packages", `${slug}.json`);
+}
+
+async function exists(filePath: string): Promise<boolean> {
+	try {
+		await fs.access(filePath);
+		return true;
+	} catch {
+		return false;
+	}
+}
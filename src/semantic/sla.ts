@@ -0,0 +1,57 @@
+/**
+ * SLA Tier Annotations
+ * @sla 어노테이션으로 선언된 서비스 수준 등급
+ */
+
+import type { SemanticNode } from "./types";
+
+/** 기본 SLA 등급 (낮은 등급 → 높은 등급 순) */
+export const DEFAULT_SLA_TIERS = ["bronze", "silver", "gold", "platinum"];
+
+/**
+ * 심볼에 선언된 SLA
+ */
+export interface SlaPolicy {
+	/** tier= 값 (소문자) */
+	tier: string;
+	/** tier 외의 key=value 옵션 (예: availability=99.9) */
+	options: Record<string, string>;
+}
+
+/**
+ * 어노테이션에서 SLA 파싱 (@sla tier=...가 없으면 undefined)
+ *
+ * 예: `@sla tier=gold availability=99.95`
+ */
+export function parseSlaPolicy(
+	annotations: Record<string, string[]>,
+): SlaPolicy | undefined {
+	const values = annotations.sla;
+	if (!values) {
+		return undefined;
+	}
+
+	let tier: string | undefined;
+	const options: Record<string, string> = {};
+	for (const pair of values.join(" ").split(/\s+/)) {
+		const separator = pair.indexOf("=");
+		if (separator <= 0) continue;
+
+		const name = pair.slice(0, separator);
+		const value = pair.slice(separator + 1);
+		if (name === "tier") {
+			tier = value.toLowerCase();
+		} else {
+			options[name] = value;
+		}
+	}
+
+	return tier ? { tier, options } : undefined;
+}
+
+/**
+ * 노드의 SLA 조회 (metadata.sla)
+ */
+export function getSlaPolicy(node: SemanticNode): SlaPolicy | undefined {
+	return node.metadata.sla as SlaPolicy | undefined;
+}
@@ -0,0 +1,113 @@
+/**
+ * Source Ranges
+ * tree-sitter 노드 위치를 UTF-8 바이트 기준 소스 범위로 변환
+ */
+
+import type Parser from "tree-sitter";
+import type { SemanticNode, SourceRange } from "./types";
+
+interface LineTable {
+	sourceCode: string;
+	/** 라인 시작 위치 (문자열 인덱스) */
+	starts: number[];
+	/** 라인 시작 위치 (UTF-8 바이트) */
+	startBytes: number[];
+}
+
+// 추출기는 한 파일의 노드를 연달아 변환하므로 마지막 소스의 표만 보관
+let lastTable: LineTable | undefined;
+
+/**
+ * tree-sitter 노드의 소스 범위 (SourceRange의 인코딩 설명 참고)
+ *
+ * Node 바인딩의 startIndex/endIndex는 UTF-16 문자열 인덱스이므로 라인
+ * 표를 이용해 UTF-8 바이트로 변환한다. sourceCode는 파싱한 소스와 같아야 한다.
+ */
+export function getSourceRange(
+	sourceCode: string,
+	node: Parser.SyntaxNode,
+): SourceRange {
+	return getTextRange(sourceCode, node.startIndex, node.endIndex);
+}
+
+/**
+ * 문자열 인덱스 구간의 소스 범위 (구문 트리 없이 추출하는 언어용)
+ */
+export function getTextRange(
+	sourceCode: string,
+	startIndex: number,
+	endIndex: number,
+): SourceRange {
+	const table = getLineTable(sourceCode);
+	const start = toBytePosition(table, startIndex);
+	const end = toBytePosition(table, endIndex);
+	return {
+		startByte: start.byte,
+		endByte: end.byte,
+		startLine: start.line,
+		startColumn: start.column,
+		endLine: end.line,
+		endColumn: end.column,
+	};
+}
+
+/**
+ * 심볼 노드에 선언 범위와 이름 토큰 범위 기록
+ */
+export function setSourceRanges(
+	target: SemanticNode,
+	sourceCode: string,
+	declaration: Parser.SyntaxNode,
+	nameNode?: Parser.SyntaxNode | null,
+): void {
+	target.range = getSourceRange(sourceCode, declaration);
+	if (nameNode) {
+		target.nameRange = getSourceRange(sourceCode, nameNode);
+	}
+}
+
+function getLineTable(sourceCode: string): LineTable {
+	if (lastTable?.sourceCode === sourceCode) {
+		return lastTable;
+	}
+
+	const starts = [0];
+	const startBytes = [0];
+	let index = sourceCode.indexOf("\n");
+	while (index !== -1) {
+		const previous = starts[starts.length - 1];
+		startBytes.push(
+			startBytes[startBytes.length - 1] +
+				Buffer.byteLength(sourceCode.slice(previous, index + 1), "utf-8"),
+		);
+		starts.push(index + 1);
+		index = sourceCode.indexOf("\n", index + 1);
+	}
+
+	lastTable = { sourceCode, starts, startBytes };
+	return lastTable;
+}
+
+function toBytePosition(
+	table: LineTable,
+	index: number,
+): { byte: number; line: number; column: number } {
+	// index 이하인 마지막 라인 시작 (이진 탐색)
+	let low = 0;
+	let high = table.starts.length - 1;
+	while (low < high) {
+		const middle = (low + high + 1) >> 1;
+		if (table.starts[middle] <= index) low = middle;
+		else high = middle - 1;
+	}
+
+	const column = Buffer.byteLength(
+		table.sourceCode.slice(table.starts[low], index),
+		"utf-8",
+	);
+	return {
+		byte: table.startBytes[low] + column,
+		line: low + 1,
+		column: column + 1,
+	};
+}
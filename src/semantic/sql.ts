@@ -0,0 +1,179 @@
+/**
+ * SQL Statement Extraction
+ * 함수 호출 인자의 SQL 문자열을 찾아 문장 종류와 대상 테이블 추출
+ */
+
+import { resolveStringValue } from "./constants";
+import { getGoPackageId } from "./extractors/GoExtractor";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { CallSite, SemanticNode } from "./types";
+
+/**
+ * 호출 인자에서 찾은 SQL 문장
+ */
+export interface SqlStatement {
+	/** 문장 종류 (대문자, 예: "INSERT", "SELECT") */
+	verb: string;
+	/** 대상 테이블 (찾지 못하면 undefined) */
+	table?: string;
+	/** 충돌 시 갱신/무시하는 upsert 형태인지 여부 */
+	upsert: boolean;
+	/** 해석된 SQL 원문 */
+	text: string;
+	/** SQL을 전달한 호출 대상 (예: "db.ExecContext") */
+	callee: string;
+	/** 호출 라인 번호 */
+	line: number;
+}
+
+const SQL_VERB =
+	/^\s*(SELECT|INSERT|UPDATE|DELETE|REPLACE|MERGE|UPSERT|WITH)\b/i;
+
+const TABLE_PATTERNS: Record<string, RegExp> = {
+	INSERT: /\bINTO\s+([\w."`]+)/i,
+	REPLACE: /\bINTO\s+([\w."`]+)/i,
+	MERGE: /\bINTO\s+([\w."`]+)/i,
+	UPSERT: /\bINTO\s+([\w."`]+)/i,
+	UPDATE: /^\s*UPDATE\s+([\w."`]+)/i,
+	DELETE: /\bFROM\s+([\w."`]+)/i,
+	SELECT: /\bFROM\s+([\w."`]+)/i,
+};
+
+const UPSERT_PATTERNS = [
+	/\bON\s+CONFLICT\b/i,
+	/\bON\s+DUPLICATE\s+KEY\s+UPDATE\b/i,
+	/^\s*INSERT\s+OR\s+(REPLACE|IGNORE)\b/i,
+	/^\s*INSERT\s+IGNORE\b/i,
+];
+
+/**
+ * SQL 문자열 파싱 (SQL 문장이 아니면 undefined)
+ */
+export function parseSqlStatement(
+	text: string,
+): Pick<SqlStatement, "verb" | "table" | "upsert"> | undefined {
+	const match = text.match(SQL_VERB);
+	if (!match) return undefined;
+
+	const verb = match[1].toUpperCase();
+	const table = TABLE_PATTERNS[verb]?.exec(text)?.[1].replace(/["`]/g, "");
+	const upsert =
+		verb === "REPLACE" ||
+		verb === "MERGE" ||
+		verb === "UPSERT" ||
+		(verb === "INSERT" &&
+			UPSERT_PATTERNS.some((pattern) => pattern.test(text)));
+
+	return { verb, table, upsert };
+}
+
+/**
+ * 함수 본문의 호출 인자에서 SQL 문장 추출
+ *
+ * 인자는 리터럴과 상수를 해석하며, SQL 키워드로 시작하는 문자열만 문장으로 본다.
+ */
+export function extractSqlStatements(
+	graph: SemanticGraph,
+	node: SemanticNode,
+): SqlStatement[] {
+	const callSites = node.metadata.callSites as CallSite[] | undefined;
+	if (!callSites) return [];
+
+	const statements: SqlStatement[] = [];
+	for (const site of callSites) {
+		for (const argument of site.arguments) {
+			const text = resolveStringValue(graph, argument, getGoPackageId(node));
+			const parsed = text === undefined ? undefined : parseSqlStatement(text);
+			if (text === undefined || !parsed) continue;
+
+			statements.push({
+				...parsed,
+				text,
+				callee: site.callee,
+				line: site.line,
+			});
+		}
+	}
+	return statements;
+}
+
+/** 바로 뒤에 테이블 이름이 오는 키워드 */
+const TABLE_KEYWORDS = new Set(["FROM", "JOIN", "INTO", "UPDATE"]);
+
+/** 테이블 이름이나 별칭으로 보지 않는 SQL 키워드 */
+const SQL_KEYWORDS = new Set([
+	"AS",
+	"CROSS",
+	"DEFAULT",
+	"FROM",
+	"FULL",
+	"GROUP",
+	"HAVING",
+	"INNER",
+	"JOIN",
+	"LEFT",
+	"LIMIT",
+	"OFFSET",
+	"ON",
+	"ORDER",
+	"OUTER",
+	"RETURNING",
+	"RIGHT",
+	"SELECT",
+	"SET",
+	"UNION",
+	"USING",
+	"VALUES",
+	"WHERE",
+]);
+
+const SQL_TOKEN =
+	/--[^\n]*|\/\*[\s\S]*?\*\/|'(?:[^']|'')*'|"[^"]*"|`[^`]*`|[\w.]+|\S/g;
+
+/**
+ * table 노드 ID
+ */
+export function tableNodeId(table: string): string {
+	return `table:${table}`;
+}
+
+/**
+ * SQL 문자열에서 참조하는 테이블 이름 수집 (등장 순, 중복 제거)
+ *
+ * 간단한 토크나이저로 주석과 문자열 리터럴을 건너뛰고 FROM, JOIN, INTO,
+ * UPDATE 뒤의 식별자를 테이블로 본다. `FROM a, b` 같은 쉼표 목록과 별칭도
+ * 처리하며, 괄호로 시작하는 서브쿼리는 안쪽 키워드에서 다시 찾는다.
+ */
+export function collectSqlTables(text: string): string[] {
+	const tokens = (text.match(SQL_TOKEN) ?? []).filter(
+		(token) =>
+			!token.startsWith("--") &&
+			!token.startsWith("/*") &&
+			!token.startsWith("'"),
+	);
+
+	const tables: string[] = [];
+	for (let i = 0; i < tokens.length; i++) {
+		if (!TABLE_KEYWORDS.has(tokens[i].toUpperCase())) continue;
+
+		let j = i + 1;
+		while (j < tokens.length && tokens[j] !== "(") {
+			const name = tokens[j++].replace(/["`]/g, "");
+			if (!isIdentifier(name)) break;
+			if (!tables.includes(name)) tables.push(name);
+
+			// 별칭 건너뛰기 (`users u`, `users AS u`)
+			if (tokens[j]?.toUpperCase() === "AS") j++;
+			if (tokens[j] !== undefined && isIdentifier(tokens[j])) j++;
+			if (tokens[j] !== ",") break;
+			j++;
+		}
+	}
+	return tables;
+}
+
+function isIdentifier(token: string): boolean {
+	return (
+		/^[A-Za-z_][\w.]*$/.test(token) && !SQL_KEYWORDS.has(token.toUpperCase())
+	);
+}
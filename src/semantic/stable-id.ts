@@ -0,0 +1,121 @@
+/**
+ * Stable Node IDs
+ * 재분석해도 유지되는 내용 주소 기반 노드 식별자
+ *
+ * ID는 "v<버전>:<해시>" 형식이며, 해시는 아래 필드를 정규화해 이어 붙인
+ * 문자열의 SHA-256 앞 32자(128비트)다.
+ *
+ * - filePath: "/" 구분자, "./" 제거 (package와 자리표시 노드는 여러 파일에
+ *   걸치므로 빈 문자열)
+ * - qualifiedName: 충돌 정책이 바꾸기 전의 FQN (metadata.collidesWith 또는
+ *   노드 ID). 다른 파일에 같은 FQN이 추가되어 ID가 "ID@파일경로"로 바뀌어도
+ *   안정 ID는 그대로다.
+ * - discriminator: 위 두 필드가 같은 노드가 여럿일 때만 채운다 (같은 파일의
+ *   "#2" 접미사나 디렉토리가 겹친 패키지). 원래 FQN을 그대로 가진 노드는
+ *   비워 두고 나머지는 실제 노드 ID를 쓴다.
+ *
+ * 심볼의 위치와 이름이 같으면 분석기 버전이 바뀌어도 같은 ID를 만든다.
+ * 입력 필드나 정규화 규칙을 바꿀 때만 STABLE_ID_VERSION을 올린다.
+ */
+
+import crypto from "node:crypto";
+import path from "node:path";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** ID 규칙 버전 (바뀌면 이전 ID와 비교할 수 없음) */
+export const STABLE_ID_VERSION = 2;
+
+/** 파일에 묶이지 않는 노드 종류 */
+const FILELESS_KINDS = new Set(["package", "external", "table"]);
+
+/**
+ * 안정 ID를 만드는 정규화된 필드
+ */
+export interface StableIdFields {
+	filePath: string;
+	qualifiedName: string;
+	discriminator: string;
+}
+
+/**
+ * 노드의 안정 ID 입력 필드
+ */
+export function stableIdFields(
+	node: SemanticNode,
+	discriminator = "",
+): StableIdFields {
+	return {
+		filePath: FILELESS_KINDS.has(node.kind)
+			? ""
+			: normalizeFilePath(node.filePath),
+		qualifiedName: originalId(node),
+		discriminator,
+	};
+}
+
+/**
+ * 노드의 안정 ID (예: "v2:3f2a...")
+ *
+ * discriminator는 stableNodeIds가 겹치는 노드에만 넘긴다.
+ */
+export function stableNodeId(node: SemanticNode, discriminator = ""): string {
+	const fields = stableIdFields(node, discriminator);
+	const input = [
+		fields.filePath,
+		fields.qualifiedName,
+		fields.discriminator,
+	].join("\0");
+	const hash = crypto
+		.createHash("sha256")
+		.update(input)
+		.digest("hex")
+		.slice(0, 32);
+	return `v${STABLE_ID_VERSION}:${hash}`;
+}
+
+/**
+ * 그래프 노드 ID -> 안정 ID (노드 순서)
+ *
+ * 경로와 원래 FQN이 같은 노드끼리만 discriminator로 구분하며, 그래도
+ * 서로 다른 노드가 같은 안정 ID를 받으면 예외를 던진다.
+ */
+export function stableNodeIds(graph: SemanticGraph): Map<string, string> {
+	const counts = new Map<string, number>();
+	for (const node of graph.nodes.values()) {
+		const base = stableNodeId(node);
+		counts.set(base, (counts.get(base) ?? 0) + 1);
+	}
+
+	const ids = new Map<string, string>();
+	const owners = new Map<string, string>();
+
+	for (const node of graph.nodes.values()) {
+		let stableId = stableNodeId(node);
+		if ((counts.get(stableId) ?? 0) > 1 && node.id !== originalId(node)) {
+			stableId = stableNodeId(node, node.id);
+		}
+		const owner = owners.get(stableId);
+		if (owner !== undefined) {
+			throw new Error(
+				`Stable ID collision: ${owner} and ${node.id} both map to ${stableId}`,
+			);
+		}
+		owners.set(stableId, node.id);
+		ids.set(node.id, stableId);
+	}
+
+	return ids;
+}
+
+/**
+ * 충돌 정책이 ID를 바꾸기 전의 노드 ID
+ */
+function originalId(node: SemanticNode): string {
+	return (node.metadata.collidesWith as string | undefined) ?? node.id;
+}
+
+function normalizeFilePath(filePath: string): string {
+	const normalized = path.posix.normalize(filePath.replace(/\\/g, "/"));
+	return normalized.replace(/^\.\//, "");
+}
@@ -0,0 +1,80 @@
+/**
+ * Graph Store Interface
+ * 심볼 그래프 영속화 백엔드 공통 인터페이스
+ */
+
+import type { SemanticGraph } from "../SemanticGraph";
+import type { Page, PagedResult, SemanticEdge, SemanticNode } from "../types";
+
+/**
+ * 노드 조회 조건 (모든 조건은 AND)
+ */
+export interface NodeFilter {
+	/** 모든 태그를 가진 노드 */
+	tags?: string[];
+	/** 노드 종류 중 하나 */
+	kinds?: string[];
+	/** 선언 파일 중 하나 */
+	filePaths?: string[];
+}
+
+/**
+ * 엣지 조회 조건 (모든 조건은 AND)
+ */
+export interface EdgeFilter {
+	/** 관계 타입 중 하나 */
+	types?: string[];
+	from?: string;
+	to?: string;
+}
+
+/**
+ * 그래프 저장소
+ *
+ * 구현체는 가능하면 태그/관계 조건을 백엔드 쿼리로 내려 보내야 한다.
+ * queryNodes는 SemanticQueryEngine과 같은 ID 순 커서 페이지네이션을 따른다.
+ */
+export interface GraphStore {
+	/** 그래프 전체 저장 (기존 내용 교체) */
+	save(graph: SemanticGraph): Promise<void>;
+	/** 저장된 그래프 전체 로드 */
+	load(): Promise<SemanticGraph>;
+	/** 조건에 맞는 노드 조회 */
+	queryNodes(
+		filter: NodeFilter,
+		page?: Page,
+	): Promise<PagedResult<SemanticNode>>;
+	/** 조건에 맞는 엣지 조회 */
+	queryEdges(filter: EdgeFilter): Promise<SemanticEdge[]>;
+	/** 저장소 연결 종료 */
+	close(): Promise<void>;
+}
+
+/**
+ * 노드가 조회 조건에 맞는지 확인
+ */
+export function matchesNodeFilter(
+	node: SemanticNode,
+	filter: NodeFilter,
+): boolean {
+	return (
+		(!filter.tags ||
+			filter.tags.every((tag) => node.semanticTags.includes(tag))) &&
+		(!filter.kinds || filter.kinds.includes(node.kind)) &&
+		(!filter.filePaths || filter.filePaths.includes(node.filePath))
+	);
+}
+
+/**
+ * 엣지가 조회 조건에 맞는지 확인
+ */
+export function matchesEdgeFilter(
+	edge: SemanticEdge,
+	filter: EdgeFilter,
+): boolean {
+	return (
+		(!filter.types || filter.types.includes(edge.type)) &&
+		(filter.from === undefined || edge.from === filter.from) &&
+		(filter.to === undefined || edge.to === filter.to)
+	);
+}
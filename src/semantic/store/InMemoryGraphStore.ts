@@ -0,0 +1,69 @@
+/**
+ * In-Memory Graph Store
+ * 테스트 및 단일 프로세스용 메모리 저장소
+ */
+
+import { SemanticGraph } from "../SemanticGraph";
+import { paginate } from "../SemanticQueryEngine";
+import type { Page, PagedResult, SemanticEdge, SemanticNode } from "../types";
+import {
+	type EdgeFilter,
+	type GraphStore,
+	matchesEdgeFilter,
+	matchesNodeFilter,
+	type NodeFilter,
+} from "./GraphStore";
+
+/**
+ * 메모리 그래프 저장소
+ *
+ * 저장 시 노드/엣지를 복사하므로 저장 이후 원본 그래프를 수정해도 영향이 없다.
+ */
+export class InMemoryGraphStore implements GraphStore {
+	private nodes: SemanticNode[] = [];
+	private edges: SemanticEdge[] = [];
+
+	async save(graph: SemanticGraph): Promise<void> {
+		this.nodes = Array.from(graph.nodes.values()).map(clone);
+		this.edges = graph.edges.map(clone);
+	}
+
+	async load(): Promise<SemanticGraph> {
+		const graph = new SemanticGraph();
+		for (const node of this.nodes) graph.addNode(clone(node));
+		for (const edge of this.edges) graph.addEdge(clone(edge));
+		return graph;
+	}
+
+	async queryNodes(
+		filter: NodeFilter,
+		page?: Page,
+	): Promise<PagedResult<SemanticNode>> {
+		const matched = this.nodes.filter((node) =>
+			matchesNodeFilter(node, filter),
+		);
+		return paginate(matched.map(clone), page);
+	}
+
+	async queryEdges(filter: EdgeFilter): Promise<SemanticEdge[]> {
+		return this.edges
+			.filter((edge) => matchesEdgeFilter(edge, filter))
+			.map(clone);
+	}
+
+	async close(): Promise<void> {
+		this.nodes = [];
+		this.edges = [];
+	}
+}
+
+function clone<T>(value: T): T {
+	return JSON.parse(JSON.stringify(value)) as T;
+}
+
+/**
+ * 메모리 저장소 팩토리 함수
+ */
+export function createInMemoryGraphStore(): InMemoryGraphStore {
+	return new InMemoryGraphStore();
+}
@@ -0,0 +1,449 @@
+/**
+ * SQLite Graph Store
+ * SQLite 기반 심볼 그래프 저장소
+ */
+
+import { promises as fs } from "node:fs";
+import { dirname } from "node:path";
+import { Database } from "sqlite3";
+import { SemanticGraph } from "../SemanticGraph";
+import {
+	DEFAULT_PAGE_LIMIT,
+	decodeCursor,
+	encodeCursor,
+} from "../SemanticQueryEngine";
+import type { Page, PagedResult, SemanticEdge, SemanticNode } from "../types";
+import type { EdgeFilter, GraphStore, NodeFilter } from "./GraphStore";
+
+/** 스키마가 바뀌면 올린다 (버전이 다르면 semantic_* 테이블을 다시 만든다) */
+export const SQLITE_SCHEMA_VERSION = 3;
+
+const TABLES = [
+	"semantic_edges",
+	"semantic_node_metadata",
+	"semantic_node_tags",
+	"semantic_nodes",
+];
+
+const SCHEMA = [
+	`CREATE TABLE IF NOT EXISTS semantic_nodes (
+		id TEXT PRIMARY KEY,
+		fqn TEXT NOT NULL,
+		name TEXT NOT NULL,
+		kind TEXT NOT NULL,
+		file_path TEXT NOT NULL,
+		language TEXT,
+		line INTEGER,
+		source_range TEXT,
+		name_range TEXT,
+		description TEXT,
+		semantic_tags TEXT NOT NULL
+	)`,
+	`CREATE TABLE IF NOT EXISTS semantic_node_tags (
+		node_id TEXT NOT NULL,
+		tag TEXT NOT NULL,
+		PRIMARY KEY (node_id, tag)
+	)`,
+	`CREATE TABLE IF NOT EXISTS semantic_node_metadata (
+		node_id TEXT NOT NULL,
+		key TEXT NOT NULL,
+		value TEXT NOT NULL,
+		PRIMARY KEY (node_id, key)
+	)`,
+	`CREATE TABLE IF NOT EXISTS semantic_edges (
+		seq INTEGER PRIMARY KEY AUTOINCREMENT,
+		from_id TEXT NOT NULL,
+		to_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		metadata TEXT,
+		UNIQUE (from_id, to_id, type)
+	)`,
+	"CREATE INDEX IF NOT EXISTS idx_semantic_nodes_kind ON semantic_nodes(kind)",
+	"CREATE INDEX IF NOT EXISTS idx_semantic_nodes_file ON semantic_nodes(file_path)",
+	"CREATE INDEX IF NOT EXISTS idx_semantic_node_tags_tag ON semantic_node_tags(tag)",
+	"CREATE INDEX IF NOT EXISTS idx_semantic_node_metadata_key ON semantic_node_metadata(key)",
+	"CREATE INDEX IF NOT EXISTS idx_semantic_edges_from ON semantic_edges(from_id, type)",
+	"CREATE INDEX IF NOT EXISTS idx_semantic_edges_to ON semantic_edges(to_id, type)",
+];
+
+interface NodeRow {
+	id: string;
+	fqn: string;
+	name: string;
+	kind: string;
+	file_path: string;
+	language: string | null;
+	line: number | null;
+	source_range: string | null;
+	name_range: string | null;
+	description: string | null;
+	semantic_tags: string;
+}
+
+interface MetadataRow {
+	node_id: string;
+	key: string;
+	value: string;
+}
+
+interface EdgeRow {
+	from_id: string;
+	to_id: string;
+	type: string;
+	metadata: string | null;
+}
+
+/**
+ * SQLite 그래프 저장소
+ *
+ * 태그, 종류, 파일, 관계 타입 조건은 모두 SQL WHERE 절로 처리한다.
+ * 노드 태그와 메타데이터는 키마다 별도 행으로 저장되어 SQL로 직접 조회할
+ * 수 있다 (메타데이터 값과 소스 범위는 JSON). 저장된 그래프는 분석 결과의
+ * 사본이므로 스키마 버전이 다르면 기존 semantic_* 테이블을 지우고 다시
+ * 만든다.
+ */
+export class SQLiteGraphStore implements GraphStore {
+	private db: Database | null = null;
+	private dbPath: string;
+
+	constructor(dbPath: string) {
+		this.dbPath = dbPath;
+	}
+
+	/**
+	 * 데이터베이스 열기 및 테이블 생성
+	 */
+	async initialize(): Promise<void> {
+		if (this.db) return;
+
+		if (this.dbPath !== ":memory:") {
+			await fs.mkdir(dirname(this.dbPath), { recursive: true });
+		}
+		this.db = await new Promise<Database>((resolve, reject) => {
+			const db = new Database(this.dbPath, (err: Error | null) => {
+				if (err) {
+					reject(new Error(`Failed to open database: ${err.message}`));
+				} else {
+					resolve(db);
+				}
+			});
+		});
+
+		const [{ user_version: version }] = await this.all<{
+			user_version: number;
+		}>("PRAGMA user_version");
+		if (version !== SQLITE_SCHEMA_VERSION) {
+			for (const table of TABLES) {
+				await this.run(`DROP TABLE IF EXISTS ${table}`);
+			}
+		}
+		for (const statement of SCHEMA) {
+			await this.run(statement);
+		}
+		await this.run(`PRAGMA user_version = ${SQLITE_SCHEMA_VERSION}`);
+	}
+
+	async save(graph: SemanticGraph): Promise<void> {
+		await this.run("BEGIN TRANSACTION");
+		try {
+			await this.run("DELETE FROM semantic_edges");
+			await this.run("DELETE FROM semantic_node_metadata");
+			await this.run("DELETE FROM semantic_node_tags");
+			await this.run("DELETE FROM semantic_nodes");
+
+			for (const node of graph.nodes.values()) {
+				await this.run(
+					`INSERT INTO semantic_nodes
+						(id, fqn, name, kind, file_path, language, line, source_range, name_range, description, semantic_tags)
+					VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					[
+						node.id,
+						node.fqn,
+						node.name,
+						node.kind,
+						node.filePath,
+						node.language ?? null,
+						node.line ?? null,
+						node.range ? JSON.stringify(node.range) : null,
+						node.nameRange ? JSON.stringify(node.nameRange) : null,
+						node.description ?? null,
+						JSON.stringify(node.semanticTags),
+					],
+				);
+				for (const tag of new Set(node.semanticTags)) {
+					await this.run(
+						"INSERT INTO semantic_node_tags (node_id, tag) VALUES (?, ?)",
+						[node.id, tag],
+					);
+				}
+				for (const [key, value] of Object.entries(node.metadata)) {
+					if (value === undefined) continue;
+					await this.run(
+						"INSERT INTO semantic_node_metadata (node_id, key, value) VALUES (?, ?, ?)",
+						[node.id, key, JSON.stringify(value)],
+					);
+				}
+			}
+
+			for (const edge of graph.edges) {
+				await this.run(
+					"INSERT INTO semantic_edges (from_id, to_id, type, metadata) VALUES (?, ?, ?, ?)",
+					[
+						edge.from,
+						edge.to,
+						edge.type,
+						edge.metadata ? JSON.stringify(edge.metadata) : null,
+					],
+				);
+			}
+
+			await this.run("COMMIT");
+		} catch (error) {
+			await this.run("ROLLBACK");
+			throw error;
+		}
+	}
+
+	async load(): Promise<SemanticGraph> {
+		const graph = new SemanticGraph();
+		// rowid 순으로 읽어 저장 당시의 노드 순서를 유지
+		const nodes = await this.all<NodeRow>(
+			"SELECT * FROM semantic_nodes ORDER BY rowid",
+		);
+		const metadata = await this.all<MetadataRow>(
+			"SELECT * FROM semantic_node_metadata ORDER BY rowid",
+		);
+		for (const node of toNodes(nodes, metadata)) graph.addNode(node);
+
+		const edges = await this.all<EdgeRow>(
+			"SELECT * FROM semantic_edges ORDER BY seq",
+		);
+		for (const row of edges) graph.addEdge(toEdge(row));
+		return graph;
+	}
+
+	async queryNodes(
+		filter: NodeFilter,
+		page?: Page,
+	): Promise<PagedResult<SemanticNode>> {
+		const where: string[] = [];
+		const params: unknown[] = [];
+
+		for (const tag of filter.tags ?? []) {
+			where.push(
+				"EXISTS (SELECT 1 FROM semantic_node_tags t WHERE t.node_id = n.id AND t.tag = ?)",
+			);
+			params.push(tag);
+		}
+		if (filter.kinds) {
+			where.push(`n.kind IN (${placeholders(filter.kinds)})`);
+			params.push(...filter.kinds);
+		}
+		if (filter.filePaths) {
+			where.push(`n.file_path IN (${placeholders(filter.filePaths)})`);
+			params.push(...filter.filePaths);
+		}
+
+		let limit: number | undefined;
+		if (page) {
+			limit = page.limit ?? DEFAULT_PAGE_LIMIT;
+			if (!Number.isInteger(limit) || limit <= 0) {
+				throw new Error(`Invalid page limit: ${limit}`);
+			}
+			if (page.cursor) {
+				where.push("n.id > ?");
+				params.push(decodeCursor(page.cursor));
+			}
+		}
+
+		let sql = "SELECT n.* FROM semantic_nodes n";
+		if (where.length > 0) sql += ` WHERE ${where.join(" AND ")}`;
+		sql += " ORDER BY n.id";
+		if (limit !== undefined) {
+			// 다음 페이지 존재 여부 확인을 위해 하나 더 조회
+			sql += " LIMIT ?";
+			params.push(limit + 1);
+		}
+
+		const rows = await this.all<NodeRow>(sql, params);
+		const pageRows = rows.slice(0, limit ?? rows.length);
+		const metadata =
+			pageRows.length > 0
+				? await this.all<MetadataRow>(
+						`SELECT * FROM semantic_node_metadata WHERE node_id IN (${placeholders(pageRows)}) ORDER BY rowid`,
+						pageRows.map((row) => row.id),
+					)
+				: [];
+		const items = toNodes(pageRows, metadata);
+		const hasMore = limit !== undefined && rows.length > limit;
+		return {
+			items,
+			nextCursor: hasMore
+				? encodeCursor(items[items.length - 1].id)
+				: undefined,
+		};
+	}
+
+	async queryEdges(filter: EdgeFilter): Promise<SemanticEdge[]> {
+		const where: string[] = [];
+		const params: unknown[] = [];
+
+		if (filter.types) {
+			where.push(`type IN (${placeholders(filter.types)})`);
+			params.push(...filter.types);
+		}
+		if (filter.from !== undefined) {
+			where.push("from_id = ?");
+			params.push(filter.from);
+		}
+		if (filter.to !== undefined) {
+			where.push("to_id = ?");
+			params.push(filter.to);
+		}
+
+		let sql = "SELECT * FROM semantic_edges";
+		if (where.length > 0) sql += ` WHERE ${where.join(" AND ")}`;
+		sql += " ORDER BY seq";
+
+		return (await this.all<EdgeRow>(sql, params)).map(toEdge);
+	}
+
+	async close(): Promise<void> {
+		const db = this.db;
+		if (!db) return;
+
+		await new Promise<void>((resolve, reject) => {
+			db.close((err: Error | null) => {
+				if (err) {
+					reject(new Error(`Failed to close database: ${err.message}`));
+				} else {
+					resolve();
+				}
+			});
+		});
+		this.db = null;
+	}
+
+	private run(sql: string, params: unknown[] = []): Promise<void> {
+		return new Promise((resolve, reject) => {
+			if (!this.db) {
+				reject(new Error("Database not initialized"));
+				return;
+			}
+			this.db.run(sql, params, (err: Error | null) => {
+				if (err) {
+					reject(new Error(`Query execution failed: ${err.message}`));
+				} else {
+					resolve();
+				}
+			});
+		});
+	}
+
+	private all<T>(sql: string, params: unknown[] = []): Promise<T[]> {
+		return new Promise((resolve, reject) => {
+			if (!this.db) {
+				reject(new Error("Database not initialized"));
+				return;
+			}
+			this.db.all(sql, params, (err: Error | null, rows: T[]) => {
+				if (err) {
+					reject(new Error(`Query execution failed: ${err.message}`));
+				} else {
+					resolve(rows || []);
+				}
+			});
+		});
+	}
+}
+
+function placeholders(values: unknown[]): string {
+	return values.map(() => "?").join(", ");
+}
+
+function toNodes(rows: NodeRow[], metadata: MetadataRow[]): SemanticNode[] {
+	const byNode = new Map<string, Record<string, unknown>>();
+	for (const entry of metadata) {
+		let values = byNode.get(entry.node_id);
+		if (!values) {
+			values = {};
+			byNode.set(entry.node_id, values);
+		}
+		values[entry.key] = JSON.parse(entry.value);
+	}
+
+	return rows.map((row) => ({
+		id: row.id,
+		fqn: row.fqn,
+		name: row.name,
+		kind: row.kind,
+		filePath: row.file_path,
+		language: row.language ?? undefined,
+		line: row.line ?? undefined,
+		range: row.source_range ? JSON.parse(row.source_range) : undefined,
+		nameRange: row.name_range ? JSON.parse(row.name_range) : undefined,
+		description: row.description ?? undefined,
+		semanticTags: JSON.parse(row.semantic_tags),
+		metadata: byNode.get(row.id) ?? {},
+	}));
+}
+
+function toEdge(row: EdgeRow): SemanticEdge {
+	const edge: SemanticEdge = {
+		from: row.from_id,
+		to: row.to_id,
+		type: row.type,
+	};
+	if (row.metadata) {
+		edge.metadata = JSON.parse(row.metadata);
+	}
+	return edge;
+}
+
+/**
+ * SQLite 저장소 생성 및 초기화
+ */
+export async function createSQLiteGraphStore(
+	dbPath: string,
+): Promise<SQLiteGraphStore> {
+	const store = new SQLiteGraphStore(dbPath);
+	await store.initialize();
+	return store;
+}
+
+/**
+ * 그래프를 SQLite 파일에 저장 (기존 내용은 한 트랜잭션으로 교체)
+ */
+export async function saveGraphToSQLite(
+	graph: SemanticGraph,
+	dbPath: string,
+): Promise<void> {
+	const store = await createSQLiteGraphStore(dbPath);
+	try {
+		await store.save(graph);
+	} finally {
+		await store.close();
+	}
+}
+
+/**
+ * SQLite 파일에서 그래프 로드 (다시 파싱하지 않음, 파일이 없으면 예외)
+ */
+export async function loadGraphFromSQLite(
+	dbPath: string,
+): Promise<SemanticGraph> {
+	if (dbPath !== ":memory:") {
+		try {
+			await fs.access(dbPath);
+		} catch {
+			throw new Error(`Database not found: ${dbPath}`);
+		}
+	}
+
+	const store = await createSQLiteGraphStore(dbPath);
+	try {
+		return await store.load();
+	} finally {
+		await store.close();
+	}
+}
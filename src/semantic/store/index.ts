@@ -0,0 +1,18 @@
+/**
+ * Graph Store Module
+ * 심볼 그래프 저장소 익스포트
+ */
+
+export type { EdgeFilter, GraphStore, NodeFilter } from "./GraphStore";
+export { matchesEdgeFilter, matchesNodeFilter } from "./GraphStore";
+export {
+	createInMemoryGraphStore,
+	InMemoryGraphStore,
+} from "./InMemoryGraphStore";
+export {
+	createSQLiteGraphStore,
+	loadGraphFromSQLite,
+	SQLITE_SCHEMA_VERSION,
+	SQLiteGraphStore,
+	saveGraphToSQLite,
+} from "./SQLiteGraphStore";
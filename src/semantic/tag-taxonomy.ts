@@ -0,0 +1,252 @@
+/**
+ * Tag Taxonomy
+ * 허용 태그 어휘와 분류(category)를 선언한 택소노미 파일 로드
+ *
+ * JSON 예:
+ * {
+ *   "tags": ["deprecated"],
+ *   "categories": {
+ *     "visibility": { "tags": ["public-api", "internal"], "required": true },
+ *     "domain": ["user-domain", "order-domain"]
+ *   },
+ *   "tagKinds": { "constructor-function": "constructor" }
+ * }
+ *
+ * YAML은 같은 구조를 들여쓰기 매핑, `- 항목` 목록, `[a, b]` 목록으로 쓴다.
+ */
+
+import { promises as fs } from "node:fs";
+import path from "node:path";
+import type { TagKindMap } from "./kind-inference";
+
+/**
+ * 태그 분류 하나
+ */
+export interface TagCategory {
+	name: string;
+	tags: string[];
+	/** 대상 심볼마다 이 분류의 태그가 정확히 하나 있어야 하는지 여부 */
+	required: boolean;
+	/** required 검사 대상 노드 종류 (생략 시 태그가 하나라도 있는 심볼) */
+	kinds?: string[];
+}
+
+/**
+ * 태그 택소노미
+ */
+export interface TagTaxonomy {
+	/** 분류에 속하지 않는 허용 태그 */
+	tags: string[];
+	categories: TagCategory[];
+	/** 태그 -> 그 태그가 뜻하는 노드 종류 (kind-inference 참고) */
+	tagKinds?: TagKindMap;
+}
+
+export type TaxonomyFormat = "json" | "yaml";
+
+/**
+ * 택소노미의 전체 허용 태그
+ */
+export function getTaxonomyVocabulary(taxonomy: TagTaxonomy): Set<string> {
+	return new Set([
+		...taxonomy.tags,
+		...taxonomy.categories.flatMap((category) => category.tags),
+	]);
+}
+
+/**
+ * 택소노미 내용 파싱 (형식이 잘못되면 예외)
+ */
+export function parseTagTaxonomy(
+	content: string,
+	format: TaxonomyFormat,
+): TagTaxonomy {
+	const data =
+		format === "json" ? JSON.parse(content) : parseYamlSubset(content);
+	if (!isRecord(data)) {
+		throw new Error("Invalid tag taxonomy: expected an object");
+	}
+
+	const categories: TagCategory[] = [];
+	const rawCategories = data.categories ?? {};
+	if (!isRecord(rawCategories)) {
+		throw new Error("Invalid tag taxonomy: categories must be an object");
+	}
+	for (const [name, value] of Object.entries(rawCategories)) {
+		const spec = Array.isArray(value) ? { tags: value } : value;
+		if (!isRecord(spec)) {
+			throw new Error(
+				`Invalid tag taxonomy: category ${name} must be a list or an object`,
+			);
+		}
+		categories.push({
+			name,
+			tags: toStringList(spec.tags, `categories.${name}.tags`),
+			required: spec.required === true,
+			...(spec.kinds !== undefined && {
+				kinds: toStringList(spec.kinds, `categories.${name}.kinds`),
+			}),
+		});
+	}
+
+	const taxonomy: TagTaxonomy = {
+		tags: toStringList(data.tags ?? [], "tags"),
+		categories,
+	};
+	if (data.tagKinds !== undefined) {
+		taxonomy.tagKinds = toStringRecord(data.tagKinds, "tagKinds");
+	}
+	return taxonomy;
+}
+
+/**
+ * 택소노미 파일 로드 (.json은 JSON, .yaml/.yml은 YAML)
+ */
+export async function loadTagTaxonomy(filePath: string): Promise<TagTaxonomy> {
+	const extension = path.extname(filePath).toLowerCase();
+	const format: TaxonomyFormat | undefined =
+		extension === ".json"
+			? "json"
+			: extension === ".yaml" || extension === ".yml"
+				? "yaml"
+				: undefined;
+	if (!format) {
+		throw new Error(`Unsupported tag taxonomy file: ${filePath}`);
+	}
+
+	const content = await fs.readFile(filePath, "utf-8");
+	return parseTagTaxonomy(content, format);
+}
+
+function isRecord(value: unknown): value is Record<string, unknown> {
+	return typeof value === "object" && value !== null && !Array.isArray(value);
+}
+
+function toStringList(value: unknown, field: string): string[] {
+	if (
+		!Array.isArray(value) ||
+		!value.every((item) => typeof item === "string")
+	) {
+		throw new Error(
+			`Invalid tag taxonomy: ${field} must be a list of strings`,
+		);
+	}
+	return value;
+}
+
+function toStringRecord(
+	value: unknown,
+	field: string,
+): Record<string, string> {
+	if (
+		!isRecord(value) ||
+		!Object.values(value).every((item) => typeof item === "string")
+	) {
+		throw new Error(
+			`Invalid tag taxonomy: ${field} must map tags to kind names`,
+		);
+	}
+	return value as Record<string, string>;
+}
+
+interface YamlLine {
+	indent: number;
+	text: string;
+	line: number;
+}
+
+/**
+ * 택소노미에 필요한 YAML 부분집합 파싱
+ *
+ * 들여쓰기 매핑, `- 값` 블록 목록, `[a, b]` 흐름 목록, 따옴표 문자열,
+ * true/false와 `#` 주석만 지원한다.
+ */
+function parseYamlSubset(content: string): unknown {
+	const lines: YamlLine[] = [];
+	content.split("\n").forEach((rawLine, index) => {
+		const text = rawLine.replace(/(^|\s)#.*$/, "").trimEnd();
+		if (text.trim().length === 0) return;
+		if (/^\s*\t/.test(text)) {
+			throw new Error(
+				`Invalid tag taxonomy: tab indentation at line ${index + 1}`,
+			);
+		}
+		lines.push({
+			indent: text.length - text.trimStart().length,
+			text: text.trim(),
+			line: index + 1,
+		});
+	});
+	if (lines.length === 0) return {};
+
+	let position = 0;
+	const isListItem = (line: YamlLine) =>
+		line.text === "-" || line.text.startsWith("- ");
+
+	const parseBlock = (indent: number): unknown => {
+		if (isListItem(lines[position])) {
+			const list: unknown[] = [];
+			while (
+				position < lines.length &&
+				lines[position].indent === indent &&
+				isListItem(lines[position])
+			) {
+				const item = lines[position].text.slice(1).trim();
+				position++;
+				list.push(item ? parseScalar(item) : parseNested(indent));
+			}
+			return list;
+		}
+
+		const mapping: Record<string, unknown> = {};
+		while (position < lines.length && lines[position].indent === indent) {
+			const { text, line } = lines[position];
+			const match = text.match(/^("[^"]*"|'[^']*'|[^:]+):(?:\s+(.*))?$/);
+			if (!match || isListItem(lines[position])) {
+				throw new Error(
+					`Invalid tag taxonomy: expected "key: value" at line ${line}`,
+				);
+			}
+			position++;
+			const key = String(parseScalar(match[1].trim()));
+			mapping[key] = match[2] ? parseScalar(match[2]) : parseNested(indent);
+		}
+		return mapping;
+	};
+
+	// 값이 비어 있는 키 아래의 블록 (목록은 키와 같은 들여쓰기도 허용)
+	const parseNested = (indent: number): unknown => {
+		const next = lines[position];
+		if (
+			next &&
+			(next.indent > indent || (next.indent === indent && isListItem(next)))
+		) {
+			return parseBlock(next.indent);
+		}
+		return null;
+	};
+
+	const result = parseBlock(lines[0].indent);
+	if (position < lines.length) {
+		throw new Error(
+			`Invalid tag taxonomy: unexpected indentation at line ${lines[position].line}`,
+		);
+	}
+	return result;
+}
+
+function parseScalar(text: string): unknown {
+	if (text.startsWith("[") && text.endsWith("]")) {
+		const inner = text.slice(1, -1).trim();
+		return inner
+			? inner.split(",").map((item) => parseScalar(item.trim()))
+			: [];
+	}
+	if (/^"[^"]*"$|^'[^']*'$/.test(text)) {
+		return text.slice(1, -1);
+	}
+	if (text === "true" || text === "false") {
+		return text === "true";
+	}
+	return text;
+}
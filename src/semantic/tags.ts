@@ -0,0 +1,70 @@
+/**
+ * Semantic Tag Inheritance
+ * 상위 심볼로부터 상속되는 유효 태그 계산
+ */
+
+import { getGoPackageId } from "./extractors/GoExtractor";
+import type { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/**
+ * 노드의 상위 심볼 ID 목록 (직계만)
+ *
+ * - contains 엣지로 노드를 포함하는 심볼 (패키지 노드 제외)
+ * - 메서드의 리시버 타입 (metadata.receiverType)
+ *
+ * 패키지 태그는 패키지 자체를 설명하므로 소속 심볼에 상속하지 않는다.
+ */
+export function getParentIds(
+	graph: SemanticGraph,
+	node: SemanticNode,
+): string[] {
+	const parents = graph
+		.getIncomingEdges(node.id, ["contains"])
+		.map((edge) => edge.from)
+		.filter((id) => graph.getNode(id)?.kind !== "package");
+
+	const receiverType = node.metadata.receiverType as string | undefined;
+	const packageId = getGoPackageId(node);
+	if (receiverType && packageId) {
+		const receiverId = `${packageId}.${receiverType}`;
+		if (graph.hasNode(receiverId) && !parents.includes(receiverId)) {
+			parents.push(receiverId);
+		}
+	}
+
+	return parents;
+}
+
+/**
+ * 노드의 유효 태그 (자신의 태그 + 모든 상위 심볼에서 상속된 태그)
+ *
+ * 반환된 Map의 값은 태그를 선언한 노드 ID이며, 같은 태그가 여러 곳에
+ * 있으면 가장 가까운 선언이 우선한다.
+ */
+export function getEffectiveTags(
+	graph: SemanticGraph,
+	node: SemanticNode,
+): Map<string, string> {
+	const tags = new Map<string, string>();
+	const visited = new Set<string>([node.id]);
+	const queue: SemanticNode[] = [node];
+
+	while (queue.length > 0) {
+		const current = queue.shift() as SemanticNode;
+		for (const tag of current.semanticTags) {
+			if (!tags.has(tag)) {
+				tags.set(tag, current.id);
+			}
+		}
+		for (const parentId of getParentIds(graph, current)) {
+			const parent = graph.getNode(parentId);
+			if (parent && !visited.has(parentId)) {
+				visited.add(parentId);
+				queue.push(parent);
+			}
+		}
+	}
+
+	return tags;
+}
@@ -0,0 +1,64 @@
+/**
+ * Test Files
+ * 언어별 테스트 파일 규칙과 테스트 출처 노드 구분
+ */
+
+import path from "node:path";
+import { SemanticGraph } from "./SemanticGraph";
+import type { SemanticNode } from "./types";
+
+/** 테스트 파일에서 추출한 노드에 붙는 태그 */
+export const TEST_TAG = "test";
+
+/** 언어별 테스트 파일 이름 규칙 */
+const TEST_FILE_PATTERNS: RegExp[] = [
+	// Go: foo_test.go
+	/_test\.go$/,
+	// JavaScript/TypeScript: foo.test.ts, foo.spec.jsx ...
+	/\.(test|spec)\.[cm]?[jt]sx?$/,
+	// Python: test_foo.py, foo_test.py
+	/^test_.*\.py$/,
+	/_test\.py$/,
+];
+
+/**
+ * 테스트 파일인지 확인
+ *
+ * 파일 이름 규칙 외에 JavaScript/TypeScript의 __tests__ 디렉토리 아래
+ * 파일도 테스트로 본다.
+ */
+export function isTestFile(filePath: string): boolean {
+	const normalized = filePath.replace(/\\/g, "/");
+	const name = path.posix.basename(normalized);
+	if (TEST_FILE_PATTERNS.some((pattern) => pattern.test(name))) {
+		return true;
+	}
+	return (
+		/(^|\/)__tests__\//.test(normalized) && /\.[cm]?[jt]sx?$/.test(name)
+	);
+}
+
+/**
+ * 테스트 파일에서 추출한 노드인지 확인
+ */
+export function isTestNode(node: SemanticNode): boolean {
+	return node.semanticTags.includes(TEST_TAG);
+}
+
+/**
+ * 테스트 노드와 그 엣지를 뺀 새 그래프 (익스포트 전 필터용)
+ */
+export function excludeTests(graph: SemanticGraph): SemanticGraph {
+	const result = new SemanticGraph();
+	for (const node of graph.nodes.values()) {
+		if (!isTestNode(node)) {
+			result.addNode(node);
+		}
+	}
+	for (const edge of graph.edges) {
+		if (result.hasNode(edge.from) && result.hasNode(edge.to)) {
+			result.addEdge(edge);
+		}
+	}
+	return result;
+}
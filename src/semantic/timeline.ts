@@ -0,0 +1,116 @@
+/**
+ * Symbol Timeline
+ * 릴리스별 그래프 스냅샷에 걸친 심볼 이력
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+
+/**
+ * 라벨이 붙은 그래프 스냅샷 (예: 릴리스 버전)
+ */
+export interface LabeledGraph {
+	label: string;
+	graph: SemanticGraph;
+}
+
+export type TimelineEvent =
+	| "introduced"
+	| "changed"
+	| "unchanged"
+	| "removed"
+	| "absent";
+
+/**
+ * 스냅샷 하나에서의 심볼 상태
+ */
+export interface TimelinePoint {
+	label: string;
+	event: TimelineEvent;
+	present: boolean;
+	/** 현재 태그 (정렬됨) */
+	tags: string[];
+	addedTags: string[];
+	removedTags: string[];
+	/** 현재 의존하는 심볼 ID (정렬됨) */
+	dependents: string[];
+	addedDependents: string[];
+	removedDependents: string[];
+	/** 현재 의존 대상 수 */
+	dependencyCount: number;
+}
+
+/**
+ * 스냅샷 순서대로 심볼 이력 생성
+ *
+ * 직전 스냅샷 대비 태그 또는 의존자(들어오는 의존 엣지의 출발 심볼)가
+ * 바뀌면 "changed", 처음 등장하면 "introduced"로 표시한다.
+ */
+export function buildSymbolTimeline(
+	snapshots: LabeledGraph[],
+	symbolId: string,
+): TimelinePoint[] {
+	const points: TimelinePoint[] = [];
+	let previous: TimelinePoint | undefined;
+
+	for (const { label, graph } of snapshots) {
+		const node = graph.getNode(symbolId);
+		const tags = node ? Array.from(new Set(node.semanticTags)).sort() : [];
+		const dependents = node
+			? Array.from(
+					new Set(
+						graph
+							.getIncomingEdges(symbolId)
+							.filter((edge) => isDependencyEdge(edge.type))
+							.map((edge) => edge.from),
+					),
+				).sort()
+			: [];
+		const dependencyCount = node
+			? new Set(
+					graph
+						.getOutgoingEdges(symbolId)
+						.filter((edge) => isDependencyEdge(edge.type))
+						.map((edge) => edge.to),
+				).size
+			: 0;
+
+		const before = previous?.present ? previous : undefined;
+		const point: TimelinePoint = {
+			label,
+			event: "absent",
+			present: node !== undefined,
+			tags,
+			addedTags: difference(tags, before?.tags ?? []),
+			removedTags: difference(before?.tags ?? [], tags),
+			dependents,
+			addedDependents: difference(dependents, before?.dependents ?? []),
+			removedDependents: difference(before?.dependents ?? [], dependents),
+			dependencyCount,
+		};
+
+		if (node && !before) {
+			point.event = "introduced";
+		} else if (node) {
+			const changed =
+				point.addedTags.length +
+					point.removedTags.length +
+					point.addedDependents.length +
+					point.removedDependents.length >
+				0;
+			point.event = changed ? "changed" : "unchanged";
+		} else if (before) {
+			point.event = "removed";
+		}
+
+		points.push(point);
+		previous = point;
+	}
+
+	return points;
+}
+
+function difference(values: string[], other: string[]): string[] {
+	const exclude = new Set(other);
+	return values.filter((value) => !exclude.has(value));
+}
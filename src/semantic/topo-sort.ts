@@ -0,0 +1,123 @@
+/**
+ * Topological Ordering
+ * 순환을 하나의 단위로 묶은 의존 순서 (빌드 순서, 계층 시각화용)
+ */
+
+import { stronglyConnectedComponents } from "./cycles";
+import { isDependencyEdge } from "./impact";
+import { graphByPackage } from "./packages";
+import type { SemanticGraph } from "./SemanticGraph";
+
+/**
+ * 정렬 단위
+ *
+ * - symbol: 그래프 노드 그대로
+ * - package: graphByPackage로 집계한 패키지 노드
+ */
+export type TopoGranularity = "symbol" | "package";
+
+/**
+ * 위상 정렬 옵션
+ */
+export interface TopoSortOptions {
+	/** 의존 관계로 볼 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+	/** 정렬 단위 (기본: "symbol") */
+	granularity?: TopoGranularity;
+}
+
+/**
+ * 위상 정렬 결과
+ */
+export interface TopoSortResult {
+	/**
+	 * 의존 대상이 먼저 오는 순서의 단위 목록
+	 *
+	 * 순환이 없는 노드는 원소 하나, 순환 요소는 ID 순 노드 목록 하나다.
+	 */
+	order: string[][];
+	/** 하나의 단위로 묶인 순환 요소 (자기 참조 포함, 첫 노드 ID 순) */
+	cycles: string[][];
+}
+
+/**
+ * 순환을 묶은 위상 정렬
+ *
+ * 강한 연결 요소를 하나의 단위로 압축한 DAG를 Kahn 알고리즘으로 정렬하며,
+ * 동시에 준비된 단위는 첫 노드 ID 순으로 꺼내므로 노드/엣지 추가 순서와
+ * 관계없이 결과가 같다. package 단위에서는 패키지에 속하지 않는 노드를
+ * 제외한다.
+ */
+export function topoSort(
+	graph: SemanticGraph,
+	options: TopoSortOptions = {},
+): TopoSortResult {
+	const target =
+		options.granularity === "package"
+			? graphByPackage(graph, { edgeTypes: options.edgeTypes })
+			: graph;
+	const follows = (type: string) =>
+		options.granularity === "package"
+			? type === "depends_on"
+			: options.edgeTypes
+				? options.edgeTypes.includes(type)
+				: isDependencyEdge(type);
+	const edges = target.edges.filter(
+		(edge) =>
+			follows(edge.type) &&
+			target.hasNode(edge.from) &&
+			target.hasNode(edge.to),
+	);
+
+	const unitOf = new Map<string, number>();
+	const units: string[][] = [];
+	for (const members of stronglyConnectedComponents(edges)) {
+		for (const id of members) unitOf.set(id, units.length);
+		units.push(members.sort());
+	}
+	for (const id of Array.from(target.nodes.keys()).sort()) {
+		if (unitOf.has(id)) continue;
+		unitOf.set(id, units.length);
+		units.push([id]);
+	}
+
+	const selfLoops = new Set<number>();
+	const dependents = new Map<number, Set<number>>();
+	const pending = units.map(() => 0);
+	for (const edge of edges) {
+		const from = unitOf.get(edge.from) as number;
+		const to = unitOf.get(edge.to) as number;
+		if (from === to) {
+			selfLoops.add(from);
+			continue;
+		}
+		const set = dependents.get(to) ?? new Set<number>();
+		if (!set.has(from)) {
+			set.add(from);
+			pending[from]++;
+		}
+		dependents.set(to, set);
+	}
+
+	const compare = (a: number, b: number) =>
+		units[a][0] < units[b][0] ? -1 : units[a][0] > units[b][0] ? 1 : 0;
+	const ready = units
+		.map((_, unit) => unit)
+		.filter((unit) => pending[unit] === 0)
+		.sort(compare);
+	const order: string[][] = [];
+	while (ready.length > 0) {
+		const unit = ready.shift() as number;
+		order.push(units[unit]);
+		for (const dependent of dependents.get(unit) ?? []) {
+			if (--pending[dependent] > 0) continue;
+			const at = ready.findIndex((other) => compare(dependent, other) < 0);
+			ready.splice(at < 0 ? ready.length : at, 0, dependent);
+		}
+	}
+
+	const cycles = units
+		.filter((members, unit) => members.length > 1 || selfLoops.has(unit))
+		.sort((a, b) => (a[0] < b[0] ? -1 : a[0] > b[0] ? 1 : 0));
+	return { order, cycles };
+}
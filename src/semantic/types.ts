@@ -0,0 +1,149 @@
+/**
+ * Semantic Graph Types
+ * @semantic-tags 기반 심볼 그래프를 위한 타입 정의
+ */
+
+// ===== NODE / EDGE TYPES =====
+
+/**
+ * 심볼 그래프 노드
+ */
+export interface SemanticNode {
+	/** 노드 ID (그래프 내 고유) */
+	id: string;
+	/** 정규화된 전체 이름 (예: "user.UserService.CreateUser") */
+	fqn: string;
+	/** 심볼 이름 */
+	name: string;
+	/** 노드 종류 (예: "package", "struct", "interface", "function", "method") */
+	kind: string;
+	/** 선언 파일 경로 */
+	filePath: string;
+	/** 파일 언어 (SupportedLanguage 또는 "proto" 같은 비 tree-sitter 언어) */
+	language?: string;
+	/** 선언 라인 번호 (1-indexed) */
+	line?: number;
+	/** 선언 전체 범위 (주석 제외) */
+	range?: SourceRange;
+	/** 이름 토큰 범위 */
+	nameRange?: SourceRange;
+	/** @semantic-tags 목록 */
+	semanticTags: string[];
+	/** @description 텍스트 */
+	description?: string;
+	/** 추가 메타데이터 */
+	metadata: Record<string, any>;
+}
+
+/**
+ * 소스 범위
+ *
+ * 오프셋은 파일 시작부터의 UTF-8 바이트 수(0부터, 끝은 제외)이고, 라인과
+ * 컬럼은 1부터 시작하며 컬럼도 라인 시작부터의 UTF-8 바이트 수다.
+ * UTF-16 코드 단위를 쓰는 편집기(LSP 기본값, VS Code)는 라인 내용으로
+ * 변환해야 한다.
+ */
+export interface SourceRange {
+	startByte: number;
+	endByte: number;
+	startLine: number;
+	startColumn: number;
+	endLine: number;
+	endColumn: number;
+}
+
+/**
+ * 심볼 그래프 엣지
+ */
+export interface SemanticEdge {
+	/** 시작 노드 ID */
+	from: string;
+	/** 끝 노드 ID */
+	to: string;
+	/** 관계 타입 (예: "imports", "calls", "contains") */
+	type: string;
+	/** 추가 메타데이터 */
+	metadata?: Record<string, any>;
+}
+
+// ===== PAGINATION TYPES =====
+
+/**
+ * 커서 기반 페이지 요청
+ */
+export interface Page {
+	/** 이전 결과의 nextCursor (첫 페이지는 생략) */
+	cursor?: string;
+	/** 페이지 크기 */
+	limit?: number;
+}
+
+/**
+ * 커서 기반 페이지 결과
+ */
+export interface PagedResult<T> {
+	/** 현재 페이지 항목 */
+	items: T[];
+	/** 다음 페이지 커서 (마지막 페이지면 undefined) */
+	nextCursor?: string;
+}
+
+// ===== EXTRACTION TYPES =====
+
+/**
+ * 함수 본문에서 발견된 호출 위치
+ */
+export interface CallSite {
+	/** 호출 대상 원본 텍스트 (예: "slog.Error", "s.db.ExecContext") */
+	callee: string;
+	/** 호출 라인 번호 (1-indexed) */
+	line: number;
+	/** 인자 원본 텍스트 */
+	arguments: string[];
+	/** defer 문으로 호출되었는지 여부 */
+	deferred: boolean;
+	/** 호출 결과를 대입한 변수 이름 (`f, err := Open()` -> ["f", "err"]) */
+	assignedTo?: string[];
+	/** 호출 결과를 그대로 return 하는지 여부 */
+	returned?: boolean;
+}
+
+/**
+ * 여러 파일에 선언된 ID를 가리켜 연결하지 못한 참조 (file-scoped 충돌 정책)
+ */
+export interface AmbiguousReference {
+	/** 참조한 원래 노드 ID */
+	target: string;
+	/** 엣지 타입 */
+	type: string;
+	/** 같은 ID로 선언된 파일별 노드 ID */
+	candidates: string[];
+	/** 참조 라인 번호 (엣지에 기록된 경우) */
+	line?: number;
+}
+
+// ===== DIAGNOSTIC TYPES =====
+
+export type DiagnosticSeverity = "error" | "warning" | "info";
+
+/**
+ * 그래프 검사 결과 진단
+ */
+export interface SemanticDiagnostic {
+	/** 기계 판독용 규칙 ID (예: "panics") */
+	ruleId: string;
+	/** 심각도 */
+	severity: DiagnosticSeverity;
+	/** 사람이 읽을 메시지 */
+	message: string;
+	/** 관련 노드 ID */
+	nodeId?: string;
+	/** 관련 파일 경로 */
+	filePath?: string;
+	/** 관련 라인 번호 */
+	line?: number;
+	/** 규칙별 추가 정보 */
+	metadata?: Record<string, any>;
+	/** @suppress로 억제되었는지 여부 (억제된 진단은 실패로 집계하지 않음) */
+	suppressed?: boolean;
+}
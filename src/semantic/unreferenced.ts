@@ -0,0 +1,82 @@
+/**
+ * Unreferenced Symbols
+ * 분석 대상 안에서 참조되지 않는 공개 심볼 탐지 (데드 코드 힌트)
+ */
+
+import { isDependencyEdge } from "./impact";
+import type { SemanticGraph } from "./SemanticGraph";
+import { isTestNode } from "./test-files";
+import type { SemanticNode } from "./types";
+
+/**
+ * 미참조 심볼 탐지 옵션
+ */
+export interface UnreferencedOptions {
+	/** 진입점 파일 (여기 선언된 심볼에서 닿는 심볼은 사용 중으로 봄) */
+	rootFiles?: string[];
+	/** 검사할 심볼 태그 (기본: ["public-api"]) */
+	tags?: string[];
+	/** 참조로 볼 엣지 타입 (기본: contains/declares를 제외한 모든 타입) */
+	edgeTypes?: string[];
+}
+
+/**
+ * 분석 대상 안에서 쓰이지 않는 공개 심볼 찾기 (ID 순)
+ *
+ * 다른 파일의 심볼에서 들어오는 참조 엣지가 없고, 진입점 파일의 심볼에서
+ * 전이적으로 닿지도 않는 심볼을 반환한다. 결과는 분석한 파일 집합만
+ * 반영하므로, 분석 대상 밖의 호출자(다른 저장소, 리플렉션, 외부 클라이언트)가
+ * 쓰는 심볼도 미참조로 보고될 수 있다.
+ */
+export function findUnreferenced(
+	graph: SemanticGraph,
+	options: UnreferencedOptions = {},
+): SemanticNode[] {
+	const tags = options.tags ?? ["public-api"];
+	const follows = (type: string) =>
+		options.edgeTypes
+			? options.edgeTypes.includes(type)
+			: isDependencyEdge(type);
+
+	const rootFiles = new Set(options.rootFiles ?? []);
+	const used = new Set<string>();
+	const queue: string[] = [];
+	for (const node of graph.nodes.values()) {
+		if (rootFiles.has(node.filePath)) {
+			used.add(node.id);
+			queue.push(node.id);
+		}
+	}
+	while (queue.length > 0) {
+		const id = queue.shift() as string;
+		for (const edge of graph.getOutgoingEdges(id)) {
+			if (!follows(edge.type) || used.has(edge.to)) continue;
+			used.add(edge.to);
+			queue.push(edge.to);
+		}
+	}
+
+	const unreferenced: SemanticNode[] = [];
+	for (const node of graph.nodes.values()) {
+		// 패키지는 심볼을 묶는 단위일 뿐 직접 참조되지 않음
+		if (node.kind === "package") continue;
+		// 테스트 코드는 운영 코드의 데드 코드 후보가 아님
+		if (isTestNode(node)) continue;
+		if (!tags.some((tag) => node.semanticTags.includes(tag))) continue;
+		if (used.has(node.id)) continue;
+
+		const referenced = graph.getIncomingEdges(node.id).some((edge) => {
+			const source = graph.getNode(edge.from);
+			return (
+				follows(edge.type) &&
+				source !== undefined &&
+				source.filePath !== node.filePath
+			);
+		});
+		if (!referenced) unreferenced.push(node);
+	}
+
+	return unreferenced.sort((a, b) =>
+		a.id < b.id ? -1 : a.id > b.id ? 1 : 0,
+	);
+}
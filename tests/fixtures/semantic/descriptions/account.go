@@ -0,0 +1,30 @@
+// Package account manages customer accounts
+package account
+
+// Account is a customer account
+//
+// @semantic-tags: account-struct, public-api
+// @description: 고객 계정
+type Account struct {
+	ID int64
+}
+
+// OpenAccount opens a new account
+//
+// @semantic-tags: constructor-function, public-api
+func OpenAccount() *Account {
+	return &Account{}
+}
+
+// CloseAccount closes an account
+//
+// @semantic-tags: close-function, public-api
+// @description:
+func CloseAccount(a *Account) error {
+	return nil
+}
+
+// normalize is internal and needs no description
+//
+// @semantic-tags: helper
+func normalize() {}
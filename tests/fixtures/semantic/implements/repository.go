@@ -0,0 +1,68 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+)
+
+// User is a stored user
+type User struct {
+	ID    int64
+	Email string
+}
+
+// Reader looks users up
+type Reader interface {
+	GetByID(ctx context.Context, id int64) (*User, error)
+	Count(ctx context.Context) (int64, error)
+}
+
+// UserRepository defines user data access operations
+type UserRepository interface {
+	Reader
+	Create(ctx context.Context, user *User) error
+	Delete(ctx context.Context, id int64) error
+}
+
+// SQLUserRepository stores users in a SQL database
+type SQLUserRepository struct {
+	db *sql.DB
+}
+
+func (r *SQLUserRepository) Create(ctx context.Context, u *User) error {
+	return nil
+}
+
+func (r *SQLUserRepository) GetByID(ctx context.Context, userID int64) (*User, error) {
+	return nil, nil
+}
+
+func (r *SQLUserRepository) Delete(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (r *SQLUserRepository) Count(ctx context.Context) (int64, error) {
+	return 0, nil
+}
+
+// StaticReader serves a fixed user list
+type StaticReader []User
+
+func (s StaticReader) GetByID(ctx context.Context, id int64) (*User, error) {
+	return nil, nil
+}
+
+func (s StaticReader) Count(ctx context.Context) (int64, error) {
+	return int64(len(s)), nil
+}
+
+// AuditLog only records deletions
+type AuditLog struct{}
+
+func (a *AuditLog) Delete(ctx context.Context, id int64) error {
+	return nil
+}
+
+func (a *AuditLog) Count(ctx context.Context) (int, error) {
+	return 0, nil
+}
@@ -0,0 +1,16 @@
+import { defineService } from "./runtime";
+
+/**
+ * @semantic-tags: billing, public-api
+ * @description: Issues and settles invoices
+ */
+export const billing = defineService("billing", {
+	version: 2,
+});
+
+export const users = defineService("users", {
+	setup() {
+		// 함수 본문 안의 호출은 심볼로 보지 않음
+		return defineService("users-internal", {});
+	},
+});
@@ -0,0 +1,8 @@
+package user
+
+// User is a registered account
+// @semantic-tags: model
+type User struct {
+	ID    int
+	Email string
+}
@@ -0,0 +1,13 @@
+package user
+
+// Repository persists users
+type Repository struct{}
+
+// Save stores a user
+func (r *Repository) Save(u *User) error {
+	return nil
+}
+
+func newRepository() *Repository {
+	return &Repository{}
+}
@@ -0,0 +1,20 @@
+package user
+
+// Service coordinates user workflows
+type Service struct {
+	repo *Repository
+}
+
+// NewService wires the service
+func NewService() *Service {
+	return &Service{repo: newRepository()}
+}
+
+// Register validates and stores a user
+// @semantic-tags: public-api
+func (s *Service) Register(u *User) error {
+	if err := validate(u); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package user
+
+import "errors"
+
+func validate(u *User) error {
+	if u.Email == "" {
+		return errors.New("email required")
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package report
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Namer names things
+type Namer interface {
+	Name() string
+}
+
+// Formatter renders report rows
+type Formatter struct {
+	sep string
+}
+
+// Join uses the receiver field
+func (f *Formatter) Join(parts []string) string {
+	return strings.Join(parts, f.sep)
+}
+
+// Title never touches f and could be a package function
+func (f *Formatter) Title(title string) string {
+	return strings.ToUpper(title)
+}
+
+// Header uses the receiver only inside a closure
+func (f Formatter) Header(columns []string) string {
+	render := func(column string) string {
+		return column + f.sep
+	}
+	return render(columns[0])
+}
+
+// Name is required by Namer, so it must stay a method
+func (f Formatter) Name() string {
+	return "formatter"
+}
+
+// Version ignores an unnamed receiver on purpose
+func (Formatter) Version() string {
+	return "v1"
+}
+
+// Debug ignores a blank receiver on purpose
+func (_ *Formatter) Debug(value int) string {
+	return fmt.Sprint(value)
+}
+
+// Label only mentions f as a field name, not the receiver
+func (f *Formatter) Label(other struct{ f string }) string {
+	return other.f
+}
@@ -0,0 +1,57 @@
+/**
+ * Semantic Analyzer Concurrency Benchmark
+ * 생성한 Go 파일 묶음을 worker thread 수별로 분석해 소요 시간 비교
+ *
+ * 실행: npx ts-node tests/performance/semantic-analyzer.benchmark.ts [파일 수]
+ *
+ * workers=1은 메인 스레드에서만 파싱하는 기준값이다. 파싱은 worker마다
+ * 다른 코어에서 돌기 때문에 코어 수까지는 거의 선형으로 빨라지고, 남는
+ * 시간은 worker 시작과 메인 스레드의 병합 비용이다. 각 회차는 캐시 없이
+ * 새 분석기로 수행한다.
+ */
+
+import { mkdtemp, rm } from "node:fs/promises";
+import os from "node:os";
+import path from "node:path";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { generateFiles } from "./semantic-fixtures";
+
+const FILE_COUNT = Number(process.argv[2] ?? "400");
+const ROUNDS = 3;
+
+async function measure(root: string, workers: number): Promise<number> {
+	let best = Number.POSITIVE_INFINITY;
+	for (let round = 0; round < ROUNDS; round++) {
+		const analyzer = new SemanticAnalyzer({ projectRoot: root, workers });
+		const start = process.hrtime.bigint();
+		await analyzer.analyzeDirectory(root);
+		const elapsed = Number(process.hrtime.bigint() - start) / 1e6;
+		best = Math.min(best, elapsed);
+	}
+	return best;
+}
+
+async function main(): Promise<void> {
+	const root = await mkdtemp(path.join(os.tmpdir(), "semantic-bench-"));
+	try {
+		await generateFiles(root, FILE_COUNT);
+		console.log(`📊 ${FILE_COUNT}개 파일, 최선 ${ROUNDS}회 기준`);
+
+		const sizes = [1, 2, 4, Math.max(1, os.cpus().length)].filter(
+			(size, index, all) => all.indexOf(size) === index,
+		);
+		const baseline = await measure(root, sizes[0]);
+		for (const size of sizes) {
+			const elapsed = size === sizes[0] ? baseline : await measure(root, size);
+			const speedup = (baseline / elapsed).toFixed(2);
+			console.log(`  workers=${size}: ${elapsed.toFixed(1)}ms (x${speedup})`);
+		}
+	} finally {
+		await rm(root, { recursive: true, force: true });
+	}
+}
+
+main().catch((error) => {
+	console.error("❌ 벤치마크 실패:", error);
+	process.exit(1);
+});
@@ -0,0 +1,37 @@
+/**
+ * Semantic Benchmark Fixtures
+ * 시맨틱 분석기 벤치마크용 합성 Go 파일 생성
+ */
+
+import { mkdir, writeFile } from "node:fs/promises";
+import path from "node:path";
+
+/**
+ * 호출 사슬을 이루는 합성 Go 파일 생성
+ */
+export async function generateFiles(
+	root: string,
+	count: number,
+): Promise<void> {
+	for (let i = 0; i < count; i++) {
+		const pkg = `pkg${i % 10}`;
+		const dir = path.join(root, pkg);
+		await mkdir(dir, { recursive: true });
+
+		const functions = Array.from({ length: 20 }, (_, j) => {
+			const call = j < 19 ? `\tF${i}_${j + 1}(n)\n` : "";
+			return [
+				"// @semantic-tags: generated",
+				`func F${i}_${j}(n int) int {`,
+				"\tif n > 0 {",
+				`${call}\t}`,
+				"\treturn n",
+				"}",
+			].join("\n");
+		});
+		await writeFile(
+			path.join(dir, `file${i}.go`),
+			`package ${pkg}\n\n${functions.join("\n\n")}\n`,
+		);
+	}
+}
@@ -0,0 +1,84 @@
+/**
+ * Semantic Analyzer Memory Benchmark
+ * 파일 수를 늘려 가며 분석할 때의 최대 RSS 비교
+ *
+ * 실행: npm run benchmark:semantic-memory -- [최대 파일 수]
+ *
+ * 분석기는 파일마다 추출이 끝나면 구문 트리를 버리므로 파일 수가 늘어도
+ * 최대 RSS는 거의 일정해야 한다. 그래프 노드/엣지는 파일 수에 비례해
+ * 늘어나므로 파일당 증가량(KB/file)이 작게 유지되는지 함께 확인한다.
+ */
+
+import { mkdtemp, rm } from "node:fs/promises";
+import os from "node:os";
+import path from "node:path";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { generateFiles } from "./semantic-fixtures";
+
+const MAX_FILES = Number(process.argv[2] ?? "3200");
+const SAMPLE_INTERVAL_MS = 5;
+
+interface MemorySample {
+	files: number;
+	nodes: number;
+	peakRss: number;
+}
+
+/**
+ * 분석 중 RSS를 주기적으로 측정해 최댓값 반환
+ */
+async function measure(root: string, files: number): Promise<MemorySample> {
+	global.gc?.();
+	let peakRss = process.memoryUsage().rss;
+	const timer = setInterval(() => {
+		peakRss = Math.max(peakRss, process.memoryUsage().rss);
+	}, SAMPLE_INTERVAL_MS);
+
+	try {
+		const analyzer = new SemanticAnalyzer({ projectRoot: root });
+		const graph = await analyzer.analyzeDirectory(root);
+		peakRss = Math.max(peakRss, process.memoryUsage().rss);
+		return { files, nodes: graph.nodes.size, peakRss };
+	} finally {
+		clearInterval(timer);
+	}
+}
+
+async function main(): Promise<void> {
+	if (!global.gc) {
+		console.log("⚠️  --expose-gc 없이 실행하면 측정값의 편차가 커집니다");
+	}
+
+	const samples: MemorySample[] = [];
+	for (let files = MAX_FILES / 8; files <= MAX_FILES; files *= 2) {
+		const root = await mkdtemp(path.join(os.tmpdir(), "semantic-memory-"));
+		try {
+			await generateFiles(root, files);
+			samples.push(await measure(root, files));
+		} finally {
+			await rm(root, { recursive: true, force: true });
+		}
+	}
+
+	const mb = (bytes: number) => (bytes / 1024 / 1024).toFixed(1);
+	const [first] = samples;
+	console.log("📊 파일 수별 최대 RSS");
+	for (const sample of samples) {
+		const perFile =
+			sample === first
+				? "-"
+				: `${(
+						(sample.peakRss - first.peakRss) /
+							1024 /
+							(sample.files - first.files)
+					).toFixed(1)}KB/file`;
+		console.log(
+			`  files=${sample.files} nodes=${sample.nodes}: ${mb(sample.peakRss)}MB (${perFile})`,
+		);
+	}
+}
+
+main().catch((error) => {
+	console.error("❌ 벤치마크 실패:", error);
+	process.exit(1);
+});
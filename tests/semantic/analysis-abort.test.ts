@@ -0,0 +1,102 @@
+/**
+ * Analysis Abort Tests
+ * 중단 신호로 디렉토리 분석을 멈추고 부분 결과를 돌려받는 테스트
+ */
+
+import { mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import type { LanguageExtractor } from "../../src/semantic/extractors/LanguageExtractor";
+import {
+	AnalysisAbortedError,
+	SemanticAnalyzer,
+} from "../../src/semantic/SemanticAnalyzer";
+import { createTestNode } from "./semantic-test-helpers";
+
+describe("SemanticAnalyzer abort", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-abort-"));
+		for (const name of ["a", "b", "c", "d"]) {
+			await writeFile(join(projectDir, `${name}.txt`), name);
+		}
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	/** 지정한 횟수만큼 추출한 뒤 controller를 중단시키는 추출기 */
+	const createAbortingExtractor = (
+		controller: AbortController,
+		abortAfter: number,
+	) => {
+		const extracted: string[] = [];
+		const extractor: LanguageExtractor = {
+			name: "text",
+			language: "text",
+			extensions: ["txt"],
+			requiresTree: false,
+			extract: ({ sourceCode, filePath }) => {
+				extracted.push(filePath);
+				if (extracted.length === abortAfter) {
+					controller.abort(new Error("deadline exceeded"));
+				}
+				return {
+					filePath,
+					language: "text",
+					nodes: [createTestNode(sourceCode, { filePath })],
+					edges: [],
+				};
+			},
+		};
+		return { extractor, extracted };
+	};
+
+	it("should stop mid-scan and return the partial graph", async () => {
+		const controller = new AbortController();
+		const { extractor, extracted } = createAbortingExtractor(controller, 2);
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: projectDir,
+			extractors: [extractor],
+			concurrency: 1,
+		});
+
+		const error = await analyzer
+			.analyzeDirectory(projectDir, { signal: controller.signal })
+			.catch((caught: unknown) => caught);
+
+		expect(error).toBeInstanceOf(AnalysisAbortedError);
+		const aborted = error as AnalysisAbortedError;
+		expect(extracted).toEqual(["a.txt", "b.txt"]);
+		expect(Array.from(aborted.partial.nodes.keys()).sort()).toEqual([
+			"a",
+			"b",
+		]);
+		expect((aborted.reason as Error).message).toBe("deadline exceeded");
+	});
+
+	it("should not analyze any file when already aborted", async () => {
+		const controller = new AbortController();
+		const { extractor, extracted } = createAbortingExtractor(controller, 0);
+		const analyzer = new SemanticAnalyzer({ extractors: [extractor] });
+		controller.abort();
+
+		await expect(
+			analyzer.analyzeDirectory(projectDir, { signal: controller.signal }),
+		).rejects.toBeInstanceOf(AnalysisAbortedError);
+		expect(extracted).toEqual([]);
+	});
+
+	it("should analyze every file without a signal", async () => {
+		const controller = new AbortController();
+		const { extractor } = createAbortingExtractor(controller, 0);
+		const analyzer = new SemanticAnalyzer({ extractors: [extractor] });
+
+		const graph = await analyzer.analyzeDirectory(projectDir);
+
+		expect(graph.nodes.size).toBe(4);
+	});
+});
@@ -0,0 +1,73 @@
+/**
+ * Analysis Stream Tests
+ * 파일 단위 JSON Lines 스트리밍 분석 테스트
+ */
+
+import { mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+describe("SemanticAnalyzer.analyzeStream", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-stream-"));
+		await writeFile(
+			join(projectDir, "user.go"),
+			`package user
+
+import "errors"
+
+// @semantic-tags: public-api
+func Get() error {
+	return check()
+}
+
+func check() error {
+	return errors.New("x")
+}
+`,
+		);
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	it("should write one record per file and keep going after an error", async () => {
+		const analyzer = new SemanticAnalyzer({ projectRoot: projectDir });
+		const lines: string[] = [];
+
+		const summary = await analyzer.analyzeStream(
+			[join(projectDir, "user.go"), join(projectDir, "missing.go")],
+			{
+				write: (chunk) => {
+					lines.push(chunk);
+					return true;
+				},
+			},
+		);
+
+		expect(summary).toEqual({ files: 1, errors: 1 });
+		expect(lines.every((line) => line.endsWith("\n"))).toBe(true);
+
+		const [file, error] = lines.map((line) => JSON.parse(line));
+		expect(file).toMatchObject({ type: "file", filePath: "user.go" });
+		expect(file.symbols).toContainEqual({
+			id: "user.Get",
+			name: "Get",
+			kind: "function",
+			line: 6,
+			semanticTags: ["public-api"],
+		});
+		expect(file.dependencies).toEqual(
+			expect.arrayContaining([
+				{ from: "user.Get", to: "user.check", type: "calls" },
+				{ from: "user.go", to: "errors", type: "imports" },
+			]),
+		);
+		expect(error).toMatchObject({ type: "error", filePath: "missing.go" });
+	});
+});
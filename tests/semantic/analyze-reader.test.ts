@@ -0,0 +1,92 @@
+/**
+ * Analyze Reader Tests
+ * 파일 시스템 대신 스트림에서 읽은 소스 분석 테스트
+ */
+
+import { mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import path from "node:path";
+import { Readable } from "node:stream";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const BUFFER = `package shop
+
+// @description: 장바구니 합계
+// @semantic-tags: cart-function
+func CartTotal() int {
+	return 0
+}
+`;
+
+describe("analyzeReader", () => {
+	it("should match analyzeSource for streamed chunks", async () => {
+		const analyzer = new SemanticAnalyzer();
+		// 멀티바이트 문자 중간에서 청크를 나눈다
+		const bytes = Buffer.from(BUFFER, "utf-8");
+		const split = bytes.indexOf(Buffer.from("합", "utf-8")) + 1;
+
+		const extraction = await analyzer.analyzeReader(
+			Readable.from([bytes.subarray(0, split), bytes.subarray(split)]),
+			"shop/cart.go",
+		);
+
+		expect(extraction).toEqual(
+			await analyzer.analyzeSource(BUFFER, "shop/cart.go"),
+		);
+		expect(
+			extraction.nodes.find((node) => node.id === "shop.CartTotal"),
+		).toMatchObject({
+			filePath: "shop/cart.go",
+			description: "장바구니 합계",
+			semanticTags: ["cart-function"],
+		});
+	});
+
+	it("should prefer the given language over the file name", async () => {
+		const analyzer = new SemanticAnalyzer({ projectRoot: "/workspace" });
+
+		const extraction = await analyzer.analyzeReader(
+			Readable.from([BUFFER]),
+			path.join("/workspace", "shop", "Untitled-1"),
+			{ language: "go" },
+		);
+
+		expect(extraction.language).toBe("go");
+		expect(extraction.filePath).toBe("shop/Untitled-1");
+		expect(extraction.nodes.map((node) => node.id)).toContain(
+			"shop.CartTotal",
+		);
+		await expect(
+			analyzer.analyzeReader(Readable.from([BUFFER]), "Untitled-1"),
+		).rejects.toThrow("No extractor registered for file: Untitled-1");
+		await expect(
+			analyzer.analyzeReader(Readable.from([BUFFER]), "cart.go", {
+				language: "cobol",
+			}),
+		).rejects.toThrow("No extractor registered for language: cobol");
+	});
+});
+
+describe("withSyntaxTree", () => {
+	it("should re-parse a file on demand from its node path", async () => {
+		const root = await mkdtemp(path.join(tmpdir(), "semantic-tree-"));
+		try {
+			await writeFile(path.join(root, "cart.go"), BUFFER);
+			const analyzer = new SemanticAnalyzer({ projectRoot: root });
+
+			const functions = await analyzer.withSyntaxTree("cart.go", (tree) =>
+				tree.rootNode
+					.descendantsOfType("function_declaration")
+					.map((node) => node?.childForFieldName("name")?.text),
+			);
+
+			expect(functions).toEqual(["CartTotal"]);
+			await expect(
+				analyzer.withSyntaxTree("notes.txt", () => undefined),
+			).rejects.toThrow("No syntax tree parser for file: notes.txt");
+		} finally {
+			await rm(root, { recursive: true, force: true });
+		}
+	});
+});
@@ -0,0 +1,55 @@
+/**
+ * Analyzer Concurrency Tests
+ * 작업자 수와 관계없이 같은 그래프를 만드는지 확인하는 테스트
+ */
+
+import { mkdir, mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+describe("SemanticAnalyzer concurrency", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-concurrency-"));
+		await mkdir(join(projectDir, "user"));
+		for (let i = 0; i < 12; i++) {
+			const next = i + 1 < 12 ? `\tStep${i + 1}()\n` : "";
+			await writeFile(
+				join(projectDir, "user", `step${i}.go`),
+				`package user\n\nfunc Step${i}() {\n${next}}\n`,
+			);
+		}
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	const analyze = (concurrency: number) => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: projectDir,
+			concurrency,
+		});
+		return analyzer.analyzeDirectory(projectDir);
+	};
+
+	it("should merge results in file path order for any pool size", async () => {
+		const sequential = await analyze(1);
+		const parallel = await analyze(8);
+
+		expect(Array.from(parallel.nodes.keys())).toEqual(
+			Array.from(sequential.nodes.keys()),
+		);
+		expect(parallel.edges).toEqual(sequential.edges);
+		expect(parallel.hasEdge("user.Step3", "user.Step4", "calls")).toBe(true);
+	});
+
+	it("should reject an invalid pool size", () => {
+		expect(() => new SemanticAnalyzer({ concurrency: 0 })).toThrow(
+			"Invalid concurrency: 0",
+		);
+	});
+});
@@ -0,0 +1,106 @@
+/**
+ * Annotation Parser Plugin Tests
+ * 분석기별 사용자 정의 어노테이션 파서 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	type AnnotationParser,
+	createDirectiveParser,
+	parseDocAnnotations,
+	stripCommentMarkers,
+} from "../../src/semantic/annotations";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+// GetUser loads a user
+//
+// @semantic-tags: read-method, public-api
+// @description: 사용자 조회
+// @owner: identity-team
+// @deprecated-since: v2.3
+func GetUser() {}
+`;
+
+const ownerParser = createDirectiveParser("owner", (values) => values[0]);
+
+const deprecatedSinceParser: AnnotationParser = {
+	name: "deprecatedSince",
+	parse: ({ directives }) => {
+		const value = directives.find(
+			(directive) => directive.name === "deprecated-since",
+		)?.value;
+		const match = value?.match(/^v(\d+)\.(\d+)$/);
+		return match
+			? { major: Number(match[1]), minor: Number(match[2]) }
+			: undefined;
+	},
+};
+
+describe("annotation parsers", () => {
+	it("should build tags and description from the built-in parsers", () => {
+		const doc = parseDocAnnotations(
+			stripCommentMarkers(SOURCE.split("\n").slice(2, 8).join("\n")),
+		);
+
+		expect(doc).toMatchObject({
+			semanticTags: ["read-method", "public-api"],
+			description: "사용자 조회",
+			data: undefined,
+		});
+	});
+
+	it("should attach plugin results to the symbol", async () => {
+		const analyzer = new SemanticAnalyzer({
+			annotationParsers: [ownerParser],
+		});
+		analyzer.registerAnnotationParser(deprecatedSinceParser);
+
+		const { nodes } = await analyzer.analyzeSource(SOURCE, "user/user.go");
+		const node = nodes.find((candidate) => candidate.id === "user.GetUser");
+
+		expect(node?.semanticTags).toEqual(["read-method", "public-api"]);
+		expect(node?.metadata.annotationData).toEqual({
+			owner: "identity-team",
+			deprecatedSince: { major: 2, minor: 3 },
+		});
+	});
+
+	it("should keep registrations per analyzer instance", async () => {
+		const withPlugin = new SemanticAnalyzer({
+			annotationParsers: [ownerParser],
+		});
+		const without = new SemanticAnalyzer();
+
+		const find = async (analyzer: SemanticAnalyzer) =>
+			(await analyzer.analyzeSource(SOURCE, "user/user.go")).nodes.find(
+				(candidate) => candidate.id === "user.GetUser",
+			);
+
+		expect((await find(withPlugin))?.metadata.annotationData).toEqual({
+			owner: "identity-team",
+		});
+		expect((await find(without))?.metadata.annotationData).toBeUndefined();
+	});
+
+	it("should let a plugin replace a built-in parser", async () => {
+		const analyzer = new SemanticAnalyzer({
+			annotationParsers: [
+				createDirectiveParser(
+					"semantic-tags",
+					(values) =>
+						values.flatMap((value) =>
+							value.split(",").map((tag) => tag.trim().toUpperCase()),
+						),
+				),
+			],
+		});
+
+		const { nodes } = await analyzer.analyzeSource(SOURCE, "user/user.go");
+
+		expect(
+			nodes.find((candidate) => candidate.id === "user.GetUser")?.semanticTags,
+		).toEqual(["READ-METHOD", "PUBLIC-API"]);
+	});
+});
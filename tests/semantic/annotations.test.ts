@@ -0,0 +1,52 @@
+/**
+ * Doc Annotation Tests
+ * 여러 줄에 걸친 @semantic-tags 파싱 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	parseDocAnnotations,
+	parseSemanticTags,
+	stripCommentMarkers,
+} from "../../src/semantic/annotations";
+
+const lines = (comment: string) => stripCommentMarkers(comment);
+
+describe("parseSemanticTags", () => {
+	it("should accumulate a tag list wrapped across comment lines", () => {
+		const doc = lines(`// UserService handles users
+// @semantic-tags: user-service, user-domain,
+//   public-api ,  audited,
+//   pii
+// @description: 사용자 서비스`);
+
+		expect(parseDocAnnotations(doc)).toMatchObject({
+			semanticTags: [
+				"user-service",
+				"user-domain",
+				"public-api",
+				"audited",
+				"pii",
+			],
+			description: "사용자 서비스",
+		});
+	});
+
+	it("should merge repeated prefixes and drop duplicates in first-seen order", () => {
+		const doc = lines(`// @semantic-tags: user-domain, public-api
+// @semantic-tags: audited, user-domain,,
+// @semantic-tags:
+// Plain prose after the tags is not a tag.`);
+
+		expect(parseSemanticTags(doc)).toEqual([
+			"user-domain",
+			"public-api",
+			"audited",
+		]);
+	});
+
+	it("should treat a tag line without tags as empty", () => {
+		expect(parseSemanticTags(["@semantic-tags:"])).toEqual([]);
+		expect(parseSemanticTags(["@semantic-tags:", "a, b"])).toEqual(["a", "b"]);
+	});
+});
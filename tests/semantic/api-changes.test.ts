@@ -0,0 +1,129 @@
+/**
+ * API Change Report Tests
+ * 두 분석 결과의 공개 API 변경 비교 테스트
+ */
+
+import { readFile } from "node:fs/promises";
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { diffApi, renderApiChangeMarkdown } from "../../src/semantic/api-changes";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("diffApi", () => {
+	const analyze = async (source: string) => {
+		const analyzer = new SemanticAnalyzer();
+		return analyzer.buildGraph([
+			await analyzer.analyzeSource(source, "user/user.go"),
+		]);
+	};
+
+	it("should report renamed, untagged and re-signed demo symbols", async () => {
+		const original = await readFile(DEMO_USER, "utf-8");
+		const changed = original
+			.replace(
+				"func (s *UserService) GetUser(",
+				"func (s *UserService) FetchUser(",
+			)
+			.replace(
+				"// @semantic-tags: delete-method, public-api",
+				"// @semantic-tags: delete-method",
+			)
+			.replace(
+				"func (s *UserService) ListUsers(ctx context.Context, limit, offset int)",
+				"func (s UserService) ListUsers(ctx context.Context, limit int)",
+			);
+
+		const report = diffApi(await analyze(original), await analyze(changed));
+
+		expect(report.added.map((symbol) => symbol.id)).toEqual([
+			"user.UserService.FetchUser",
+		]);
+		expect(report.removed).toEqual([
+			{
+				id: "user.UserService.GetUser",
+				name: "GetUser",
+				kind: "method",
+				filePath: "user/user.go",
+				line: 70,
+				semanticTags: ["read-method", "public-api"],
+				signature:
+					"func (s *UserService) GetUser(ctx context.Context, id int64) (*User, error)",
+			},
+		]);
+		expect(report.tagsChanged).toEqual([
+			{
+				id: "user.UserService.DeleteUser",
+				kind: "method",
+				before: ["delete-method", "public-api"],
+				after: ["delete-method"],
+				added: [],
+				removed: ["public-api"],
+			},
+		]);
+		expect(report.signatureChanged).toEqual([
+			{
+				id: "user.UserService.ListUsers",
+				kind: "method",
+				before:
+					"func (s *UserService) ListUsers(ctx context.Context, limit, offset int) ([]*User, error)",
+				after:
+					"func (s UserService) ListUsers(ctx context.Context, limit int) ([]*User, error)",
+			},
+		]);
+
+		// 같은 입력이면 직렬화 결과도 같아야 커밋/게시할 수 있다
+		const again = diffApi(await analyze(original), await analyze(changed));
+		expect(JSON.stringify(again)).toBe(JSON.stringify(report));
+	});
+
+	it("should ignore symbols that were never public", async () => {
+		const report = diffApi(
+			await analyze("package user\n\nfunc helper(a int) {}\n"),
+			await analyze("package user\n\nfunc helper(a, b int) {}\n"),
+		);
+
+		expect(report).toEqual({
+			added: [],
+			removed: [],
+			tagsChanged: [],
+			signatureChanged: [],
+		});
+		expect(
+			diffApi(
+				await analyze("package user\n\nfunc helper(a int) {}\n"),
+				await analyze("package user\n\nfunc helper(a, b int) {}\n"),
+				{ tags: [] },
+			).signatureChanged.map((change) => change.after),
+		).toEqual(["func helper(a, b int)"]);
+	});
+
+	it("should render a markdown summary", async () => {
+		const report = diffApi(
+			await analyze(
+				"package user\n\n// @semantic-tags: public-api\nfunc Get() {}\n",
+			),
+			await analyze(
+				"package user\n\n// @semantic-tags: public-api, read-method\nfunc Get(id int) {}\n",
+			),
+		);
+
+		expect(renderApiChangeMarkdown(report)).toBe(
+			[
+				"## API changes",
+				"",
+				"### Tags changed (1)",
+				"",
+				"- `user.Get`: +read-method",
+				"",
+				"### Signature changed (1)",
+				"",
+				"- `user.Get`",
+				"  - before: `func Get()`",
+				"  - after: `func Get(id int)`",
+				"",
+			].join("\n"),
+		);
+	});
+});
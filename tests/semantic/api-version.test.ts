@@ -0,0 +1,81 @@
+/**
+ * API Version Tests
+ * @api-version 그룹핑 및 버전 일관성 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { groupByApiVersion } from "../../src/semantic/api-version";
+import { checkVersionConsistency } from "../../src/semantic/checks/version-consistency";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+// @api-version v1
+type LegacyStore struct{}
+
+func (s *LegacyStore) Load(id int) error {
+	return nil
+}
+
+type UserAPI struct{}
+
+// FindUser serves GET /v1/users/{id}
+// @api-version v1
+func (a *UserAPI) FindUser(id int) error {
+	return nil
+}
+
+// @api-version v1, v2
+func LoadUser(id int) error {
+	return nil
+}
+
+// GetUserV2 serves GET /v2/users/{id}
+// @api-version v2
+func (a *UserAPI) GetUserV2(id int) error {
+	LoadUser(id)
+	return a.FindUser(id)
+}
+`;
+
+describe("API versions", () => {
+	const analyze = async () => {
+		const analyzer = new SemanticAnalyzer();
+		return analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+	};
+
+	it("should group symbols by version, inheriting from the receiver type", async () => {
+		const groups = groupByApiVersion(await analyze());
+
+		expect(Array.from(groups.keys())).toEqual(["v1", "v2"]);
+		expect(groups.get("v1")?.map((n) => n.id)).toEqual([
+			"user.LegacyStore",
+			"user.LegacyStore.Load",
+			"user.LoadUser",
+			"user.UserAPI.FindUser",
+		]);
+		expect(groups.get("v2")?.map((n) => n.id)).toEqual([
+			"user.LoadUser",
+			"user.UserAPI.GetUserV2",
+		]);
+	});
+
+	it("should flag a v2 handler calling a v1-only method", async () => {
+		const diagnostics = checkVersionConsistency(await analyze());
+
+		expect(diagnostics).toEqual([
+			expect.objectContaining({
+				ruleId: "api-version",
+				nodeId: "user.UserAPI.GetUserV2",
+				line: 27,
+				metadata: {
+					callee: "user.UserAPI.FindUser",
+					callerVersions: ["v2"],
+					calleeVersions: ["v1"],
+				},
+			}),
+		]);
+	});
+});
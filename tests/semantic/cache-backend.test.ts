@@ -0,0 +1,158 @@
+/**
+ * Cache Backend Tests
+ * 키 기반 공유 캐시 저장소의 적중/미스와 정리 흐름 테스트
+ */
+
+import { mkdir, mkdtemp, rm, unlink, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import {
+	type CacheBackend,
+	FileCacheBackend,
+} from "../../src/semantic/extraction-cache";
+import type { FileExtraction } from "../../src/semantic/extractors/LanguageExtractor";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+/** 호출 순서를 기록하는 메모리 백엔드 (put은 늦게 끝난다) */
+class MemoryBackend implements CacheBackend {
+	readonly entries = new Map<string, FileExtraction>();
+	readonly events: string[] = [];
+
+	async get(key: string): Promise<FileExtraction | undefined> {
+		const entry = this.entries.get(key);
+		this.events.push(entry ? "hit" : "miss");
+		return entry && structuredClone(entry);
+	}
+
+	async put(key: string, extraction: FileExtraction): Promise<void> {
+		await new Promise((resolve) => setTimeout(resolve, 10));
+		this.entries.set(key, extraction);
+		this.events.push("put");
+	}
+
+	async prune(liveKeys: ReadonlySet<string>): Promise<void> {
+		for (const key of Array.from(this.entries.keys())) {
+			if (!liveKeys.has(key)) this.entries.delete(key);
+		}
+		this.events.push(`prune:${liveKeys.size}`);
+	}
+}
+
+describe("SemanticAnalyzer with CacheBackend", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-backend-"));
+		await mkdir(join(projectDir, "user"));
+		await writeFile(
+			join(projectDir, "user", "user.go"),
+			"package user\n\nfunc Get() {\n\tload()\n}\n",
+		);
+		await writeFile(
+			join(projectDir, "user", "store.go"),
+			"package user\n\nfunc load() {}\n",
+		);
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	const analyze = (cacheBackend: CacheBackend) =>
+		new SemanticAnalyzer({
+			projectRoot: projectDir,
+			cacheBackend,
+			concurrency: 2,
+		}).analyzeDirectory(projectDir);
+
+	it("should miss, store, then hit across runs", async () => {
+		const backend = new MemoryBackend();
+
+		await analyze(backend);
+		expect(backend.events.sort()).toEqual([
+			"miss",
+			"miss",
+			"prune:2",
+			"put",
+			"put",
+		]);
+		// 모든 put이 끝난 뒤에 prune
+		expect(backend.entries.size).toBe(2);
+
+		backend.events.length = 0;
+		const graph = await analyze(backend);
+
+		expect(backend.events).toEqual(["hit", "hit", "prune:2"]);
+		expect(graph.hasEdge("user.Get", "user.load", "calls")).toBe(true);
+	});
+
+	it("should call prune last and drop entries of deleted files", async () => {
+		const backend = new MemoryBackend();
+		await analyze(backend);
+		expect(backend.events[backend.events.length - 1]).toBe("prune:2");
+
+		await writeFile(
+			join(projectDir, "user", "store.go"),
+			"package user\n\nfunc load() {}\n\nfunc Save() {}\n",
+		);
+		backend.events.length = 0;
+		const changed = await analyze(backend);
+		expect(backend.events.sort()).toEqual(["hit", "miss", "prune:2", "put"]);
+		expect(changed.hasNode("user.Save")).toBe(true);
+		expect(backend.entries.size).toBe(2);
+
+		await unlink(join(projectDir, "user", "store.go"));
+		await analyze(backend);
+		expect(backend.entries.size).toBe(1);
+	});
+
+	it("should not prune when the analysis is aborted", async () => {
+		const backend = new MemoryBackend();
+		await analyze(backend);
+		const controller = new AbortController();
+		controller.abort();
+
+		await expect(
+			new SemanticAnalyzer({
+				projectRoot: projectDir,
+				cacheBackend: backend,
+			}).analyzeDirectory(projectDir, { signal: controller.signal }),
+		).rejects.toThrow("Analysis aborted");
+		expect(backend.events.filter((e) => e.startsWith("prune"))).toHaveLength(
+			1,
+		);
+	});
+});
+
+describe("FileCacheBackend", () => {
+	let cacheDir: string;
+
+	beforeEach(async () => {
+		cacheDir = await mkdtemp(join(tmpdir(), "semantic-file-backend-"));
+	});
+
+	afterEach(async () => {
+		await rm(cacheDir, { recursive: true, force: true });
+	});
+
+	it("should store entries by key and prune stale ones", async () => {
+		const backend = new FileCacheBackend(cacheDir);
+		const extraction: FileExtraction = {
+			filePath: "user/user.go",
+			language: "go",
+			nodes: [],
+			edges: [],
+		};
+
+		expect(await backend.get("ab12")).toBeUndefined();
+		await backend.put("ab12", extraction);
+		await backend.put("cd34", extraction);
+		expect(await backend.get("ab12")).toEqual(extraction);
+
+		await backend.prune(new Set(["cd34"]));
+
+		expect(await backend.get("ab12")).toBeUndefined();
+		expect(await backend.get("cd34")).toEqual(extraction);
+	});
+});
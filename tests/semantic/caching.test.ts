@@ -0,0 +1,61 @@
+/**
+ * Cache Annotation Tests
+ * @cached 파싱 및 캐시 사용 현황 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { getCachePolicy, reportCacheUsage } from "../../src/semantic/caching";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+type UserService struct{}
+
+// GetUser loads a user by ID
+// @cached key=user ttl=60s
+func (s *UserService) GetUser(id int) error {
+	return nil
+}
+
+// ListUsers returns every user
+func (s *UserService) ListUsers() error {
+	return nil
+}
+
+// DeleteUser removes a user
+func (s *UserService) DeleteUser(id int) error {
+	return nil
+}
+`;
+
+describe("Cache annotations", () => {
+	const analyze = async () => {
+		const analyzer = new SemanticAnalyzer();
+		return analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+	};
+
+	it("should attach @cached key=user ttl=60s to the method", async () => {
+		const graph = await analyze();
+		const getUser = graph.getNode("user.UserService.GetUser");
+
+		expect(getUser && getCachePolicy(getUser)).toEqual({
+			key: "user",
+			ttl: "60s",
+			ttlMs: 60_000,
+			options: {},
+		});
+	});
+
+	it("should split read methods into cached and uncached", async () => {
+		const report = reportCacheUsage(await analyze());
+
+		expect(report.cached.map((n) => n.id)).toEqual([
+			"user.UserService.GetUser",
+		]);
+		expect(report.uncached.map((n) => n.id)).toEqual([
+			"user.UserService.ListUsers",
+		]);
+	});
+});
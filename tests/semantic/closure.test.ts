@@ -0,0 +1,90 @@
+/**
+ * Dependency Closure Tests
+ * 심볼의 전이적 의존 집합과 최단 경로 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { computeClosure } from "../../src/semantic/closure";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+// Route -> Handle -> GetUser -> Query <-> Conn 순환, Route -> Check -> Query
+const build = () =>
+	createTestGraph(
+		[
+			createTestNode("api.Route"),
+			createTestNode("api.Handle"),
+			createTestNode("auth.Check"),
+			createTestNode("user.UserService", { kind: "struct" }),
+			createTestNode("user.UserService.GetUser", { kind: "method" }),
+			createTestNode("db.Query"),
+			createTestNode("db.Conn"),
+		],
+		[
+			["api.Route", "api.Handle"],
+			["api.Route", "auth.Check"],
+			["api.Handle", "user.UserService.GetUser"],
+			["auth.Check", "db.Query"],
+			["user.UserService", "user.UserService.GetUser", "contains"],
+			["user.UserService.GetUser", "db.Query"],
+			["db.Query", "db.Conn"],
+			["db.Conn", "db.Query"],
+		],
+	);
+
+describe("closure", () => {
+	it("should collect each dependency once with its shortest path", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		expect(
+			engine
+				.closure("api.Route", { includePaths: true })
+				.map(({ node, depth, path }) => [node.id, depth, path]),
+		).toEqual([
+			["api.Handle", 1, ["api.Route", "api.Handle"]],
+			["auth.Check", 1, ["api.Route", "auth.Check"]],
+			["db.Query", 2, ["api.Route", "auth.Check", "db.Query"]],
+			[
+				"user.UserService.GetUser",
+				2,
+				["api.Route", "api.Handle", "user.UserService.GetUser"],
+			],
+			["db.Conn", 3, ["api.Route", "auth.Check", "db.Query", "db.Conn"]],
+		]);
+		expect(engine.closure("api.Route", { maxDepth: 1 })).toEqual([
+			{ node: expect.objectContaining({ id: "api.Handle" }), depth: 1 },
+			{ node: expect.objectContaining({ id: "auth.Check" }), depth: 1 },
+		]);
+	});
+
+	it("should follow a type's members and reject bad input", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		expect(
+			engine.closure("user.UserService").map((entry) => entry.node.id),
+		).toEqual(["db.Query", "db.Conn"]);
+		expect(() => engine.closure("api.Missing")).toThrow(
+			"Unknown symbol: api.Missing",
+		);
+		expect(() => engine.closure("api.Route", { maxDepth: -1 })).toThrow(
+			"Invalid depth: -1",
+		);
+	});
+
+	it("should walk long chains without recursion", () => {
+		const count = 50_000;
+		const ids = Array.from({ length: count }, (_, index) => `chain.N${index}`);
+		const graph = createTestGraph(
+			ids.map((id) => createTestNode(id)),
+			ids.slice(1).map((id, index): [string, string] => [ids[index], id]),
+		);
+
+		const closure = computeClosure(graph, [ids[0]]);
+
+		expect(closure).toHaveLength(count - 1);
+		expect(closure[count - 2]).toMatchObject({
+			node: { id: ids[count - 1] },
+			depth: count - 1,
+		});
+	});
+});
@@ -0,0 +1,69 @@
+/**
+ * CODEOWNERS Tests
+ * 소유자 지정 및 조회 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	assignOwners,
+	parseCodeOwners,
+	resolveOwners,
+} from "../../src/semantic/codeowners";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const CODEOWNERS = `# Default owners
+*                   @org/platform
+
+/services/user/     @team-user
+*.proto             @team-api @team-user
+/docs               # unowned
+`;
+
+describe("CODEOWNERS", () => {
+	const rules = parseCodeOwners(CODEOWNERS);
+
+	it("should parse rules and ignore comments", () => {
+		expect(rules.map((r) => r.pattern)).toEqual([
+			"*",
+			"/services/user/",
+			"*.proto",
+			"/docs",
+		]);
+		expect(rules[3].owners).toEqual([]);
+	});
+
+	it("should let the last matching rule win", () => {
+		expect(resolveOwners("services/user/user.go", rules)).toEqual([
+			"@team-user",
+		]);
+		expect(resolveOwners("services/billing/api/billing.proto", rules)).toEqual([
+			"@team-api",
+			"@team-user",
+		]);
+		expect(resolveOwners("cmd/main.go", rules)).toEqual(["@org/platform"]);
+		expect(resolveOwners("docs/guide/intro.md", rules)).toEqual([]);
+	});
+
+	it("should return symbols owned by a team", () => {
+		const graph = createTestGraph([
+			createTestNode("user.UserService", {
+				filePath: "services/user/service.go",
+			}),
+			createTestNode("user.User", { filePath: "services/user/model.go" }),
+			createTestNode("billing.Invoice", {
+				filePath: "services/billing/invoice.go",
+			}),
+		]);
+		assignOwners(graph, rules);
+
+		const engine = new SemanticQueryEngine(graph);
+		expect(engine.queryByOwner("@team-user").items.map((n) => n.id)).toEqual([
+			"user.User",
+			"user.UserService",
+		]);
+		expect(graph.getNode("billing.Invoice")?.metadata.owners).toEqual([
+			"@org/platform",
+		]);
+	});
+});
@@ -0,0 +1,135 @@
+/**
+ * Graph Compaction Tests
+ * re-export 체인 병합 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { compactGraph } from "../../src/semantic/compaction";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("compactGraph", () => {
+	const ts = (id: string, filePath: string, kind = "export") =>
+		createTestNode(id, { filePath, kind, language: "typescript", name: "User" });
+
+	const graph = createTestGraph(
+		[
+			ts("src/user/User.ts#User", "src/user/User.ts", "class"),
+			ts("src/user/index.ts#User", "src/user/index.ts"),
+			ts("src/index.ts#User", "src/index.ts"),
+			createTestNode("src/app.ts#main", {
+				filePath: "src/app.ts",
+				language: "typescript",
+			}),
+			createTestNode("src/user/service.ts#createUser", {
+				filePath: "src/user/service.ts",
+				language: "typescript",
+			}),
+		],
+		[
+			["src/index.ts#User", "src/user/index.ts#User", "re-exports"],
+			["src/user/index.ts#User", "src/user/User.ts#User", "re-exports"],
+			["src/app.ts#main", "src/index.ts#User", "references"],
+			["src/user/service.ts#createUser", "src/user/index.ts#User", "references"],
+			["src/user/service.ts#createUser", "src/user/User.ts#User", "calls"],
+			["src/user/User.ts#User", "src/app.ts#main", "references"],
+		],
+	);
+
+	it("should collapse a re-export chain into the original declaration", () => {
+		const compacted = compactGraph(graph);
+
+		expect(Array.from(compacted.nodes.keys()).sort()).toEqual([
+			"src/app.ts#main",
+			"src/user/User.ts#User",
+			"src/user/service.ts#createUser",
+		]);
+		expect(compacted.getNode("src/user/User.ts#User")?.metadata.aliases).toEqual(
+			["src/index.ts#User", "src/user/index.ts#User"],
+		);
+	});
+
+	it("should redirect all edges to the canonical node", () => {
+		const compacted = compactGraph(graph);
+		const edges = compacted.edges.map((e) => [e.from, e.to, e.type]);
+
+		expect(edges).toEqual([
+			["src/app.ts#main", "src/user/User.ts#User", "references"],
+			["src/user/service.ts#createUser", "src/user/User.ts#User", "references"],
+			["src/user/service.ts#createUser", "src/user/User.ts#User", "calls"],
+			["src/user/User.ts#User", "src/app.ts#main", "references"],
+		]);
+	});
+
+	it("should keep self-edges that existed before compaction", () => {
+		const recursive = createTestGraph(
+			[
+				ts("src/user/User.ts#User", "src/user/User.ts", "class"),
+				ts("src/user/index.ts#User", "src/user/index.ts"),
+			],
+			[
+				["src/user/index.ts#User", "src/user/User.ts#User", "re-exports"],
+				["src/user/User.ts#User", "src/user/User.ts#User", "references"],
+				["src/user/User.ts#User", "src/user/index.ts#User", "references"],
+			],
+		);
+
+		const edges = compactGraph(recursive).edges.map((e) => [
+			e.from,
+			e.to,
+			e.type,
+		]);
+
+		expect(edges).toEqual([
+			["src/user/User.ts#User", "src/user/User.ts#User", "references"],
+		]);
+	});
+
+	it("should not modify the source graph", () => {
+		compactGraph(graph);
+
+		expect(graph.nodes.size).toBe(5);
+		expect(graph.edges).toHaveLength(6);
+	});
+});
+
+describe("compactGraph on TypeScript re-exports", () => {
+	const files: Record<string, string> = {
+		"src/user/User.ts": "export default class User {}\n",
+		"src/user/index.ts": 'export { default as User } from "./User";\n',
+		"src/index.ts": 'export { User as Account } from "./user";\n',
+		"src/app.ts": [
+			'import { Account } from "./index";',
+			"",
+			"export function main() {}",
+			"",
+		].join("\n"),
+	};
+
+	it("should collapse a barrel chain extracted from source", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph(
+			await Promise.all(
+				Object.entries(files).map(([filePath, source]) =>
+					analyzer.analyzeSource(source, filePath),
+				),
+			),
+		);
+
+		expect(graph.getOutgoingEdges("src/index.ts#Account")).toEqual([
+			{
+				from: "src/index.ts#Account",
+				to: "src/user/index.ts#User",
+				type: "re-exports",
+				metadata: { specifier: "./user" },
+			},
+		]);
+
+		const compacted = compactGraph(graph);
+		expect(compacted.hasNode("src/index.ts#Account")).toBe(false);
+		expect(compacted.hasNode("src/user/index.ts#User")).toBe(false);
+		expect(compacted.getNode("src/user/User.ts#User")?.metadata).toMatchObject({
+			aliases: ["src/index.ts#Account", "src/user/index.ts#User"],
+		});
+	});
+});
@@ -0,0 +1,131 @@
+/**
+ * Symbol Complexity Tests
+ * 함수/메서드 크기와 순환 복잡도 지표 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import {
+	getComplexity,
+	rankByComplexity,
+	sortByComplexity,
+} from "../../src/semantic/complexity";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("complexity metrics", () => {
+	const analyzeDemo = () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		return analyzer.analyzeFiles([DEMO_USER]);
+	};
+
+	it("should score loops above a single error check in the demo", async () => {
+		const graph = await analyzeDemo();
+		const metrics = (id: string) => {
+			const node = graph.getNode(id);
+			return node ? getComplexity(node) : undefined;
+		};
+
+		expect(metrics("user.UserService.GetUserCount")).toEqual({
+			lines: 11,
+			statements: 6,
+			cyclomatic: 2,
+		});
+		expect(metrics("user.UserService.ListUsers")?.cyclomatic).toBe(4);
+		expect(metrics("user.UserService.SearchUsers")?.cyclomatic).toBe(4);
+		expect(metrics("user.User")).toBeUndefined();
+	});
+
+	it("should count case clauses and short-circuit operators", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const [go, python] = await Promise.all([
+			analyzer.analyzeSource(
+				[
+					"package shape",
+					"",
+					"func Classify(n int, ok bool) string {",
+					"\tswitch {",
+					"\tcase n < 0 && ok:",
+					"\t\treturn \"negative\"",
+					"\tcase n == 0 || !ok:",
+					"\t\treturn \"zero\"",
+					"\tdefault:",
+					"\t\treturn \"positive\"",
+					"\t}",
+					"}",
+					"",
+				].join("\n"),
+				"shape/shape.go",
+			),
+			analyzer.analyzeSource(
+				[
+					"def check(a, b):",
+					"    if a and b:",
+					"        return 1",
+					"    elif a or b:",
+					"        return 2",
+					"    return 3",
+					"",
+				].join("\n"),
+				"app/check.py",
+			),
+		]);
+
+		const find = (nodes: typeof go.nodes, name: string) =>
+			nodes.find((node) => node.name === name)?.metadata.complexity;
+
+		expect(find(go.nodes, "Classify")).toEqual({
+			lines: 10,
+			statements: 4,
+			cyclomatic: 5,
+		});
+		expect(find(python.nodes, "check")).toEqual({
+			lines: 6,
+			statements: 4,
+			cyclomatic: 5,
+		});
+	});
+
+	it("should sort symbol refs by any metric", async () => {
+		const graph = await analyzeDemo();
+		const refs = new SemanticQueryEngine(graph).findByTag("public-api");
+
+		const byCyclomatic = sortByComplexity(refs).map((ref) => ref.name);
+		expect(byCyclomatic.indexOf("ListUsers")).toBeLessThan(
+			byCyclomatic.indexOf("GetUserCount"),
+		);
+		expect(byCyclomatic.indexOf("SearchUsers")).toBeLessThan(
+			byCyclomatic.indexOf("GetUserCount"),
+		);
+		// 지표가 없는 타입 심볼은 뒤로 간다
+		expect(byCyclomatic.slice(-4).sort()).toEqual([
+			"User",
+			"UserRepository",
+			"UserService",
+			"user",
+		]);
+
+		const byLines = sortByComplexity(refs, "lines");
+		for (let i = 1; i < byLines.length; i++) {
+			expect(byLines[i - 1].complexity?.lines ?? -1).toBeGreaterThanOrEqual(
+				byLines[i].complexity?.lines ?? -1,
+			);
+		}
+	});
+
+	it("should rank graph symbols with a limit", async () => {
+		const ranked = rankByComplexity(await analyzeDemo(), "statements", 3);
+
+		expect(ranked).toHaveLength(3);
+		expect(ranked[0].complexity.statements).toBeGreaterThanOrEqual(
+			ranked[2].complexity.statements,
+		);
+		expect(ranked.map((entry) => entry.id)).not.toContain(
+			"user.UserService.GetUserCount",
+		);
+	});
+});
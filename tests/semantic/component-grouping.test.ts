@@ -0,0 +1,99 @@
+/**
+ * Component Grouping Tests
+ * 논리 컴포넌트 그룹핑 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { groupByComponent } from "../../src/semantic/component-grouping";
+import {
+	GLOB_CACHE_SIZE,
+	globToRegExp,
+	matchesGlob,
+} from "../../src/semantic/glob";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("groupByComponent", () => {
+	const graph = createTestGraph(
+		[
+			createTestNode("user.UserService", { filePath: "services/user/user.go" }),
+			createTestNode("user.User", { filePath: "services/user/model.go" }),
+			createTestNode("account.Account", {
+				filePath: "services/account/account.go",
+			}),
+			createTestNode("handler.Handle", { filePath: "web/handler/handler.go" }),
+			createTestNode("util.Log", { filePath: "pkg/util/log.go" }),
+		],
+		[
+			["handler.Handle", "user.UserService", "calls"],
+			["handler.Handle", "user.User", "references"],
+			["handler.Handle", "account.Account", "references"],
+			["user.UserService", "user.User", "references"],
+			["user.UserService", "account.Account", "calls"],
+			["account.Account", "util.Log", "calls"],
+		],
+	);
+
+	it("should collapse packages of one component into a single node", () => {
+		const components = groupByComponent(graph, {
+			components: {
+				identity: ["services/user", "services/account"],
+				frontend: ["web/**"],
+			},
+		});
+
+		expect(Array.from(components.nodes.keys()).sort()).toEqual([
+			"frontend",
+			"identity",
+			"pkg/util",
+		]);
+
+		const identity = components.getNode("identity");
+		expect(identity?.kind).toBe("component");
+		expect(identity?.metadata.packages).toEqual([
+			"services/account",
+			"services/user",
+		]);
+		expect(identity?.metadata.internalEdges).toBe(2);
+
+		const edge = components.edges.find(
+			(e) => e.from === "frontend" && e.to === "identity",
+		);
+		expect(edge?.type).toBe("depends_on");
+		expect(edge?.metadata).toEqual({
+			count: 3,
+			types: { calls: 1, references: 2 },
+		});
+	});
+
+	it("should drop unmapped packages when includeUnmapped is false", () => {
+		const components = groupByComponent(graph, {
+			components: { identity: ["services/*"] },
+			includeUnmapped: false,
+		});
+
+		expect(Array.from(components.nodes.keys())).toEqual(["identity"]);
+		expect(components.edges).toHaveLength(0);
+	});
+});
+
+describe("matchesGlob", () => {
+	it("should support single and double star patterns", () => {
+		expect(matchesGlob("services/user", "services/*")).toBe(true);
+		expect(matchesGlob("services/user/internal", "services/*")).toBe(false);
+		expect(matchesGlob("services/user/internal", "services/**")).toBe(true);
+		expect(matchesGlob("a/b/c.go", "**/*.go")).toBe(true);
+		expect(matchesGlob("c.go", "**/*.go")).toBe(true);
+	});
+
+	it("should evict least recently used patterns from the cache", () => {
+		const first = globToRegExp("evict/first/*");
+		const recent = globToRegExp("evict/recent/*");
+		globToRegExp("evict/first/*");
+		for (let i = 0; i < GLOB_CACHE_SIZE - 1; i++) {
+			globToRegExp(`evict/${i}/*`);
+		}
+
+		expect(globToRegExp("evict/first/*")).toBe(first);
+		expect(globToRegExp("evict/recent/*")).not.toBe(recent);
+	});
+});
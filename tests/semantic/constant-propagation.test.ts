@@ -0,0 +1,62 @@
+/**
+ * Constant Propagation Tests
+ * 상수로 참조된 플래그 키 해석 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { linkFeatureFlags } from "../../src/semantic/feature-flags";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const KEYS = `package flags
+
+const (
+	FlagNewUI = "new-ui"
+	// FlagRedesign is an alias kept for old callers
+	FlagRedesign = FlagNewUI
+)
+
+func Enabled(key string) bool {
+	return false
+}
+`;
+
+const RENDER = `package ui
+
+const searchFlag = \`beta-search\`
+
+func Render(user string) {
+	if flags.Enabled(flags.FlagNewUI) {
+		return
+	}
+	if flags.Enabled(flags.FlagRedesign) || flags.Enabled(searchFlag) {
+		return
+	}
+	flags.Enabled("dark-mode")
+	flags.Enabled(user)
+}
+`;
+
+describe("Constant propagation for flag keys", () => {
+	it("should resolve flag checks through constants to flag nodes", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(KEYS, "flags/keys.go"),
+			await analyzer.analyzeSource(RENDER, "ui/render.go"),
+		]);
+
+		expect(graph.getNode("flags.FlagNewUI")?.metadata.value).toBe("new-ui");
+		expect(linkFeatureFlags(graph)).toBe(3);
+
+		const flags = graph
+			.getOutgoingEdges("ui.Render", ["uses_flag"])
+			.map((edge) => [edge.to, edge.metadata?.expression]);
+		expect(flags).toEqual([
+			["flag:new-ui", "flags.FlagNewUI"],
+			["flag:beta-search", "searchFlag"],
+			["flag:dark-mode", '"dark-mode"'],
+		]);
+		expect(graph.getNode("ui.Render")?.metadata.unresolvedFlags).toEqual([
+			"user",
+		]);
+	});
+});
@@ -0,0 +1,121 @@
+/**
+ * Cycle Detection Tests
+ * 관계 타입 필터 기반 순환 탐지 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { detectCycles, findImportCycles } from "../../src/semantic/cycles";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const buildGraph = () =>
+	createTestGraph(
+		["user", "user_test", "billing", "orders"].map((id) =>
+			createTestNode(id, { kind: "package" }),
+		),
+		[
+			["user_test", "user", "imports"],
+			["user", "user_test", "tests"],
+			["billing", "orders", "imports"],
+			["orders", "billing", "imports"],
+		],
+	);
+
+describe("detectCycles", () => {
+	it("should ignore a cycle closed by a tests edge", () => {
+		const cycles = detectCycles(buildGraph());
+
+		expect(cycles.map((cycle) => cycle.nodes)).toEqual([
+			["billing", "orders"],
+		]);
+		expect(cycles[0].edges).toHaveLength(2);
+	});
+
+	it("should report partly structural cycles in any mode", () => {
+		const cycles = detectCycles(buildGraph(), { match: "any" });
+
+		expect(cycles.map((cycle) => cycle.nodes)).toEqual([
+			["billing", "orders"],
+			["user", "user_test"],
+		]);
+	});
+
+	it("should use configured structural types", () => {
+		const cycles = detectCycles(buildGraph(), {
+			structuralTypes: ["imports", "tests"],
+		});
+
+		expect(cycles).toHaveLength(2);
+		expect(detectCycles(buildGraph(), { structuralTypes: ["calls"] })).toEqual(
+			[],
+		);
+	});
+});
+
+describe("findImportCycles", () => {
+	it("should list every elementary cycle in order and self-loops separately", () => {
+		const graph = createTestGraph(
+			["a.go", "b.go", "c.go", "d.go", "gen.go"].map((id) =>
+				createTestNode(id, { kind: "file" }),
+			),
+			[
+				["a.go", "b.go", "imports"],
+				["b.go", "c.go", "imports"],
+				["c.go", "a.go", "imports"],
+				["b.go", "a.go", "imports"],
+				["c.go", "d.go", "imports"],
+				["d.go", "c.go", "calls"],
+				["gen.go", "gen.go", "imports"],
+			],
+		);
+
+		const report = findImportCycles(graph);
+
+		expect(report.cycles).toEqual([
+			["a.go", "b.go"],
+			["a.go", "b.go", "c.go"],
+		]);
+		expect(report.selfLoops).toEqual(["gen.go"]);
+		expect(report.truncated).toBe(false);
+		expect(findImportCycles(graph)).toEqual(report);
+	});
+
+	it("should stop at the cycle and length limits", () => {
+		const ids = ["a", "b", "c", "d"];
+		const graph = createTestGraph(
+			ids.map((id) => createTestNode(id)),
+			ids.flatMap((from) =>
+				ids
+					.filter((to) => to !== from)
+					.map((to): [string, string, string] => [from, to, "imports"]),
+			),
+		);
+
+		expect(findImportCycles(graph).cycles).toHaveLength(20);
+		expect(findImportCycles(graph, { maxCycles: 5 })).toMatchObject({
+			truncated: true,
+		});
+		expect(findImportCycles(graph, { maxCycles: 5 }).cycles).toHaveLength(5);
+		expect(findImportCycles(graph, { maxLength: 2 }).cycles).toEqual([
+			["a", "b"],
+			["a", "c"],
+			["a", "d"],
+			["b", "c"],
+			["b", "d"],
+			["c", "d"],
+		]);
+	});
+
+	it("should handle dependency chains deeper than the call stack", () => {
+		const depth = 20000;
+		const graph = createTestGraph(
+			Array.from({ length: depth }, (_, i) => createTestNode(`n${i}`)),
+			Array.from({ length: depth }, (_, i): [string, string, string] => [
+				`n${i}`,
+				`n${(i + 1) % depth}`,
+				"imports",
+			]),
+		);
+
+		expect(findImportCycles(graph).cycles).toHaveLength(1);
+	});
+});
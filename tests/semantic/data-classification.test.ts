@@ -0,0 +1,63 @@
+/**
+ * Data Classification Tests
+ * 민감 분류 타입의 로그/외부 호출 흐름 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkClassifiedDataFlows } from "../../src/semantic/checks/data-classification";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package billing
+
+// Card holds raw card data
+// @classification confidential
+type Card struct {
+	Number string
+}
+
+// Receipt is safe to share
+// @classification public
+type Receipt struct {
+	ID string
+}
+
+func Charge(card *Card, receipt Receipt) {
+	slog.Info("charging", "card", card.Number)
+	slog.Info("charged", "receipt", receipt)
+}
+
+func Send(cards ...Card) {
+	http.Post("https://audit.example.com", "application/json", cards)
+}
+`;
+
+describe("checkClassifiedDataFlows", () => {
+	it("should flag confidential data passed to log and HTTP calls", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "billing/card.go"),
+		]);
+
+		expect(graph.getNode("billing.Card")?.metadata.classification).toBe(
+			"confidential",
+		);
+		expect(graph.getNode("billing.Charge")?.metadata.parameters).toEqual([
+			{ name: "card", type: "*Card" },
+			{ name: "receipt", type: "Receipt" },
+		]);
+
+		const diagnostics = checkClassifiedDataFlows(graph);
+
+		expect(
+			diagnostics.map((d) => [d.nodeId, d.line, d.metadata?.sink]),
+		).toEqual([
+			["billing.Charge", 16, "log"],
+			["billing.Send", 21, "http"],
+		]);
+		expect(diagnostics[0].metadata).toMatchObject({
+			argument: "card.Number",
+			type: "billing.Card",
+			classification: "confidential",
+		});
+	});
+});
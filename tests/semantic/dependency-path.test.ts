@@ -0,0 +1,106 @@
+/**
+ * Dependency Path Tests
+ * 두 심볼 사이의 최단 의존 경로 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { findShortestPaths } from "../../src/semantic/dependency-path";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+// Handle -> CreateUser -> User, Handle -> Validate -> User, Repo <-> Conn 순환
+const build = () =>
+	createTestGraph(
+		[
+			createTestNode("api.Handle"),
+			createTestNode("user.CreateUser"),
+			createTestNode("user.Validate"),
+			createTestNode("user.User", { kind: "struct" }),
+			createTestNode("user.User.Save", { kind: "method" }),
+			createTestNode("db.Repo"),
+			createTestNode("db.Conn"),
+		],
+		[
+			["api.Handle", "user.CreateUser"],
+			["api.Handle", "user.Validate"],
+			["user.CreateUser", "user.User", "references"],
+			["user.CreateUser", "user.User", "calls"],
+			["user.Validate", "user.User", "references"],
+			["user.User", "user.User.Save", "contains"],
+			["user.User.Save", "db.Repo"],
+			["db.Repo", "db.Conn"],
+			["db.Conn", "db.Repo"],
+		],
+	);
+
+describe("dependency path", () => {
+	it("should return a one-hop type reference", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		expect(engine.path("user.CreateUser", "user.User")).toEqual({
+			nodes: ["user.CreateUser", "user.User"],
+			edgeTypes: ["calls"],
+		});
+		expect(
+			engine.path("user.CreateUser", "user.User", {
+				edgeTypes: ["references"],
+			}),
+		).toEqual({
+			nodes: ["user.CreateUser", "user.User"],
+			edgeTypes: ["references"],
+		});
+	});
+
+	it("should return every tied shortest path when asked", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		expect(engine.paths("api.Handle", "user.User", { all: true })).toEqual([
+			{
+				nodes: ["api.Handle", "user.CreateUser", "user.User"],
+				edgeTypes: ["calls", "calls"],
+			},
+			{
+				nodes: ["api.Handle", "user.CreateUser", "user.User"],
+				edgeTypes: ["calls", "references"],
+			},
+			{
+				nodes: ["api.Handle", "user.Validate", "user.User"],
+				edgeTypes: ["calls", "references"],
+			},
+		]);
+		expect(engine.paths("api.Handle", "user.User")).toHaveLength(1);
+	});
+
+	it("should return undefined when the target is unreachable", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		// contains는 의존 엣지가 아니므로 User에서 Save로 가지 않는다
+		expect(engine.path("user.User", "db.Repo")).toBeUndefined();
+		expect(engine.path("db.Repo", "user.User")).toBeUndefined();
+		expect(
+			engine.path("user.User", "db.Repo", {
+				edgeTypes: ["contains", "calls"],
+			}),
+		).toEqual({
+			nodes: ["user.User", "user.User.Save", "db.Repo"],
+			edgeTypes: ["contains", "calls"],
+		});
+	});
+
+	it("should terminate on cycles", () => {
+		const graph = build();
+
+		expect(findShortestPaths(graph, "db.Repo", "user.Validate")).toEqual([]);
+		expect(findShortestPaths(graph, "db.Conn", "db.Conn")).toEqual([
+			{ nodes: ["db.Conn"], edgeTypes: [] },
+		]);
+	});
+
+	it("should reject unknown symbols", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		expect(() => engine.path("user.CreateUser", "user.Missing")).toThrow(
+			"Unknown symbol: user.Missing",
+		);
+	});
+});
@@ -0,0 +1,59 @@
+/**
+ * Deprecation Timeline Tests
+ * @deprecated 제거 기한 리포트 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	compareVersions,
+	findOverdueDeprecations,
+} from "../../src/semantic/deprecation";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+// @deprecated since=v1.2 remove=v1.9 use CreateUserV2
+func CreateUser(name string) error {
+	return nil
+}
+
+// @deprecated since=v1.8 remove=v2.1
+func RenameUser(name string) error {
+	return nil
+}
+
+// LegacyUser is kept for old clients
+// @deprecated
+type LegacyUser struct{}
+`;
+
+describe("compareVersions", () => {
+	it("should compare numerically and ignore prefixes", () => {
+		expect(compareVersions("v2.0", "v1.9")).toBeGreaterThan(0);
+		expect(compareVersions("1.10", "v1.9")).toBeGreaterThan(0);
+		expect(compareVersions("v2", "2.0.0")).toBe(0);
+		expect(compareVersions("v2.0.0-rc1", "v2.0.1")).toBeLessThan(0);
+	});
+});
+
+describe("findOverdueDeprecations", () => {
+	it("should report symbols past their removal version", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+
+		expect(graph.getNode("user.CreateUser")?.metadata.deprecation).toEqual({
+			since: "v1.2",
+			remove: "v1.9",
+			message: "use CreateUserV2",
+		});
+		expect(graph.getNode("user.LegacyUser")?.metadata.deprecation).toEqual(
+			{},
+		);
+
+		const overdue = findOverdueDeprecations(graph, "v2.0");
+
+		expect(overdue.map((entry) => entry.node.id)).toEqual(["user.CreateUser"]);
+	});
+});
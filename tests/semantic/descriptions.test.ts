@@ -0,0 +1,43 @@
+/**
+ * Description Check Tests
+ * 공개 API @description 누락 검사 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { checkDescriptions } from "../../src/semantic/checks/descriptions";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const FIXTURE = path.join(__dirname, "../fixtures/semantic/descriptions");
+
+describe("checkDescriptions", () => {
+	it("should flag public-api symbols with a missing or empty @description", async () => {
+		const analyzer = new SemanticAnalyzer({ projectRoot: FIXTURE });
+		const graph = await analyzer.analyzeFiles([
+			path.join(FIXTURE, "account.go"),
+		]);
+
+		const diagnostics = checkDescriptions(graph);
+
+		expect(
+			diagnostics
+				.map((d) => [d.ruleId, d.filePath, d.line, d.metadata?.symbol])
+				.sort(),
+		).toEqual([
+			["missing-description", "account.go", 15, "OpenAccount"],
+			["missing-description", "account.go", 23, "CloseAccount"],
+		]);
+		expect(diagnostics.every((d) => d.severity === "error")).toBe(true);
+	});
+
+	it("should accept configured tags", async () => {
+		const analyzer = new SemanticAnalyzer({ projectRoot: FIXTURE });
+		const graph = await analyzer.analyzeFiles([
+			path.join(FIXTURE, "account.go"),
+		]);
+
+		expect(
+			checkDescriptions(graph, { tags: ["helper"] }).map((d) => d.nodeId),
+		).toEqual(["account.normalize"]);
+	});
+});
@@ -0,0 +1,119 @@
+/**
+ * Deterministic JSON Tests
+ * 삽입 순서와 무관하게 같은 바이트로 직렬화하는 테스트
+ */
+
+import { readFile } from "node:fs/promises";
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { marshalDeterministic } from "../../src/semantic/deterministic-json";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { SemanticGraph } from "../../src/semantic/SemanticGraph";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("marshalDeterministic", () => {
+	it("should ignore node, edge, tag and key insertion order", () => {
+		const nodes = [
+			createTestNode("user.Create", {
+				semanticTags: ["public-api", "constructor-function"],
+				metadata: { score: 0.1 + 0.2, owners: ["b", "a"] },
+			}),
+			createTestNode("user.Delete"),
+			createTestNode("db.Query", { filePath: "db/db.go" }),
+		];
+		const edges: Array<[string, string, string?]> = [
+			["user.Create", "db.Query"],
+			["user.Delete", "db.Query"],
+			["user.Create", "user.Delete", "references"],
+		];
+
+		const forward = createTestGraph(nodes, edges);
+		const backward = new SemanticGraph();
+		for (const node of [...nodes].reverse()) {
+			const { metadata, ...rest } = node;
+			backward.addNode({
+				metadata: Object.fromEntries(Object.entries(metadata).reverse()),
+				...rest,
+				semanticTags: [...node.semanticTags].reverse(),
+			});
+		}
+		for (const [from, to, type = "calls"] of [...edges].reverse()) {
+			backward.addEdge({ from, to, type });
+		}
+
+		const json = marshalDeterministic(forward);
+		expect(marshalDeterministic(backward)).toBe(json);
+
+		const parsed = JSON.parse(json);
+		expect(parsed.nodes.map((node: { id: string }) => node.id)).toEqual([
+			"db.Query",
+			"user.Create",
+			"user.Delete",
+		]);
+		expect(parsed.nodes[1].semanticTags).toEqual([
+			"constructor-function",
+			"public-api",
+		]);
+		// 순서에 뜻이 있는 메타데이터 배열은 그대로 둔다
+		expect(parsed.nodes[1].metadata).toEqual({
+			owners: ["b", "a"],
+			score: 0.3,
+		});
+		expect(
+			parsed.edges.map(
+				(edge: { from: string; to: string }) => `${edge.from}>${edge.to}`,
+			),
+		).toEqual([
+			"user.Create>db.Query",
+			"user.Create>user.Delete",
+			"user.Delete>db.Query",
+		]);
+	});
+
+	it("should round floats and normalize maps and sets", () => {
+		const graph = createTestGraph([
+			createTestNode("app.Main", {
+				metadata: {
+					ratio: 2 / 3,
+					count: 3,
+					callers: new Set(["z", "a"]),
+					weights: new Map([
+						["b", 1.23456],
+						["a", -0.0000001],
+					]),
+				},
+			}),
+		]);
+
+		const { metadata } = JSON.parse(
+			marshalDeterministic(graph, { precision: 2 }),
+		).nodes[0];
+		expect(metadata).toEqual({
+			callers: ["a", "z"],
+			count: 3,
+			ratio: 0.67,
+			weights: { a: 0, b: 1.23 },
+		});
+		expect(() => marshalDeterministic(graph, { precision: -1 })).toThrow(
+			"Invalid precision: -1",
+		);
+	});
+
+	it("should produce identical output for repeated demo analyses", async () => {
+		const source = await readFile(DEMO_USER, "utf-8");
+		const analyze = async () => {
+			const analyzer = new SemanticAnalyzer();
+			return marshalDeterministic(
+				analyzer.buildGraph([
+					await analyzer.analyzeSource(source, "user/user.go"),
+				]),
+			);
+		};
+
+		const first = await analyze();
+		expect(await analyze()).toBe(first);
+		expect(first.endsWith("}\n")).toBe(true);
+	});
+});
@@ -0,0 +1,60 @@
+/**
+ * DI Scope Tests
+ * @scope 프로바이더 수명 의존 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkDIScopes } from "../../src/semantic/checks/di-scope";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package app
+
+// @scope request
+func ProvideSession(r *http.Request) *Session {
+	return &Session{}
+}
+
+// @scope transient
+func ProvideClock() Clock {
+	return Clock{}
+}
+
+// @scope singleton
+func ProvideCache() *Cache {
+	clock := ProvideClock()
+	return &Cache{session: ProvideSession(nil), clock: clock}
+}
+
+// @scope request
+func ProvideHandler() *Handler {
+	return &Handler{cache: ProvideCache(), session: ProvideSession(nil)}
+}
+`;
+
+describe("checkDIScopes", () => {
+	it("should flag a singleton provider depending on a request-scoped one", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "app/providers.go"),
+		]);
+
+		expect(graph.getNode("app.ProvideCache")?.metadata.scope).toBe(
+			"singleton",
+		);
+
+		const diagnostics = checkDIScopes(graph);
+
+		expect(diagnostics).toHaveLength(1);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "di-scope",
+			severity: "error",
+			nodeId: "app.ProvideCache",
+			line: 16,
+			metadata: {
+				scope: "singleton",
+				dependency: "app.ProvideSession",
+				dependencyScope: "request",
+			},
+		});
+	});
+});
@@ -0,0 +1,147 @@
+/**
+ * Diagnostic Format Tests
+ * GitHub Actions 주석 명령과 SARIF 출력 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	formatGitHubAnnotations,
+	formatSarif,
+} from "../../src/semantic/diagnostic-formats";
+import type { SemanticDiagnostic } from "../../src/semantic/types";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const DIAGNOSTICS: SemanticDiagnostic[] = [
+	{
+		ruleId: "missing-description",
+		severity: "error",
+		message: "user.Load has no description\nadd one: 100% required",
+		nodeId: "user.Load",
+		filePath: "user/user, v2.go",
+		line: 12,
+	},
+	{
+		ruleId: "unknown-tag",
+		severity: "warning",
+		message: "user.Save uses tag public-apis",
+		filePath: "user/user.go",
+	},
+	{
+		ruleId: "logs",
+		severity: "info",
+		message: "3 log calls",
+	},
+	{
+		ruleId: "unknown-tag",
+		severity: "error",
+		message: "suppressed one",
+		filePath: "user/user.go",
+		line: 3,
+		suppressed: true,
+	},
+];
+
+const graph = createTestGraph([
+	createTestNode("user.Load", {
+		nameRange: {
+			startByte: 120,
+			endByte: 124,
+			startLine: 12,
+			startColumn: 6,
+			endLine: 12,
+			endColumn: 10,
+		},
+	}),
+]);
+
+describe("formatGitHubAnnotations", () => {
+	it("should emit escaped workflow commands and a summary", () => {
+		expect(formatGitHubAnnotations(DIAGNOSTICS, { graph })).toBe(
+			[
+				"::error file=user/user%2C v2.go,line=12,col=6,title=missing-description::user.Load has no description%0Aadd one: 100%25 required",
+				"::warning file=user/user.go,title=unknown-tag::user.Save uses tag public-apis",
+				"::notice title=logs::3 log calls",
+				"3 diagnostics: 1 errors, 1 warnings, 1 notices (1 suppressed)",
+				"",
+			].join("\n"),
+		);
+	});
+});
+
+describe("formatSarif", () => {
+	it("should map diagnostics to SARIF results", () => {
+		const log = formatSarif(DIAGNOSTICS, { graph, toolVersion: "2.1.0" });
+
+		expect(log).toMatchObject({
+			version: "2.1.0",
+			runs: [
+				{
+					tool: {
+						driver: {
+							name: "dependency-linker",
+							version: "2.1.0",
+							rules: [
+								{ id: "logs" },
+								{ id: "missing-description" },
+								{ id: "unknown-tag" },
+							],
+						},
+					},
+				},
+			],
+		});
+		const [run] = log.runs as Array<{ results: unknown[] }>;
+		expect(run.results).toEqual([
+			{
+				ruleId: "missing-description",
+				ruleIndex: 1,
+				level: "error",
+				message: {
+					text: "user.Load has no description\nadd one: 100% required",
+				},
+				locations: [
+					{
+						physicalLocation: {
+							artifactLocation: { uri: "user/user%2C%20v2.go" },
+							region: { startLine: 12, startColumn: 6 },
+						},
+					},
+				],
+			},
+			{
+				ruleId: "unknown-tag",
+				ruleIndex: 2,
+				level: "warning",
+				message: { text: "user.Save uses tag public-apis" },
+				locations: [
+					{
+						physicalLocation: {
+							artifactLocation: { uri: "user/user.go" },
+						},
+					},
+				],
+			},
+			{
+				ruleId: "logs",
+				ruleIndex: 0,
+				level: "note",
+				message: { text: "3 log calls" },
+			},
+			{
+				ruleId: "unknown-tag",
+				ruleIndex: 2,
+				level: "error",
+				message: { text: "suppressed one" },
+				locations: [
+					{
+						physicalLocation: {
+							artifactLocation: { uri: "user/user.go" },
+							region: { startLine: 3 },
+						},
+					},
+				],
+				suppressions: [{ kind: "inSource" }],
+			},
+		]);
+	});
+});
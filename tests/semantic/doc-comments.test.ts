@@ -0,0 +1,142 @@
+/**
+ * Doc Comment Text Tests
+ * 심볼 노드에 문서 주석 원문과 정리된 본문을 붙이는 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { createDocComment } from "../../src/semantic/annotations";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const docOf = async (source: string, filePath: string, name: string) => {
+	const extraction = await new SemanticAnalyzer().analyzeSource(
+		source,
+		filePath,
+	);
+	return extraction.nodes.find((node) => node.name === name)?.metadata.doc;
+};
+
+describe("createDocComment", () => {
+	it("should strip markers and surrounding blank lines", () => {
+		expect(createDocComment(["/**", " * Loads a user.", " */"])).toEqual({
+			raw: "/**\n * Loads a user.\n */",
+			text: "Loads a user.",
+		});
+		expect(createDocComment([])).toBeUndefined();
+	});
+});
+
+describe("doc comment text", () => {
+	it("should keep Go line comments including the Korean description", async () => {
+		const source = [
+			"package user",
+			"",
+			"// CreateUser creates a new user.",
+			"//",
+			"// @semantic-tags: public-api",
+			"// @description: 새 사용자를 생성한다",
+			"func CreateUser() {}",
+			"",
+			"/*",
+			" * Delete removes a user.",
+			" */",
+			"func Delete() {}",
+			"",
+			"func Undocumented() {}",
+			"",
+		].join("\n");
+
+		expect(await docOf(source, "user/user.go", "CreateUser")).toEqual({
+			raw: [
+				"// CreateUser creates a new user.",
+				"//",
+				"// @semantic-tags: public-api",
+				"// @description: 새 사용자를 생성한다",
+			].join("\n"),
+			text: [
+				"CreateUser creates a new user.",
+				"",
+				"@semantic-tags: public-api",
+				"@description: 새 사용자를 생성한다",
+			].join("\n"),
+		});
+		expect(await docOf(source, "user/user.go", "Delete")).toEqual({
+			raw: "/*\n * Delete removes a user.\n */",
+			text: "Delete removes a user.",
+		});
+		expect(
+			await docOf(source, "user/user.go", "Undocumented"),
+		).toBeUndefined();
+	});
+
+	it("should read TypeScript and Java block comments", async () => {
+		const typescript = [
+			"/**",
+			" * Loads a user.",
+			" * @description: 사용자를 불러온다",
+			" */",
+			"export function load() {}",
+			"",
+		].join("\n");
+		const java = [
+			"package com.example;",
+			"",
+			"/** Stores users. */",
+			"public class UserStore {}",
+			"",
+		].join("\n");
+
+		expect(
+			(await docOf(typescript, "web/user.ts", "load"))?.text,
+		).toBe("Loads a user.\n@description: 사용자를 불러온다");
+		expect(await docOf(java, "src/UserStore.java", "UserStore")).toEqual({
+			raw: "/** Stores users. */",
+			text: "Stores users.",
+		});
+	});
+
+	it("should combine Python comments with the docstring", async () => {
+		const source = [
+			"# @semantic-tags: user-domain",
+			"def create_user(name):",
+			'    """Creates a new user.',
+			"",
+			"    Returns the id.",
+			'    """',
+			"    return name",
+			"",
+		].join("\n");
+
+		expect(await docOf(source, "app/users.py", "create_user")).toEqual({
+			raw: [
+				"# @semantic-tags: user-domain",
+				'"""Creates a new user.',
+				"",
+				"    Returns the id.",
+				'    """',
+			].join("\n"),
+			text: [
+				"@semantic-tags: user-domain",
+				"Creates a new user.",
+				"",
+				"Returns the id.",
+			].join("\n"),
+		});
+	});
+
+	it("should keep proto comments", async () => {
+		const source = [
+			'syntax = "proto3";',
+			"package user.v1;",
+			"",
+			"// User is an account holder.",
+			"// @description: 계정 소유자",
+			"message User {}",
+			"",
+		].join("\n");
+
+		expect(await docOf(source, "proto/user.proto", "User")).toEqual({
+			raw: "// User is an account holder.\n// @description: 계정 소유자",
+			text: "User is an account holder.\n@description: 계정 소유자",
+		});
+	});
+});
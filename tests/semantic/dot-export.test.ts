@@ -0,0 +1,61 @@
+/**
+ * DOT Export Tests
+ * Graphviz DOT 출력 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { exportDot, renderDot } from "../../src/semantic/dot-export";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const graph = createTestGraph(
+	[
+		createTestNode("user.(*Service).Get", {
+			name: "Get",
+			semanticTags: ["public-api", "read-method"],
+		}),
+		createTestNode("user.load", { name: 'load "cached"' }),
+		createTestNode("store.Query", { filePath: "store/store.go" }),
+	],
+	[
+		["user.(*Service).Get", "user.load", "calls"],
+		["user.load", "user.(*Service).Get", "calls"],
+		["user.load", "store.Query", "calls"],
+		["user.load", "store.Query", "contains"],
+	],
+);
+
+describe("renderDot", () => {
+	it("should escape symbol IDs and survive cycles", () => {
+		expect(renderDot(graph)).toBe(
+			[
+				'digraph "dependencies" {',
+				'\t"store.Query" [label="Query"];',
+				'\t"user.(*Service).Get" [label="Get", tooltip="public-api, read-method"];',
+				'\t"user.load" [label="load \\"cached\\""];',
+				'\t"user.(*Service).Get" -> "user.load" [label="calls", color="#2ca02c"];',
+				'\t"user.load" -> "store.Query" [label="calls", color="#2ca02c"];',
+				'\t"user.load" -> "user.(*Service).Get" [label="calls", color="#2ca02c"];',
+				"}",
+				"",
+			].join("\n"),
+		);
+	});
+
+	it("should collapse symbols into files and drop same-file edges", () => {
+		const chunks: string[] = [];
+		exportDot(graph, { write: (chunk: string) => chunks.push(chunk) }, {
+			granularity: "file",
+		});
+
+		expect(chunks.join("")).toBe(
+			[
+				'digraph "dependencies" {',
+				'\t"store/store.go" [label="store/store.go"];',
+				'\t"user/user.go" [label="user/user.go", tooltip="public-api, read-method"];',
+				'\t"user/user.go" -> "store/store.go" [label="calls", color="#2ca02c"];',
+				"}",
+				"",
+			].join("\n"),
+		);
+	});
+});
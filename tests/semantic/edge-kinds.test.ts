@@ -0,0 +1,100 @@
+/**
+ * Edge Kind Tests
+ * 엣지 타입 분류와 분류별 조회/내보내기 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { renderDot } from "../../src/semantic/dot-export";
+import { EdgeKind, edgeKindOf } from "../../src/semantic/edge-kinds";
+import { renderMermaid } from "../../src/semantic/mermaid-export";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("edgeKindOf", () => {
+	it("should classify edge types and honor metadata.kind", () => {
+		expect(edgeKindOf({ from: "a", to: "b", type: "imports_type" })).toBe(
+			EdgeKind.Import,
+		);
+		expect(edgeKindOf({ from: "a", to: "b", type: "calls-service" })).toBe(
+			EdgeKind.Call,
+		);
+		expect(edgeKindOf({ from: "a", to: "b", type: "uses_table" })).toBe(
+			EdgeKind.Other,
+		);
+		expect(
+			edgeKindOf({
+				from: "a",
+				to: "b",
+				type: "publishes",
+				metadata: { kind: "call" },
+			}),
+		).toBe(EdgeKind.Call);
+	});
+});
+
+describe("SemanticGraph.edgesOfKind", () => {
+	it("should separate the demo's imports from its type references", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+
+		const imports = graph.edgesOfKind(EdgeKind.Import);
+		expect(imports.length).toBeGreaterThan(0);
+		expect(
+			imports.every(
+				(edge) =>
+					edge.from === "user.go" && graph.getNode(edge.to)?.metadata.stdlib,
+			),
+		).toBe(true);
+
+		const typeRefs = graph.edgesOfKind(EdgeKind.TypeRef);
+		expect(
+			typeRefs
+				.filter((edge) => edge.from === "user.UserService.CreateUser")
+				.map((edge) => edge.to),
+		).toEqual(["user.User"]);
+		expect(
+			typeRefs.some(
+				(edge) =>
+					edge.from === "user.NewUserService" &&
+					edge.to === "user.UserService",
+			),
+		).toBe(true);
+	});
+});
+
+describe("exporters", () => {
+	const graph = createTestGraph(
+		[
+			createTestNode("api.Handle"),
+			createTestNode("user.Get"),
+			createTestNode("user.User", { kind: "struct" }),
+		],
+		[
+			["api.Handle", "user.Get", "calls"],
+			["user.Get", "user.User", "references"],
+		],
+	);
+
+	it("should filter DOT edges by kind", () => {
+		expect(renderDot(graph, { kinds: [EdgeKind.TypeRef] })).toContain(
+			'\t"user.Get" -> "user.User" [label="references", color="#9467bd"];',
+		);
+		expect(renderDot(graph, { kinds: [EdgeKind.TypeRef] })).not.toContain(
+			"calls",
+		);
+	});
+
+	it("should color Mermaid links by kind", () => {
+		expect(renderMermaid(graph)).toContain(
+			"\tn0 -->|calls| n1\n\tn1 -->|references| n2\n\tlinkStyle 0 stroke:#2ca02c\n\tlinkStyle 1 stroke:#9467bd\n",
+		);
+		expect(renderMermaid(graph, { kinds: [EdgeKind.Call] })).not.toContain(
+			"references",
+		);
+	});
+});
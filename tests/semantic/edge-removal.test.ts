@@ -0,0 +1,63 @@
+/**
+ * Edge Removal Simulation Tests
+ * 엣지 제거 영향 시뮬레이션 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { simulateRemoveEdge } from "../../src/semantic/edge-removal";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const buildGraph = () =>
+	createTestGraph(
+		["app.Main", "app.A", "app.B", "app.C", "app.D"].map((id) =>
+			createTestNode(id),
+		),
+		[
+			["app.Main", "app.A", "calls"],
+			["app.A", "app.B", "calls"],
+			["app.B", "app.C", "calls"],
+			["app.C", "app.A", "calls"],
+			["app.C", "app.D", "calls"],
+		],
+	);
+
+describe("simulateRemoveEdge", () => {
+	it("should report the cycle resolved by removing its closing edge", () => {
+		const graph = buildGraph();
+
+		const impact = simulateRemoveEdge(graph, {
+			from: "app.C",
+			to: "app.A",
+			type: "calls",
+		});
+
+		expect(impact.resolvedCycle).toEqual(["app.A", "app.B", "app.C"]);
+		expect(impact.remainingCycles).toEqual([]);
+		expect(impact.unreachable).toEqual(["app.A", "app.B"]);
+		// app.Main still depends on app.A
+		expect(impact.orphans).toEqual([]);
+		expect(graph.edges).toHaveLength(5);
+	});
+
+	it("should report orphans and no cycle change for an acyclic edge", () => {
+		const impact = simulateRemoveEdge(buildGraph(), {
+			from: "app.C",
+			to: "app.D",
+			type: "calls",
+		});
+
+		expect(impact.resolvedCycle).toBeUndefined();
+		expect(impact.unreachable).toEqual(["app.D"]);
+		expect(impact.orphans).toEqual(["app.D"]);
+	});
+
+	it("should throw for an edge that is not in the graph", () => {
+		expect(() =>
+			simulateRemoveEdge(buildGraph(), {
+				from: "app.D",
+				to: "app.A",
+				type: "calls",
+			}),
+		).toThrow("Edge not found");
+	});
+});
@@ -0,0 +1,122 @@
+/**
+ * Error Handling Tests
+ * 함수별 에러 반환 방식 지표와 에러 감싸기 검사 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { checkErrorWrapping } from "../../src/semantic/checks/error-wrapping";
+import { getErrorHandling } from "../../src/semantic/error-handling";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+const STORE = `package store
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+)
+
+// @semantic-tags: public-api
+func Load(path string) error {
+	data, err := read(path)
+	if err != nil {
+		return fmt.Errorf("read %s: %w", path, err)
+	}
+	n, _ := parse(data)
+	_ = flush()
+	if n == 0 {
+		return ErrEmpty
+	}
+	if err := check(n); err != nil {
+		return errors.Wrap(err, "check")
+	}
+	if err := validate(n); err != nil {
+		return fmt.Errorf("validate: %v", err)
+	}
+	go func() error {
+		return err
+	}()
+	return nil
+}
+
+// @semantic-tags: public-api
+func Save(path string) error {
+	if err := write(path); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
+`;
+
+describe("error handling metrics", () => {
+	it("should report the demo service methods as returning bare errors", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+		const metrics = (id: string) => {
+			const node = graph.getNode(id);
+			return node ? getErrorHandling(node) : undefined;
+		};
+
+		expect(metrics("user.UserService.GetUser")).toEqual({
+			errorReturns: 1,
+			wrapped: 0,
+			bare: 1,
+			ignored: 0,
+		});
+		expect(metrics("user.UserService.UpdateUser")?.bare).toBe(2);
+		expect(metrics("user.ValidateUser")?.errorReturns).toBe(0);
+		expect(metrics("user.User")).toBeUndefined();
+
+		expect(
+			checkErrorWrapping(graph).map((diagnostic) => diagnostic.nodeId),
+		).toEqual([
+			"user.UserService.CreateUser",
+			"user.UserService.GetUser",
+			"user.UserService.GetUserByEmail",
+			"user.UserService.UpdateUser",
+			"user.UserService.DeleteUser",
+			"user.UserService.ListUsers",
+			"user.UserService.SearchUsers",
+			"user.UserService.GetUserCount",
+			"user.UserService.UserExists",
+		]);
+	});
+
+	it("should tell wrapped, bare and ignored errors apart", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(STORE, "store/store.go"),
+		]);
+		const load = graph.getNode("store.Load");
+		const save = graph.getNode("store.Save");
+
+		expect(load && getErrorHandling(load)).toEqual({
+			errorReturns: 3,
+			wrapped: 2,
+			bare: 1,
+			ignored: 2,
+		});
+		expect(save && getErrorHandling(save)).toEqual({
+			errorReturns: 1,
+			wrapped: 1,
+			bare: 0,
+			ignored: 0,
+		});
+
+		const diagnostics = checkErrorWrapping(graph);
+		expect(diagnostics).toHaveLength(1);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "unwrapped-error",
+			severity: "warning",
+			nodeId: "store.Load",
+			message:
+				"store.Load is public-api but returns 1 unwrapped error(s); wrap them with %w",
+		});
+		expect(checkErrorWrapping(graph, { tag: "internal" })).toEqual([]);
+	});
+});
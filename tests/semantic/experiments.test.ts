@@ -0,0 +1,68 @@
+/**
+ * Experiment Tests
+ * 만료된 @experiment 정리 리포트 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	findExpiredExperiments,
+	parseExpiry,
+} from "../../src/semantic/experiments";
+import { linkFeatureFlags } from "../../src/semantic/feature-flags";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package checkout
+
+// @experiment name=one-click expires=2024-06
+func OneClickPay() error {
+	return nil
+}
+
+// @experiment name=one-click expires=2024-05
+type OneClickButton struct{}
+
+// @experiment name=dark-cart expires=2099-01-31
+func DarkCart() {}
+
+func Render() {
+	if flags.Enabled("one-click") {
+		OneClickPay()
+	}
+}
+`;
+
+describe("parseExpiry", () => {
+	it("should expire at the end of the month or day", () => {
+		expect(parseExpiry("2024-06")).toBe(Date.UTC(2024, 6, 1));
+		expect(parseExpiry("2024-06-30")).toBe(Date.UTC(2024, 6, 1));
+		expect(parseExpiry("June 2024")).toBeUndefined();
+	});
+});
+
+describe("findExpiredExperiments", () => {
+	it("should report an expired experiment with the symbols it guards", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "checkout/checkout.go"),
+		]);
+		linkFeatureFlags(graph);
+
+		expect(graph.getNode("checkout.OneClickPay")?.metadata.experiment).toEqual(
+			{ name: "one-click", expires: "2024-06" },
+		);
+		expect(
+			findExpiredExperiments(graph, new Date("2024-06-30T23:59:59Z")),
+		).toEqual([]);
+
+		const expired = findExpiredExperiments(graph, new Date("2024-07-15"));
+
+		expect(expired).toHaveLength(1);
+		expect(expired[0].name).toBe("one-click");
+		expect(expired[0].expires).toBe("2024-06");
+		expect(expired[0].guarded.map((node) => node.id)).toEqual([
+			"checkout.OneClickButton",
+			"checkout.OneClickPay",
+			"checkout.Render",
+		]);
+	});
+});
@@ -0,0 +1,145 @@
+/**
+ * External Resolution Tests
+ * 외부 의존성 보강 콜백의 메모이즈, 동시 호출 제한, 실패 표시 테스트
+ */
+
+import { mkdir, mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import {
+	createExternalResolver,
+	type ExternalInfo,
+	resolveExternals,
+} from "../../src/semantic/external-resolution";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("createExternalResolver", () => {
+	it("should call the hook once per import path", async () => {
+		const calls: string[] = [];
+		const resolve = createExternalResolver(async (importPath) => {
+			calls.push(importPath);
+			return { license: "MIT" };
+		});
+
+		await Promise.all([resolve("fmt"), resolve("fmt"), resolve("os")]);
+		await resolve("fmt");
+
+		expect(calls).toEqual(["fmt", "os"]);
+	});
+
+	it("should limit concurrent hook calls", async () => {
+		let active = 0;
+		let peak = 0;
+		const resolve = createExternalResolver(async () => {
+			active++;
+			peak = Math.max(peak, active);
+			await new Promise((done) => setTimeout(done, 5));
+			active--;
+			return {};
+		}, 2);
+
+		await Promise.all(
+			Array.from({ length: 8 }, (_, i) => resolve(`example.com/m${i}`)),
+		);
+
+		expect(peak).toBe(2);
+		expect(() => createExternalResolver(async () => ({}), 0)).toThrow(
+			"Invalid concurrency: 0",
+		);
+	});
+});
+
+describe("resolveExternals", () => {
+	it("should attach info or an error marker to external nodes", async () => {
+		const graph = createTestGraph(
+			[
+				createTestNode("user/user.go", { kind: "file" }),
+				createTestNode("github.com/acme/log", {
+					kind: "external",
+					metadata: { importPath: "github.com/acme/log" },
+				}),
+				createTestNode("example.com/private", {
+					kind: "external",
+					metadata: { importPath: "example.com/private" },
+				}),
+			],
+			[
+				["user/user.go", "github.com/acme/log", "imports"],
+				["user/user.go", "example.com/private", "imports"],
+			],
+		);
+
+		const resolved = await resolveExternals(
+			graph,
+			async (importPath): Promise<ExternalInfo> => {
+				if (importPath.startsWith("example.com")) {
+					throw new Error("404 Not Found");
+				}
+				return { repository: `https://${importPath}`, license: "MIT" };
+			},
+		);
+
+		expect(
+			resolved.getNode("github.com/acme/log")?.metadata.external,
+		).toEqual({ repository: "https://github.com/acme/log", license: "MIT" });
+		expect(
+			resolved.getNode("example.com/private")?.metadata.externalError,
+		).toEqual({
+			importPath: "example.com/private",
+			message: "404 Not Found",
+		});
+		expect(resolved.getNode("user/user.go")?.metadata).toEqual({});
+		expect(resolved.edges).toHaveLength(2);
+		expect(graph.getNode("github.com/acme/log")?.metadata.external).toBe(
+			undefined,
+		);
+	});
+});
+
+describe("SemanticAnalyzer externalResolver", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-external-"));
+		await mkdir(join(projectDir, "user"));
+		for (const name of ["user", "admin"]) {
+			await writeFile(
+				join(projectDir, "user", `${name}.go`),
+				'package user\n\nimport (\n\t"fmt"\n\t"github.com/acme/log"\n)\n',
+			);
+		}
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	it("should enrich externals once per path across analyses", async () => {
+		const calls: string[] = [];
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: projectDir,
+			externalConcurrency: 1,
+			externalResolver: (importPath) => {
+				calls.push(importPath);
+				if (importPath === "fmt") {
+					throw new Error("standard library");
+				}
+				return { license: "Apache-2.0" };
+			},
+		});
+
+		await analyzer.analyzeDirectory(projectDir);
+		const graph = await analyzer.analyzeDirectory(projectDir);
+
+		expect(calls.sort()).toEqual(["fmt", "github.com/acme/log"]);
+		expect(graph.getNode("github.com/acme/log")?.metadata.external).toEqual({
+			license: "Apache-2.0",
+		});
+		expect(graph.getNode("fmt")?.metadata.externalError).toEqual({
+			importPath: "fmt",
+			message: "standard library",
+		});
+	});
+});
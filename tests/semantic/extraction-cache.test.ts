@@ -0,0 +1,70 @@
+/**
+ * Extraction Cache Tests
+ * 내용 해시 기반 증분 재분석 테스트
+ */
+
+import { mkdir, mkdtemp, rm, unlink, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { ExtractionCache } from "../../src/semantic/extraction-cache";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+describe("SemanticAnalyzer with ExtractionCache", () => {
+	let projectDir: string;
+	let cacheFile: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-cache-"));
+		cacheFile = join(projectDir, ".cache", "extractions.json");
+		await mkdir(join(projectDir, "user"));
+		await writeFile(
+			join(projectDir, "user", "user.go"),
+			"package user\n\nfunc Get() {\n\tload()\n}\n",
+		);
+		await writeFile(
+			join(projectDir, "user", "store.go"),
+			"package user\n\nfunc load() {}\n",
+		);
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	const analyze = async () => {
+		const cache = await ExtractionCache.load(cacheFile);
+		const analyzer = new SemanticAnalyzer({ projectRoot: projectDir, cache });
+		const graph = await analyzer.analyzeDirectory(projectDir);
+		await cache.save(cacheFile);
+		return { graph, cache };
+	};
+
+	it("should only re-parse files whose content changed", async () => {
+		const first = await analyze();
+		expect(first.cache.stats).toEqual({ hits: 0, misses: 2, pruned: 0 });
+
+		const second = await analyze();
+		expect(second.cache.stats).toEqual({ hits: 2, misses: 0, pruned: 0 });
+		expect(second.graph.hasEdge("user.Get", "user.load", "calls")).toBe(true);
+
+		await writeFile(
+			join(projectDir, "user", "store.go"),
+			"package user\n\nfunc load() {}\n\nfunc Save() {}\n",
+		);
+		const third = await analyze();
+		expect(third.cache.stats).toEqual({ hits: 1, misses: 1, pruned: 0 });
+		expect(third.graph.hasNode("user.Save")).toBe(true);
+	});
+
+	it("should prune entries for deleted files", async () => {
+		await analyze();
+		await unlink(join(projectDir, "user", "store.go"));
+
+		const { graph, cache } = await analyze();
+
+		expect(cache.stats).toEqual({ hits: 1, misses: 0, pruned: 1 });
+		expect(cache.has("user/store.go")).toBe(false);
+		expect(graph.hasNode("user.load")).toBe(false);
+	});
+});
@@ -0,0 +1,106 @@
+/**
+ * Extraction Pool Tests
+ * worker thread 파싱 결과가 메인 스레드 파싱과 같은지 테스트
+ */
+
+import { mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import {
+	ExtractionPool,
+	MIN_FILES_PER_WORKER,
+} from "../../src/semantic/extraction-pool";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+/** worker를 띄우는 테스트 (ts-node로 worker 모듈을 불러오는 시간 포함) */
+const WORKER_TIMEOUT_MS = 60_000;
+
+const SOURCE = `package user
+
+// @semantic-tags: service
+type UserService struct{}
+
+func (s *UserService) GetUser(id string) string {
+	return Validate(id)
+}
+
+func Validate(id string) string { return id }
+`;
+
+describe("ExtractionPool", () => {
+	it(
+		"should extract the same result as the main thread",
+		async () => {
+			const pool = new ExtractionPool(2);
+			try {
+				const [first, second] = await Promise.all([
+					pool.analyze(SOURCE, "user/user.go"),
+					pool.analyze("package user\n\nfunc Other() {}\n", "user/other.go"),
+				]);
+
+				expect(first).toEqual(
+					await new SemanticAnalyzer().analyzeSource(SOURCE, "user/user.go"),
+				);
+				expect(second.nodes.map((node) => node.id)).toEqual(["user.Other"]);
+				await expect(pool.analyze(SOURCE, "user/user.rb")).rejects.toThrow(
+					"No extractor registered for file: user/user.rb",
+				);
+			} finally {
+				await pool.close();
+			}
+			await expect(pool.analyze(SOURCE, "user/user.go")).rejects.toThrow(
+				"Extraction pool is closed",
+			);
+		},
+		WORKER_TIMEOUT_MS,
+	);
+
+	it("should reject an invalid worker count", () => {
+		expect(() => new ExtractionPool(0)).toThrow("Invalid worker count: 0");
+		expect(() => new SemanticAnalyzer({ workers: 0 })).toThrow(
+			"Invalid workers: 0",
+		);
+	});
+});
+
+describe("SemanticAnalyzer workers", () => {
+	// worker 2개가 뜨는 최소 파일 수
+	const FILE_COUNT = MIN_FILES_PER_WORKER * 2;
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-workers-"));
+		for (let i = 0; i < FILE_COUNT; i++) {
+			await writeFile(
+				join(projectDir, `file${i}.go`),
+				`package gen\n\nfunc F${i}() {\n\tF${(i + 1) % FILE_COUNT}()\n}\n`,
+			);
+		}
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	it(
+		"should build the same graph with and without worker threads",
+		async () => {
+			const threaded = await new SemanticAnalyzer({
+				projectRoot: projectDir,
+				workers: 2,
+			}).analyzeDirectory(projectDir);
+			const single = await new SemanticAnalyzer({
+				projectRoot: projectDir,
+				workers: 1,
+			}).analyzeDirectory(projectDir);
+
+			expect(threaded.nodes.size).toBeGreaterThanOrEqual(FILE_COUNT);
+			expect(Array.from(threaded.nodes.values())).toEqual(
+				Array.from(single.nodes.values()),
+			);
+			expect(threaded.edges).toEqual(single.edges);
+		},
+		WORKER_TIMEOUT_MS,
+	);
+});
@@ -0,0 +1,125 @@
+/**
+ * Fail Level Tests
+ * --fail-level 기준 CI 종료 코드 테스트
+ */
+
+import { mkdir, mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import {
+	afterEach,
+	beforeEach,
+	describe,
+	expect,
+	it,
+	jest,
+} from "@jest/globals";
+import { executeSemanticCheckAction } from "../../src/cli/actions/semantic-check-action";
+import { countFailures } from "../../src/semantic/diagnostics";
+import type { SemanticDiagnostic } from "../../src/semantic/types";
+
+const PANICKING = `package app
+
+func mustLoad() {
+	panic("boom")
+}
+`;
+
+const CONFLICTING = `package app
+
+// @semantic-tags: public-api, internal
+func Export() {}
+`;
+
+const SUPPRESSED = `package app
+
+// @semantic-tags: public-api, internal
+// @suppress tag-exclusivity
+func Export() {}
+`;
+
+describe("--fail-level", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-check-"));
+		jest.spyOn(console, "log").mockImplementation(() => {});
+		jest.spyOn(console, "error").mockImplementation(() => {});
+	});
+
+	afterEach(async () => {
+		jest.restoreAllMocks();
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	it("should pass with only warnings when the fail level is error", async () => {
+		await writeFile(join(projectDir, "load.go"), PANICKING);
+
+		expect(
+			await executeSemanticCheckAction({
+				directory: projectDir,
+				failLevel: "error",
+			}),
+		).toBe(0);
+		expect(await executeSemanticCheckAction({ directory: projectDir })).toBe(1);
+	});
+
+	it("should fail when an error is present", async () => {
+		await writeFile(join(projectDir, "load.go"), PANICKING);
+		await writeFile(join(projectDir, "export.go"), CONFLICTING);
+
+		expect(
+			await executeSemanticCheckAction({
+				directory: projectDir,
+				failLevel: "error",
+			}),
+		).toBe(1);
+	});
+
+	it("should never count suppressed violations", async () => {
+		await writeFile(join(projectDir, "export.go"), SUPPRESSED);
+
+		expect(
+			await executeSemanticCheckAction({
+				directory: projectDir,
+				failLevel: "info",
+			}),
+		).toBe(0);
+	});
+
+	it("should skip .linkerignore paths with and without a pattern", async () => {
+		await mkdir(join(projectDir, "generated"));
+		await writeFile(join(projectDir, "generated", "export.go"), CONFLICTING);
+		await writeFile(join(projectDir, ".linkerignore"), "generated/\n");
+
+		expect(await executeSemanticCheckAction({ directory: projectDir })).toBe(0);
+		expect(
+			await executeSemanticCheckAction({
+				directory: projectDir,
+				pattern: "**/*.go",
+			}),
+		).toBe(0);
+	});
+
+	it("should reject unknown fail levels", async () => {
+		expect(
+			await executeSemanticCheckAction({
+				directory: projectDir,
+				failLevel: "fatal",
+			}),
+		).toBe(2);
+	});
+
+	it("should count diagnostics at or above the level", () => {
+		const diagnostics: SemanticDiagnostic[] = [
+			{ ruleId: "a", severity: "info", message: "" },
+			{ ruleId: "b", severity: "warning", message: "" },
+			{ ruleId: "c", severity: "error", message: "" },
+			{ ruleId: "d", severity: "error", message: "", suppressed: true },
+		];
+
+		expect(countFailures(diagnostics, "info")).toBe(3);
+		expect(countFailures(diagnostics, "warning")).toBe(2);
+		expect(countFailures(diagnostics, "error")).toBe(1);
+	});
+});
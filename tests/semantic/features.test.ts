@@ -0,0 +1,68 @@
+/**
+ * Feature Impact Tests
+ * @feature 기준 영향 범위 리포트 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { getFeatures, impactByFeature } from "../../src/semantic/features";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const CART = `package shop
+
+// @feature checkout
+func CartTotal() int {
+	return 0
+}
+`;
+
+const ORDERS = `package shop
+
+// @feature orders, invoicing
+func PlaceOrder() int {
+	return CartTotal()
+}
+
+// @feature invoicing
+func SendInvoice() int {
+	return PlaceOrder()
+}
+
+func unrelated() {}
+`;
+
+describe("impactByFeature", () => {
+	it("should report the changed feature and its dependents' features", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(CART, "shop/cart.go"),
+			await analyzer.analyzeSource(ORDERS, "shop/orders.go"),
+		]);
+
+		const placeOrder = graph.getNode("shop.PlaceOrder");
+		expect(placeOrder && getFeatures(placeOrder)).toEqual([
+			"orders",
+			"invoicing",
+		]);
+
+		const report = impactByFeature(graph, ["shop/cart.go"]);
+
+		expect(report.map((entry) => entry.feature)).toEqual([
+			"checkout",
+			"invoicing",
+			"orders",
+		]);
+		expect(report[0].changed.map((node) => node.id)).toEqual([
+			"shop.CartTotal",
+		]);
+		expect(report[0].affected).toEqual([]);
+		expect(
+			report[1].affected.map((entry) => [entry.node.id, entry.depth]),
+		).toEqual([
+			["shop.PlaceOrder", 1],
+			["shop.SendInvoice", 2],
+		]);
+		expect(report[2].affected.map((entry) => entry.node.id)).toEqual([
+			"shop.PlaceOrder",
+		]);
+	});
+});
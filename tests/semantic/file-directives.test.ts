@@ -0,0 +1,129 @@
+/**
+ * File Directive Tests
+ * 파일 머리의 @file-tags, @file-description을 심볼에 적용하는 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	applyFileDirectives,
+	parseFileDirectives,
+} from "../../src/semantic/file-directives";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestNode } from "./semantic-test-helpers";
+
+const GO_SOURCE = [
+	"// Code generated by protoc-gen-go. DO NOT EDIT.",
+	"// @file-tags: generated, public-api",
+	"// @file-description: protoc이 생성한 코드",
+	"",
+	"package userpb",
+	"",
+	"// @semantic-tags: internal",
+	"// @description: 내부 변환기",
+	"func convert() {}",
+	"",
+	"func Marshal() {}",
+].join("\n");
+
+describe("parseFileDirectives", () => {
+	it("should read directives from leading line comments", () => {
+		expect(parseFileDirectives(GO_SOURCE)).toEqual({
+			tags: ["generated", "public-api"],
+			description: "protoc이 생성한 코드",
+		});
+	});
+
+	it("should read directives from a leading block comment", () => {
+		const source = [
+			"/**",
+			" * @file-tags: legacy,",
+			" * @file-tags: legacy, billing",
+			" */",
+			"export function charge() {}",
+		].join("\n");
+
+		expect(parseFileDirectives(source)).toEqual({
+			tags: ["legacy", "billing"],
+		});
+	});
+
+	it("should stop at the first code line", () => {
+		const source = [
+			"package user",
+			"",
+			"// @file-tags: generated",
+			"func Create() {}",
+		].join("\n");
+
+		expect(parseFileDirectives(source)).toEqual({ tags: [] });
+	});
+});
+
+describe("applyFileDirectives", () => {
+	const directives = {
+		tags: ["generated", "public-api"],
+		description: "생성된 코드",
+	};
+
+	it("should append file tags after the symbol's own tags", () => {
+		const [node] = applyFileDirectives(
+			[createTestNode("user.Create", { semanticTags: ["service"] })],
+			directives,
+		);
+
+		expect(node.semanticTags).toEqual(["service", "generated", "public-api"]);
+		expect(node.description).toBe("생성된 코드");
+		expect(node.metadata.fileTags).toEqual(["generated", "public-api"]);
+	});
+
+	it("should let symbol tags win over exclusive file tags", () => {
+		const [node] = applyFileDirectives(
+			[
+				createTestNode("user.convert", {
+					semanticTags: ["internal"],
+					description: "내부 변환기",
+				}),
+			],
+			directives,
+		);
+
+		expect(node.semanticTags).toEqual(["internal", "generated"]);
+		expect(node.description).toBe("내부 변환기");
+		expect(node.metadata.fileTags).toEqual(["generated"]);
+	});
+
+	it("should leave file, package and placeholder nodes untouched", () => {
+		const nodes = [
+			createTestNode("user", { kind: "package" }),
+			createTestNode("user/user.go", { kind: "file" }),
+			createTestNode("external:fmt", { kind: "external" }),
+		];
+
+		expect(applyFileDirectives(nodes, directives)).toEqual(nodes);
+	});
+});
+
+describe("file directives in analysis", () => {
+	it("should apply header directives to extracted symbols", async () => {
+		const extraction = await new SemanticAnalyzer().analyzeSource(
+			GO_SOURCE,
+			"userpb/user.pb.go",
+		);
+		const byName = (name: string) =>
+			extraction.nodes.find((node) => node.name === name);
+
+		expect(byName("Marshal")?.semanticTags).toEqual([
+			"generated",
+			"public-api",
+		]);
+		expect(byName("Marshal")?.description).toBe("protoc이 생성한 코드");
+		expect(byName("convert")?.semanticTags).toEqual([
+			"internal",
+			"generated",
+		]);
+		expect(byName("convert")?.description).toBe("내부 변환기");
+		expect(
+			extraction.nodes.find((node) => node.kind === "package")?.semanticTags,
+		).toEqual([]);
+	});
+});
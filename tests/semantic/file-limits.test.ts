@@ -0,0 +1,128 @@
+/**
+ * File Limit Tests
+ * 파일 크기/파싱 시간 제한으로 파일을 건너뛰어도 분석이 계속되는지 테스트
+ */
+
+import { promises as fs } from "node:fs";
+import { mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import {
+	afterEach,
+	beforeEach,
+	describe,
+	expect,
+	it,
+	jest,
+} from "@jest/globals";
+import { checkSkippedFiles } from "../../src/semantic/checks/skipped-files";
+import {
+	DEFAULT_MAX_FILE_BYTES,
+	SemanticAnalyzer,
+} from "../../src/semantic/SemanticAnalyzer";
+
+/** 함수 count개로 이루어진 생성 코드 */
+const generated = (count: number) => {
+	const lines = ["package gen", ""];
+	for (let i = 0; i < count; i++) {
+		lines.push(`func Generated${i}(a, b int) int {`);
+		lines.push(`\treturn a*${i} + b*(a-${i})/(b+${i + 1})`);
+		lines.push("}", "");
+	}
+	return lines.join("\n");
+};
+
+const SMALL = "package gen\n\nfunc Small() {}\n";
+
+describe("file limits", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-limits-"));
+		await writeFile(join(projectDir, "big.go"), generated(50_000));
+		await writeFile(join(projectDir, "small.go"), SMALL);
+	});
+
+	afterEach(async () => {
+		jest.restoreAllMocks();
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	it("should skip files over the default size limit", async () => {
+		const source = `package gen\n\n// ${"x".repeat(DEFAULT_MAX_FILE_BYTES)}\n`;
+		const extraction = await new SemanticAnalyzer().analyzeSource(
+			source,
+			"gen/huge.go",
+		);
+
+		expect(extraction.skipped?.reason).toBe("max-file-bytes");
+		expect(extraction.partial).toBe(true);
+		expect(extraction.nodes).toEqual([
+			expect.objectContaining({
+				id: "gen/huge.go",
+				kind: "file",
+				metadata: { skipped: extraction.skipped },
+			}),
+		]);
+	});
+
+	it("should keep scanning after skipping an oversized file", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: projectDir,
+			maxFileBytes: 64 * 1024,
+			concurrency: 1,
+		});
+		const graph = await analyzer.analyzeDirectory(projectDir);
+
+		expect(graph.hasNode("gen.Small")).toBe(true);
+		expect(graph.hasNode("gen.Generated0")).toBe(false);
+		expect(checkSkippedFiles(graph)).toEqual([
+			expect.objectContaining({
+				ruleId: "skipped-file",
+				severity: "warning",
+				filePath: "big.go",
+				line: 1,
+				metadata: { reason: "max-file-bytes" },
+			}),
+		]);
+	});
+
+	it("should skip an oversized file without reading it", async () => {
+		const readFile = jest.spyOn(fs, "readFile");
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: projectDir,
+			maxFileBytes: 64 * 1024,
+		});
+		const extraction = await analyzer.analyzeFile(join(projectDir, "big.go"));
+
+		expect(extraction.filePath).toBe("big.go");
+		expect(extraction.skipped?.reason).toBe("max-file-bytes");
+		expect(readFile).not.toHaveBeenCalled();
+	});
+
+	it("should abandon a parse that exceeds the timeout", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: projectDir,
+			parseTimeoutMs: 1,
+			concurrency: 1,
+		});
+		const graph = await analyzer.analyzeDirectory(projectDir);
+
+		// 중단된 파싱 뒤에도 같은 파서로 다음 파일을 처음부터 파싱한다
+		expect(graph.hasNode("gen.Small")).toBe(true);
+		expect(graph.getNode("big.go")?.metadata.skipped).toEqual({
+			reason: "parse-timeout",
+			message: "Parsing did not finish within 1ms",
+		});
+	});
+
+	it("should allow disabling the limits", async () => {
+		const analyzer = new SemanticAnalyzer({ maxFileBytes: 0 });
+		const extraction = await analyzer.analyzeSource(generated(10), "gen.go");
+
+		expect(extraction.skipped).toBeUndefined();
+		expect(() => new SemanticAnalyzer({ parseTimeoutMs: -1 })).toThrow(
+			"Invalid parseTimeoutMs: -1",
+		);
+	});
+});
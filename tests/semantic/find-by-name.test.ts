@@ -0,0 +1,62 @@
+/**
+ * Find By Name Tests
+ * glob/정규식 이름 패턴으로 심볼 참조 찾기 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("SemanticQueryEngine.findByName", () => {
+	const createEngine = async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		return new SemanticQueryEngine(await analyzer.analyzeFiles([DEMO_USER]));
+	};
+
+	it("should match whole names with a glob", async () => {
+		const engine = await createEngine();
+
+		const refs = engine.findByName("Get*");
+
+		expect(refs.map((ref) => ref.name)).toEqual([
+			"GetUser",
+			"GetUserByEmail",
+			"GetUserCount",
+		]);
+		expect(refs[0]).toMatchObject({
+			id: "user.UserService.GetUser",
+			kind: "method",
+			filePath: "user.go",
+			line: 70,
+			parent: "user.UserService",
+		});
+		expect(engine.findByName("User")).toHaveLength(1);
+	});
+
+	it("should match regular expressions when asked", async () => {
+		const engine = await createEngine();
+
+		expect(
+			engine.findByName("^Get.*Email$", { regex: true }).map((ref) => ref.id),
+		).toEqual(["user.UserService.GetUserByEmail"]);
+		expect(() => engine.findByName("Get(", { regex: true })).toThrow(
+			'Invalid name pattern "Get("',
+		);
+	});
+
+	it("should scope matching to the fully-qualified name", async () => {
+		const engine = await createEngine();
+
+		expect(engine.findByName("user.UserService.*User")).toEqual([]);
+		expect(
+			engine
+				.findByName("user.UserService.*User", { fqn: true })
+				.map((ref) => ref.name),
+		).toEqual(["CreateUser", "GetUser", "UpdateUser", "DeleteUser"]);
+	});
+});
@@ -0,0 +1,67 @@
+/**
+ * Find By Tag Tests
+ * 태그로 심볼 참조 찾기 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("SemanticQueryEngine.findByTag", () => {
+	it("should return every public-api symbol in the demo file", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const engine = new SemanticQueryEngine(
+			await analyzer.analyzeFiles([DEMO_USER]),
+		);
+
+		const refs = engine.findByTag("public-api");
+
+		expect(refs.map((ref) => [ref.name, ref.kind])).toEqual([
+			["user", "package"],
+			["User", "struct"],
+			["UserService", "struct"],
+			["NewUserService", "function"],
+			["CreateUser", "method"],
+			["GetUser", "method"],
+			["GetUserByEmail", "method"],
+			["UpdateUser", "method"],
+			["DeleteUser", "method"],
+			["ListUsers", "method"],
+			["SearchUsers", "method"],
+			["GetUserCount", "method"],
+			["UserRepository", "interface"],
+			["ValidateUser", "function"],
+			["UserExists", "method"],
+		]);
+		expect(refs[1]).toEqual({
+			id: "user.User",
+			name: "User",
+			kind: "struct",
+			filePath: "user.go",
+			line: 17,
+			parent: "user",
+		});
+	});
+
+	it("should match case-insensitively only when asked", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const engine = new SemanticQueryEngine(
+			analyzer.buildGraph([
+				await analyzer.analyzeSource(
+					"package user\n\n// @semantic-tags: Public-API\nfunc Get() {}\n",
+					"user/user.go",
+				),
+			]),
+		);
+
+		expect(engine.findByTag("public-api")).toEqual([]);
+		expect(
+			engine.findByTag("public-api", { caseInsensitive: true }),
+		).toMatchObject([{ id: "user.Get", line: 4 }]);
+	});
+});
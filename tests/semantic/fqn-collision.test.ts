@@ -0,0 +1,163 @@
+/**
+ * FQN Collision Policy Tests
+ * 그래프 병합 시 노드 ID 충돌 처리 정책 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkAmbiguousReferences } from "../../src/semantic/checks/ambiguous-references";
+import type { FileExtraction } from "../../src/semantic/extractors/LanguageExtractor";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestNode } from "./semantic-test-helpers";
+
+function createExtractions(): FileExtraction[] {
+	return [
+		{
+			filePath: "user/user.go",
+			language: "go",
+			nodes: [
+				createTestNode("user.User", { kind: "struct" }),
+				createTestNode("user.NewUser"),
+			],
+			edges: [{ from: "user.NewUser", to: "user.User", type: "references" }],
+		},
+		{
+			filePath: "user/user.pb.go",
+			language: "go",
+			nodes: [
+				createTestNode("user.User", {
+					kind: "struct",
+					filePath: "user/user.pb.go",
+				}),
+				createTestNode("user.UserFromProto", { filePath: "user/user.pb.go" }),
+			],
+			edges: [
+				{ from: "user.UserFromProto", to: "user.User", type: "references" },
+			],
+		},
+	];
+}
+
+describe("FQN collision policy", () => {
+	it("should keep both declarations file-scoped by default", () => {
+		const graph = new SemanticAnalyzer({ extractors: [] }).buildGraph(
+			createExtractions(),
+		);
+
+		expect(graph.hasNode("user.User")).toBe(false);
+		expect(graph.getNode("user.User@user/user.go")).toMatchObject({
+			fqn: "user.User",
+			filePath: "user/user.go",
+			metadata: { collidesWith: "user.User" },
+		});
+		expect(graph.getNode("user.User@user/user.pb.go")?.filePath).toBe(
+			"user/user.pb.go",
+		);
+		expect(graph.hasEdge("user.NewUser", "user.User@user/user.go")).toBe(
+			true,
+		);
+		expect(
+			graph.hasEdge("user.UserFromProto", "user.User@user/user.pb.go"),
+		).toBe(true);
+	});
+
+	it("should overwrite with the overwrite policy", () => {
+		const graph = new SemanticAnalyzer({
+			extractors: [],
+			collisionPolicy: "overwrite",
+		}).buildGraph(createExtractions());
+
+		expect(graph.getNode("user.User")?.filePath).toBe("user/user.pb.go");
+	});
+
+	it("should throw with the error policy", () => {
+		const analyzer = new SemanticAnalyzer({
+			extractors: [],
+			collisionPolicy: "error",
+		});
+
+		expect(() => analyzer.buildGraph(createExtractions())).toThrow(
+			"FQN collision: user.User is declared in both user/user.go and user/user.pb.go",
+		);
+	});
+
+	it("should keep the first declaration with the keep-first policy", () => {
+		const graph = new SemanticAnalyzer({
+			extractors: [],
+			collisionPolicy: "keep-first",
+		}).buildGraph(createExtractions());
+
+		expect(graph.getNode("user.User")?.filePath).toBe("user/user.go");
+		expect(graph.hasNode("user.User#2")).toBe(false);
+		expect(graph.hasEdge("user.UserFromProto", "user.User")).toBe(true);
+	});
+
+	it("should keep both declarations with the suffix policy", () => {
+		const graph = new SemanticAnalyzer({
+			extractors: [],
+			collisionPolicy: "suffix",
+		}).buildGraph(createExtractions());
+
+		expect(graph.getNode("user.User")?.filePath).toBe("user/user.go");
+		expect(graph.getNode("user.User#2")).toMatchObject({
+			fqn: "user.User#2",
+			filePath: "user/user.pb.go",
+			metadata: { collidesWith: "user.User" },
+		});
+		expect(graph.hasEdge("user.NewUser", "user.User")).toBe(true);
+		expect(graph.hasEdge("user.UserFromProto", "user.User#2")).toBe(true);
+		expect(graph.hasEdge("user.UserFromProto", "user.User")).toBe(false);
+	});
+});
+
+describe("file-scoped symbols", () => {
+	it("should retain ValidateUser from both files and flag other references", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(
+				"package user\n\nfunc ValidateUser() error { return nil }\n\nfunc Create() { ValidateUser() }\n",
+				"user/a.go",
+			),
+			await analyzer.analyzeSource(
+				"package user\n\nfunc ValidateUser() error { return nil }\n",
+				"user/b.go",
+			),
+			await analyzer.analyzeSource(
+				"package user\n\nfunc Update() { ValidateUser() }\n",
+				"user/c.go",
+			),
+		]);
+
+		expect(
+			Array.from(graph.nodes.values())
+				.filter((node) => node.name === "ValidateUser")
+				.map((node) => [node.id, node.filePath]),
+		).toEqual([
+			["user.ValidateUser@user/a.go", "user/a.go"],
+			["user.ValidateUser@user/b.go", "user/b.go"],
+		]);
+		expect(
+			graph.hasEdge("user", "user.ValidateUser@user/b.go", "contains"),
+		).toBe(true);
+		expect(
+			graph.getOutgoingEdges("user.Create", ["calls"]).map((edge) => edge.to),
+		).toEqual(["user.ValidateUser@user/a.go"]);
+		expect(graph.getOutgoingEdges("user.Update", ["calls"])).toEqual([]);
+
+		const diagnostics = checkAmbiguousReferences(graph);
+		expect(diagnostics).toHaveLength(1);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "ambiguous-reference",
+			nodeId: "user.Update",
+			filePath: "user/c.go",
+			line: 3,
+			message:
+				"user.Update references user.ValidateUser, which is declared in user/a.go, user/b.go",
+			metadata: {
+				candidates: [
+					"user.ValidateUser@user/a.go",
+					"user.ValidateUser@user/b.go",
+				],
+			},
+		});
+	});
+});
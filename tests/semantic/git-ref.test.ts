@@ -0,0 +1,185 @@
+/**
+ * Git Ref Analysis Tests
+ * 체크아웃 없이 git ref 시점의 파일을 분석하는 테스트
+ */
+
+import { execFileSync } from "node:child_process";
+import { mkdir, mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import {
+	afterEach,
+	beforeEach,
+	describe,
+	expect,
+	it,
+	jest,
+} from "@jest/globals";
+import type { LanguageExtractor } from "../../src/semantic/extractors/LanguageExtractor";
+import * as gitSource from "../../src/semantic/git-source";
+import {
+	filterGitTree,
+	type GitTreeEntry,
+	isBinaryContent,
+} from "../../src/semantic/git-source";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestNode } from "./semantic-test-helpers";
+
+/** 파일 내용의 각 줄을 노드 ID로 만드는 추출기 */
+const textExtractor: LanguageExtractor = {
+	name: "text",
+	language: "text",
+	extensions: ["txt"],
+	requiresTree: false,
+	extract: ({ sourceCode, filePath }) => ({
+		filePath,
+		language: "text",
+		nodes: sourceCode
+			.split("\n")
+			.filter(Boolean)
+			.map((line) => createTestNode(line, { filePath })),
+		edges: [],
+	}),
+};
+
+const entry = (path: string): GitTreeEntry => ({
+	mode: "100644",
+	oid: path,
+	size: 0,
+	path,
+});
+
+describe("filterGitTree", () => {
+	it("should apply nested .linkerignore rules after parent rules", () => {
+		const entries = [
+			"a.log",
+			"a.txt",
+			"gen/out.txt",
+			"lib/gen/out.txt",
+			"lib/keep.log",
+			"lib/keep.txt",
+			"lib/skip.txt",
+			"skip.txt",
+		].map(entry);
+		const ignores = new Map([
+			["", "gen/\n*.log\n"],
+			["lib", "skip.txt\n!keep.log\n"],
+		]);
+
+		const kept = filterGitTree(entries, ignores).map((e) => e.path);
+
+		expect(kept).toEqual([
+			"a.txt",
+			"lib/keep.log",
+			"lib/keep.txt",
+			"skip.txt",
+		]);
+	});
+
+	it("should skip hidden and configured directories", () => {
+		const entries = [".git/x.txt", "vendor/y.txt", "src/z.txt"].map(entry);
+
+		const kept = filterGitTree(entries, new Map(), new Set(["vendor"]));
+
+		expect(kept.map((e) => e.path)).toEqual(["src/z.txt"]);
+	});
+});
+
+describe("isBinaryContent", () => {
+	it("should treat content with NUL bytes as binary", () => {
+		expect(isBinaryContent(Buffer.from("a\0b"))).toBe(true);
+		expect(isBinaryContent(Buffer.from("plain text"))).toBe(false);
+	});
+});
+
+describe("SemanticAnalyzer.analyzeGitRef", () => {
+	let repoDir: string;
+
+	const git = (...args: string[]) =>
+		execFileSync("git", args, { cwd: repoDir, encoding: "utf-8" });
+
+	beforeEach(async () => {
+		repoDir = await mkdtemp(join(tmpdir(), "semantic-git-ref-"));
+		git("init", "-q");
+		git("config", "user.email", "test@example.com");
+		git("config", "user.name", "Test");
+		git("config", "commit.gpgsign", "false");
+
+		await mkdir(join(repoDir, "src"));
+		await mkdir(join(repoDir, "generated"));
+		await writeFile(join(repoDir, "src", "a.txt"), "alpha\nbeta\n");
+		await writeFile(join(repoDir, "generated", "g.txt"), "generated\n");
+		await writeFile(join(repoDir, "blob.txt"), Buffer.from("bin\0ary"));
+		await writeFile(join(repoDir, "notes.md"), "unsupported\n");
+		await writeFile(join(repoDir, ".linkerignore"), "generated/\n");
+		git("add", "-A");
+		git("commit", "-q", "-m", "initial");
+		git("tag", "v1");
+	});
+
+	afterEach(async () => {
+		await rm(repoDir, { recursive: true, force: true });
+	});
+
+	it("should analyze committed content instead of the working tree", async () => {
+		await writeFile(join(repoDir, "src", "a.txt"), "changed\n");
+		await writeFile(join(repoDir, "src", "new.txt"), "untracked\n");
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: repoDir,
+			extractors: [textExtractor],
+		});
+
+		const graph = await analyzer.analyzeGitRef(repoDir, "v1");
+
+		expect(Array.from(graph.nodes.keys()).sort()).toEqual(["alpha", "beta"]);
+		expect(graph.getNode("alpha")?.filePath).toBe("src/a.txt");
+	});
+
+	it("should use the .linkerignore committed at the ref", async () => {
+		await writeFile(join(repoDir, ".linkerignore"), "src/\n");
+		git("commit", "-q", "-am", "ignore src");
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: repoDir,
+			extractors: [textExtractor],
+		});
+
+		const head = await analyzer.analyzeGitRef(repoDir, "HEAD");
+		const tagged = await analyzer.analyzeGitRef(repoDir, "v1");
+
+		expect(Array.from(head.nodes.keys())).toEqual(["generated"]);
+		expect(Array.from(tagged.nodes.keys()).sort()).toEqual(["alpha", "beta"]);
+	});
+
+	it("should skip oversized blobs by tree size without reading them", async () => {
+		const readBlobs = jest.spyOn(gitSource, "readGitBlobs");
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: repoDir,
+			extractors: [textExtractor],
+			maxFileBytes: 8,
+		});
+
+		try {
+			const graph = await analyzer.analyzeGitRef(repoDir, "v1");
+
+			expect(graph.getNode("src/a.txt")?.metadata.skipped).toEqual({
+				reason: "max-file-bytes",
+				message: "File is 11 bytes, over the 8 byte limit",
+			});
+			expect(graph.hasNode("alpha")).toBe(false);
+			// 크기 제한 안의 blob.txt만 읽는다
+			expect(readBlobs.mock.calls.map(([, oids]) => oids)).toEqual([
+				[git("rev-parse", "v1:blob.txt").trim()],
+			]);
+		} finally {
+			readBlobs.mockRestore();
+		}
+	});
+
+	it("should reject an unknown ref", async () => {
+		const analyzer = new SemanticAnalyzer({ extractors: [textExtractor] });
+
+		await expect(
+			analyzer.analyzeGitRef(repoDir, "no-such-ref"),
+		).rejects.toThrow("Unknown git ref: no-such-ref");
+	});
+});
@@ -0,0 +1,86 @@
+/**
+ * Go Import Extractor Tests
+ * import 선언의 의존 엣지 추출 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+const SOURCE = `package app
+
+import "fmt"
+
+import (
+	"context"
+	_ "github.com/lib/pq"
+	. "example.com/app/testing/assert"
+	log "github.com/sirupsen/logrus"
+)
+
+func main() {}
+`;
+
+describe("GoImportExtractor", () => {
+	it("should emit an imports edge for every import form", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "app/main.go"),
+		]);
+
+		expect(graph.getNode("app/main.go")).toMatchObject({
+			kind: "file",
+			metadata: { goPackage: "app" },
+		});
+		expect(
+			graph
+				.getOutgoingEdges("app/main.go", ["imports"])
+				.map((edge) => edge.metadata),
+		).toEqual([
+			{ line: 3, path: "fmt", alias: undefined, stdlib: true },
+			{ line: 6, path: "context", alias: undefined, stdlib: true },
+			{ line: 7, path: "github.com/lib/pq", alias: "_", stdlib: false },
+			{
+				line: 8,
+				path: "example.com/app/testing/assert",
+				alias: ".",
+				stdlib: false,
+			},
+			{
+				line: 9,
+				path: "github.com/sirupsen/logrus",
+				alias: "log",
+				stdlib: false,
+			},
+		]);
+		expect(graph.getNode("github.com/sirupsen/logrus")).toMatchObject({
+			name: "logrus",
+			kind: "external",
+			metadata: { stdlib: false },
+		});
+		expect(graph.getNode("app.main")?.kind).toBe("function");
+	});
+
+	it("should add the demo user package's stdlib imports to the graph", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+
+		const imported = graph
+			.getOutgoingEdges("user.go", ["imports"])
+			.map((edge) => graph.getNode(edge.to));
+
+		expect(imported.map((node) => node?.id)).toEqual([
+			"context",
+			"database/sql",
+			"errors",
+			"time",
+		]);
+		expect(imported.every((node) => node?.metadata.stdlib === true)).toBe(
+			true,
+		);
+	});
+});
@@ -0,0 +1,126 @@
+/**
+ * Go SQL Extractor Tests
+ * 쿼리 문자열에서 테이블 참조 엣지를 추출하는 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { GoExtractor } from "../../src/semantic/extractors/GoExtractor";
+import { GoSqlExtractor } from "../../src/semantic/extractors/GoSqlExtractor";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { collectSqlTables } from "../../src/semantic/sql";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+const createAnalyzer = (projectRoot?: string) =>
+	new SemanticAnalyzer({
+		projectRoot,
+		extractors: [new GoExtractor(), new GoSqlExtractor()],
+	});
+
+describe("collectSqlTables", () => {
+	it("should find tables after FROM, JOIN, INTO and UPDATE", () => {
+		expect(collectSqlTables("INSERT INTO users (email) VALUES (?)")).toEqual([
+			"users",
+		]);
+		expect(collectSqlTables("UPDATE users SET name = ? WHERE id = ?")).toEqual([
+			"users",
+		]);
+		expect(
+			collectSqlTables(
+				"SELECT * FROM users u JOIN orders AS o ON o.user_id = u.id",
+			),
+		).toEqual(["users", "orders"]);
+		expect(collectSqlTables("SELECT * FROM accounts, audit_log a")).toEqual([
+			"accounts",
+			"audit_log",
+		]);
+	});
+
+	it("should ignore comments, literals and subquery parentheses", () => {
+		expect(
+			collectSqlTables(
+				"SELECT * FROM (SELECT id FROM users) t -- FROM comments\nWHERE x = 'FROM fake'",
+			),
+		).toEqual(["users"]);
+	});
+});
+
+describe("GoSqlExtractor", () => {
+	it("should link every demo query method to the users table", async () => {
+		const analyzer = createAnalyzer(path.dirname(DEMO_USER));
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+
+		expect(graph.getNode("table:users")?.kind).toBe("table");
+		const callers = graph
+			.getIncomingEdges("table:users", ["uses_table"])
+			.map((edge) => edge.from)
+			.sort();
+		expect(callers).toEqual([
+			"user.UserService.CreateUser",
+			"user.UserService.DeleteUser",
+			"user.UserService.GetUser",
+			"user.UserService.GetUserByEmail",
+			"user.UserService.GetUserCount",
+			"user.UserService.ListUsers",
+			"user.UserService.SearchUsers",
+			"user.UserService.UpdateUser",
+			"user.UserService.UserExists",
+		]);
+
+		const insert = graph
+			.getOutgoingEdges("user.UserService.CreateUser", ["uses_table"])
+			.map((edge) => edge.metadata);
+		expect(insert).toEqual([{ line: 45, verb: "INSERT", variable: "query" }]);
+	});
+
+	it("should only read query-looking variables and concatenated literals", async () => {
+		const analyzer = createAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(
+				[
+					"package report",
+					"",
+					"func Load() {",
+					'\tlabel := "SELECT * FROM ignored"',
+					'\tvar countSQL = "SELECT COUNT(*) " +',
+					'\t\t"FROM orders JOIN customers ON customers.id = orders.customer_id"',
+					"\tuse(label, countSQL)",
+					"}",
+					"",
+				].join("\n"),
+				"report/report.go",
+			),
+		]);
+
+		expect(
+			graph
+				.getOutgoingEdges("report.Load", ["uses_table"])
+				.map((edge) => edge.to),
+		).toEqual(["table:orders", "table:customers"]);
+		expect(graph.hasNode("table:ignored")).toBe(false);
+	});
+
+	it("should share one table node across files", async () => {
+		const analyzer = new SemanticAnalyzer({
+			extractors: [new GoExtractor(), new GoSqlExtractor()],
+			collisionPolicy: "error",
+		});
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(
+				'package a\n\nfunc A() {\n\tquery := "DELETE FROM users"\n\trun(query)\n}\n',
+				"a/a.go",
+			),
+			await analyzer.analyzeSource(
+				'package b\n\nfunc B() {\n\tquery := "SELECT id FROM users"\n\trun(query)\n}\n',
+				"b/b.go",
+			),
+		]);
+
+		expect(
+			graph
+				.getIncomingEdges("table:users", ["uses_table"])
+				.map((edge) => edge.from),
+		).toEqual(["a.A", "b.B"]);
+	});
+});
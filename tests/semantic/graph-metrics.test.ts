@@ -0,0 +1,118 @@
+/**
+ * Graph Metrics Tests
+ * 엣지 가중치에 따른 instability/PageRank 변화 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	computeHotspots,
+	computeMetrics,
+	computePageRank,
+	edgeWeightsByType,
+} from "../../src/semantic/graph-metrics";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const weighted = edgeWeightsByType({ calls: 10, references: 1 });
+
+describe("computeMetrics", () => {
+	it("should change instability when calls outweigh references", () => {
+		const graph = createTestGraph(
+			["user.A", "user.B", "user.C", "user.D"].map((id) => createTestNode(id)),
+			[
+				["user.A", "user.C", "references"],
+				["user.D", "user.C", "references"],
+				["user.C", "user.B", "calls"],
+			],
+		);
+
+		const plain = computeMetrics(graph).get("user.C");
+		expect(plain).toEqual({ afferent: 2, efferent: 1, instability: 1 / 3 });
+
+		const reweighted = computeMetrics(graph, { edgeWeight: weighted }).get(
+			"user.C",
+		);
+		expect(reweighted).toEqual({
+			afferent: 2,
+			efferent: 10,
+			instability: 10 / 12,
+		});
+	});
+});
+
+describe("computePageRank", () => {
+	it("should reorder symbols when calls outweigh references", () => {
+		const graph = createTestGraph(
+			["user.A", "user.Q", "user.X", "user.Y", "user.Z"].map((id) =>
+				createTestNode(id),
+			),
+			[
+				["user.A", "user.X", "calls"],
+				["user.A", "user.Y", "references"],
+				["user.Q", "user.Y", "references"],
+				["user.Q", "user.Z", "calls"],
+			],
+		);
+
+		const plain = computePageRank(graph);
+		expect(plain.get("user.Y")).toBeGreaterThan(plain.get("user.X") as number);
+
+		const reweighted = computePageRank(graph, { edgeWeight: weighted });
+		expect(reweighted.get("user.X")).toBeGreaterThan(
+			reweighted.get("user.Y") as number,
+		);
+		expect(reweighted.get("user.X")).toBeCloseTo(
+			reweighted.get("user.Z") as number,
+		);
+
+		const total = Array.from(reweighted.values()).reduce((a, b) => a + b, 0);
+		expect(total).toBeCloseTo(1);
+	});
+});
+
+describe("computeHotspots", () => {
+	const graph = createTestGraph(
+		["api.A", "api.B", "user.Hub", "user.Util"].map((id) =>
+			createTestNode(id),
+		),
+		[
+			["api.A", "user.Hub", "calls"],
+			["api.B", "user.Hub", "calls"],
+			["api.B", "user.Hub", "references"],
+			["user.Hub", "user.Util", "calls"],
+			["user.Hub", "user.Util", "contains"],
+			["api.A", "api.A", "calls"],
+		],
+	);
+
+	it("should report fan-in and fan-out by edge kind", () => {
+		const engine = new SemanticQueryEngine(graph);
+
+		expect(engine.fanIn("user.Hub")).toEqual({
+			total: 3,
+			byKind: { call: 2, "type-ref": 1 },
+		});
+		expect(engine.fanOut("user.Hub")).toEqual({
+			total: 1,
+			byKind: { call: 1 },
+		});
+		expect(engine.fanOut("user.Util")).toEqual({ total: 0, byKind: {} });
+	});
+
+	it("should rank symbols by weighted total degree", () => {
+		expect(
+			computeHotspots(graph, 2).map(({ node, score }) => [node.id, score]),
+		).toEqual([
+			["user.Hub", 4],
+			["api.B", 2],
+		]);
+		expect(
+			computeHotspots(graph, 2, { fanOutWeight: 0 }).map(
+				({ node }) => node.id,
+			),
+		).toEqual(["user.Hub", "user.Util"]);
+		expect(() => computeHotspots(graph, 0)).toThrow(
+			"Invalid hotspot count: 0",
+		);
+	});
+});
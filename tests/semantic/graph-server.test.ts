@@ -0,0 +1,224 @@
+/**
+ * Graph Server Tests
+ * 포커스 모드 이웃 조회, 쿼리, reload 엔드포인트 테스트
+ */
+
+import type http from "node:http";
+import type { AddressInfo } from "node:net";
+import { afterAll, beforeAll, describe, expect, it } from "@jest/globals";
+import { createGraphServer } from "../../src/semantic/server";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("GET /node/{fqn}/neighbors", () => {
+	const graph = createTestGraph(
+		[
+			createTestNode("user.UserService", { kind: "struct" }),
+			createTestNode("user.UserService.CreateUser", { kind: "method" }),
+			createTestNode("user.UserService.GetUser", { kind: "method" }),
+			createTestNode("user.ValidateUser"),
+			createTestNode("handler.Register", { filePath: "handler/handler.go" }),
+			createTestNode("db.Exec", { filePath: "db/db.go" }),
+		],
+		[
+			["user.UserService", "user.UserService.CreateUser", "contains"],
+			["user.UserService", "user.UserService.GetUser", "contains"],
+			["handler.Register", "user.UserService", "references"],
+			["user.UserService.CreateUser", "user.ValidateUser", "calls"],
+			["user.UserService.GetUser", "db.Exec", "calls"],
+		],
+	);
+	let server: http.Server;
+	let baseUrl: string;
+
+	beforeAll(async () => {
+		server = createGraphServer(graph);
+		await new Promise<void>((resolve) => server.listen(0, resolve));
+		baseUrl = `http://127.0.0.1:${(server.address() as AddressInfo).port}`;
+	});
+
+	afterAll(async () => {
+		await new Promise((resolve) => server.close(resolve));
+	});
+
+	it("should return the 1-hop neighborhood of UserService", async () => {
+		const response = await fetch(
+			`${baseUrl}/node/user.UserService/neighbors?hops=1`,
+		);
+		const body = await response.json();
+
+		expect(response.status).toBe(200);
+		expect(body.node.id).toBe("user.UserService");
+		expect(body.neighbors.map((n: { id: string }) => n.id)).toEqual([
+			"handler.Register",
+			"user.UserService.CreateUser",
+			"user.UserService.GetUser",
+		]);
+		expect(body.edges).toHaveLength(3);
+		expect(body.nextCursor).toBeUndefined();
+	});
+
+	it("should paginate high-degree nodes", async () => {
+		const first = await (
+			await fetch(`${baseUrl}/node/user.UserService/neighbors?limit=2`)
+		).json();
+		expect(first.neighbors).toHaveLength(2);
+		expect(first.nextCursor).toBeDefined();
+
+		const second = await (
+			await fetch(
+				`${baseUrl}/node/user.UserService/neighbors?limit=2&cursor=${first.nextCursor}`,
+			)
+		).json();
+		expect(second.neighbors.map((n: { id: string }) => n.id)).toEqual([
+			"user.UserService.GetUser",
+		]);
+		expect(second.nextCursor).toBeUndefined();
+	});
+
+	it("should expand further with more hops", async () => {
+		const body = await (
+			await fetch(`${baseUrl}/node/user.UserService/neighbors?hops=2`)
+		).json();
+
+		expect(body.neighbors).toHaveLength(5);
+	});
+
+	it("should reject unknown nodes and invalid hops", async () => {
+		expect(
+			(await fetch(`${baseUrl}/node/user.Missing/neighbors`)).status,
+		).toBe(404);
+		expect(
+			(await fetch(`${baseUrl}/node/user.UserService/neighbors?hops=0`)).status,
+		).toBe(400);
+	});
+});
+
+describe("graph query endpoints", () => {
+	const before = createTestGraph(
+		[
+			createTestNode("user.UserService", {
+				kind: "struct",
+				semanticTags: ["service"],
+			}),
+			createTestNode("user.ValidateUser"),
+			createTestNode("handler.Register", { filePath: "handler/handler.go" }),
+		],
+		[
+			["handler.Register", "user.UserService", "references"],
+			["user.UserService", "user.ValidateUser", "calls"],
+		],
+	);
+	const after = createTestGraph([createTestNode("user.ValidateUser")]);
+	const source = {
+		graph: before,
+		reload: async () => {
+			source.graph = after;
+			return after;
+		},
+	};
+	let server: http.Server;
+	let baseUrl: string;
+
+	beforeAll(async () => {
+		server = createGraphServer(source);
+		await new Promise<void>((resolve) => server.listen(0, resolve));
+		baseUrl = `http://127.0.0.1:${(server.address() as AddressInfo).port}`;
+	});
+
+	afterAll(async () => {
+		await new Promise((resolve) => server.close(resolve));
+	});
+
+	const get = async (route: string) => {
+		const response = await fetch(`${baseUrl}${route}`);
+		return { status: response.status, body: await response.json() };
+	};
+
+	it("should find symbols by tag and name", async () => {
+		const byTag = await get("/find-by-tag?tag=SERVICE&caseInsensitive=true");
+		expect(byTag.body.symbols.map((s: { id: string }) => s.id)).toEqual([
+			"user.UserService",
+		]);
+
+		const byName = await get("/find-by-name?pattern=Validate*");
+		expect(byName.body.symbols.map((s: { id: string }) => s.id)).toEqual([
+			"user.ValidateUser",
+		]);
+	});
+
+	it("should answer reverse deps, closure and path queries", async () => {
+		const reverse = await get("/reverse-deps?symbol=user.ValidateUser");
+		expect(
+			reverse.body.dependents.map((d: { node: { id: string } }) => d.node.id),
+		).toEqual(["user.UserService", "handler.Register"]);
+
+		const closure = await get("/closure?symbol=handler.Register&paths=true");
+		expect(closure.body.dependencies).toEqual([
+			expect.objectContaining({
+				depth: 1,
+				path: ["handler.Register", "user.UserService"],
+			}),
+			expect.objectContaining({
+				depth: 2,
+				path: ["handler.Register", "user.UserService", "user.ValidateUser"],
+			}),
+		]);
+
+		const path = await get("/path?from=handler.Register&to=user.ValidateUser");
+		expect(path.body.paths).toEqual([
+			{
+				nodes: ["handler.Register", "user.UserService", "user.ValidateUser"],
+				edgeTypes: ["references", "calls"],
+			},
+		]);
+	});
+
+	it("should validate query parameters", async () => {
+		expect(await get("/find-by-tag")).toEqual({
+			status: 400,
+			body: { error: "Missing query parameter: tag" },
+		});
+		expect(
+			(await get("/closure?symbol=user.UserService&depth=-1")).status,
+		).toBe(400);
+		expect(
+			(await get("/path?from=user.Missing&to=user.UserService")).status,
+		).toBe(404);
+		expect(
+			(await fetch(`${baseUrl}/find-by-tag?tag=x`, { method: "POST" })).status,
+		).toBe(405);
+	});
+
+	it("should report health and serve the reloaded graph", async () => {
+		expect(await get("/health")).toEqual({
+			status: 200,
+			body: { status: "ok", nodes: 3, edges: 2 },
+		});
+		expect((await get("/reload")).status).toBe(405);
+
+		const reload = await fetch(`${baseUrl}/reload`, { method: "POST" });
+		expect(await reload.json()).toEqual({
+			status: "reloaded",
+			nodes: 1,
+			edges: 0,
+		});
+		expect(
+			(await get("/reverse-deps?symbol=user.ValidateUser")).body,
+		).toEqual({ symbol: "user.ValidateUser", dependents: [] });
+	});
+});
+
+describe("POST /reload", () => {
+	it("should be unsupported for a fixed graph", async () => {
+		const server = createGraphServer(createTestGraph([]));
+		await new Promise<void>((resolve) => server.listen(0, resolve));
+		const { port } = server.address() as AddressInfo;
+
+		const response = await fetch(`http://127.0.0.1:${port}/reload`, {
+			method: "POST",
+		});
+		await new Promise((resolve) => server.close(resolve));
+
+		expect(response.status).toBe(501);
+	});
+});
@@ -0,0 +1,94 @@
+/**
+ * Graph Service Tests
+ * 그래프 다시 읽기와 실패 시 이전 그래프 유지 테스트
+ */
+
+import { mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { GraphService } from "../../src/semantic/graph-service";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("GraphService", () => {
+	let projectDir: string;
+
+	beforeEach(async () => {
+		projectDir = await mkdtemp(join(tmpdir(), "semantic-service-"));
+		await writeFile(
+			join(projectDir, "user.go"),
+			"package user\n\nfunc CreateUser() {}\n",
+		);
+	});
+
+	afterEach(async () => {
+		await rm(projectDir, { recursive: true, force: true });
+	});
+
+	it("should pick up changed files on reload", async () => {
+		const service = new GraphService(projectDir);
+		await service.reload();
+		expect(service.graph.hasNode("user.CreateUser")).toBe(true);
+
+		await writeFile(
+			join(projectDir, "user.go"),
+			"package user\n\nfunc DeleteUser() {}\n",
+		);
+		const graph = await service.reload();
+
+		expect(service.graph).toBe(graph);
+		expect(graph.hasNode("user.CreateUser")).toBe(false);
+		expect(graph.hasNode("user.DeleteUser")).toBe(true);
+	});
+
+	it("should share a pending reload and keep the graph on failure", async () => {
+		const graph = createTestGraph([createTestNode("user.CreateUser")]);
+		let loads = 0;
+		const service = new GraphService(projectDir, {
+			load: async () => {
+				loads++;
+				if (loads > 1) throw new Error("snapshot is corrupt");
+				return graph;
+			},
+		});
+
+		const [first, second] = await Promise.all([
+			service.reload(),
+			service.reload(),
+		]);
+		expect(first).toBe(second);
+		expect(loads).toBe(1);
+
+		await expect(service.reload()).rejects.toThrow("snapshot is corrupt");
+		expect(service.graph).toBe(graph);
+		await expect(service.watch()).rejects.toThrow(
+			"Cannot watch a graph that is not analyzed from source",
+		);
+	});
+
+	it("should resolve externals when rebuilding from watch events", async () => {
+		await writeFile(
+			join(projectDir, "user.go"),
+			'package user\n\nimport "github.com/acme/log"\n',
+		);
+		const controller = new AbortController();
+		const service = new GraphService(projectDir, {
+			analyzer: new SemanticAnalyzer({
+				projectRoot: projectDir,
+				externalResolver: () => ({ license: "MIT" }),
+			}),
+		});
+
+		const watching = service.watch({ signal: controller.signal });
+		while (!service.graph.hasNode("github.com/acme/log")) {
+			await new Promise((resolve) => setTimeout(resolve, 10));
+		}
+		controller.abort();
+		await watching;
+
+		expect(service.graph.getNode("github.com/acme/log")?.metadata.external).toEqual(
+			{ license: "MIT" },
+		);
+	});
+});
@@ -0,0 +1,99 @@
+/**
+ * Graph Snapshot Tests
+ * 스냅샷/롤백 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import type { SemanticGraph } from "../../src/semantic/SemanticGraph";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+function serialize(graph: SemanticGraph) {
+	return {
+		nodes: Array.from(graph.nodes.entries()),
+		edges: graph.edges.map((e) => ({ ...e })),
+		adjacency: Array.from(graph.nodes.keys()).map((id) => [
+			graph.getOutgoingEdges(id),
+			graph.getIncomingEdges(id),
+		]),
+	};
+}
+
+describe("SemanticGraph snapshots", () => {
+	const build = () =>
+		createTestGraph(
+			[
+				createTestNode("app.A"),
+				createTestNode("app.B"),
+				createTestNode("app.C"),
+			],
+			[
+				["app.A", "app.B"],
+				["app.B", "app.C"],
+				["app.C", "app.A", "references"],
+			],
+		);
+
+	it("should restore the pre-mutation state", () => {
+		const graph = build();
+		const before = serialize(graph);
+
+		const snapshot = graph.snapshot();
+		graph.addEdge({ from: "app.A", to: "app.C", type: "calls" });
+		graph.removeEdge("app.A", "app.B");
+		graph.removeNode("app.B");
+		graph.addNode(createTestNode("app.D"));
+		graph.addNode(createTestNode("app.A", { semanticTags: ["changed"] }));
+		expect(serialize(graph)).not.toEqual(before);
+
+		graph.restore(snapshot);
+		expect(serialize(graph)).toEqual(before);
+	});
+
+	it("should allow repeated experiments from the same snapshot", () => {
+		const graph = build();
+		const before = serialize(graph);
+		const snapshot = graph.snapshot();
+
+		graph.removeNode("app.C");
+		graph.restore(snapshot);
+		graph.removeNode("app.A");
+		graph.restore(snapshot);
+
+		expect(serialize(graph)).toEqual(before);
+	});
+
+	it("should invalidate snapshots taken after the restored one", () => {
+		const graph = build();
+		const outer = graph.snapshot();
+		graph.removeEdge("app.A", "app.B");
+		const inner = graph.snapshot();
+		graph.restore(outer);
+
+		expect(() => graph.restore(inner)).toThrow("is not active");
+		expect(graph.hasEdge("app.A", "app.B")).toBe(true);
+
+		graph.release(outer);
+		expect(() => graph.restore(outer)).toThrow("is not active");
+	});
+
+	it("should keep edge lookups in sync after restore", () => {
+		const graph = build();
+		const snapshot = graph.snapshot();
+
+		graph.removeNode("app.C");
+		expect(graph.getIncomingEdges("app.A")).toEqual([]);
+		expect(graph.hasEdge("app.C", "app.A", "references")).toBe(false);
+
+		graph.restore(snapshot);
+		expect(graph.hasEdge("app.C", "app.A", "references")).toBe(true);
+		expect(graph.getOutgoingEdges("app.B").map((e) => e.to)).toEqual([
+			"app.C",
+		]);
+		expect(graph.getIncomingEdges("app.A").map((e) => e.from)).toEqual([
+			"app.C",
+		]);
+		expect(graph.addEdge({ from: "app.B", to: "app.C", type: "calls" })).toBe(
+			false,
+		);
+	});
+});
@@ -0,0 +1,127 @@
+/**
+ * Graph Store Contract Tests
+ * GraphStore 구현체 공통 계약 테스트
+ */
+
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import type { GraphStore } from "../../src/semantic/store/GraphStore";
+import { InMemoryGraphStore } from "../../src/semantic/store/InMemoryGraphStore";
+import { createSQLiteGraphStore } from "../../src/semantic/store/SQLiteGraphStore";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const buildGraph = () =>
+	createTestGraph(
+		[
+			createTestNode("user.UserService", {
+				kind: "struct",
+				semanticTags: ["service", "public-api"],
+			}),
+			createTestNode("user.UserService.CreateUser", {
+				kind: "method",
+				semanticTags: ["public-api", "write"],
+				metadata: { receiverType: "UserService" },
+			}),
+			createTestNode("user.UserService.GetUser", {
+				kind: "method",
+				semanticTags: ["public-api", "read"],
+			}),
+			createTestNode("user.ValidateUser", {
+				semanticTags: ["validation"],
+				filePath: "user/validate.go",
+			}),
+		],
+		[
+			["user.UserService", "user.UserService.CreateUser", "contains"],
+			["user.UserService", "user.UserService.GetUser", "contains"],
+			["user.UserService.CreateUser", "user.ValidateUser", "calls"],
+		],
+	);
+
+describe.each<[string, () => Promise<GraphStore>]>([
+	["InMemoryGraphStore", async () => new InMemoryGraphStore()],
+	["SQLiteGraphStore", () => createSQLiteGraphStore(":memory:")],
+])("%s contract", (_name, createStore) => {
+	let store: GraphStore;
+
+	beforeEach(async () => {
+		store = await createStore();
+		await store.save(buildGraph());
+	});
+
+	afterEach(async () => {
+		await store.close();
+	});
+
+	it("should round-trip the graph", async () => {
+		const loaded = await store.load();
+		const original = buildGraph();
+
+		expect(Array.from(loaded.nodes.values())).toEqual(
+			Array.from(original.nodes.values()),
+		);
+		expect(loaded.edges).toEqual(original.edges);
+	});
+
+	it("should replace previous content on save", async () => {
+		await store.save(createTestGraph([createTestNode("app.Main")]));
+
+		const loaded = await store.load();
+		expect(Array.from(loaded.nodes.keys())).toEqual(["app.Main"]);
+		expect(loaded.edges).toEqual([]);
+	});
+
+	it("should filter nodes by all tags and kind", async () => {
+		const byTags = await store.queryNodes({ tags: ["public-api", "write"] });
+		expect(byTags.items.map((n) => n.id)).toEqual([
+			"user.UserService.CreateUser",
+		]);
+
+		const byKind = await store.queryNodes({
+			tags: ["public-api"],
+			kinds: ["method"],
+		});
+		expect(byKind.items.map((n) => n.id)).toEqual([
+			"user.UserService.CreateUser",
+			"user.UserService.GetUser",
+		]);
+
+		const byFile = await store.queryNodes({ filePaths: ["user/validate.go"] });
+		expect(byFile.items.map((n) => n.id)).toEqual(["user.ValidateUser"]);
+	});
+
+	it("should paginate nodes in ID order", async () => {
+		const first = await store.queryNodes({}, { limit: 3 });
+		expect(first.items.map((n) => n.id)).toEqual([
+			"user.UserService",
+			"user.UserService.CreateUser",
+			"user.UserService.GetUser",
+		]);
+
+		const second = await store.queryNodes(
+			{},
+			{ limit: 3, cursor: first.nextCursor },
+		);
+		expect(second.items.map((n) => n.id)).toEqual(["user.ValidateUser"]);
+		expect(second.nextCursor).toBeUndefined();
+	});
+
+	it("should filter edges by type and endpoint", async () => {
+		const contains = await store.queryEdges({
+			types: ["contains"],
+			from: "user.UserService",
+		});
+		expect(contains.map((e) => e.to)).toEqual([
+			"user.UserService.CreateUser",
+			"user.UserService.GetUser",
+		]);
+
+		const calls = await store.queryEdges({ to: "user.ValidateUser" });
+		expect(calls).toEqual([
+			{
+				from: "user.UserService.CreateUser",
+				to: "user.ValidateUser",
+				type: "calls",
+			},
+		]);
+	});
+});
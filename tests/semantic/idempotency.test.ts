@@ -0,0 +1,67 @@
+/**
+ * Idempotency Tests
+ * @idempotent 메서드의 INSERT/upsert 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkIdempotency } from "../../src/semantic/checks/idempotency";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { parseSqlStatement } from "../../src/semantic/sql";
+
+const SOURCE = `package orders
+
+const upsertOrder = \`INSERT INTO orders (id, total) VALUES ($1, $2)
+ON CONFLICT (id) DO UPDATE SET total = EXCLUDED.total\`
+
+type Store struct{}
+
+// @idempotent
+func (s *Store) Record(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO orders (id) VALUES ($1)", id)
+	return err
+}
+
+// @idempotent
+func (s *Store) Save(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, upsertOrder, id, 0)
+	return err
+}
+
+func (s *Store) Append(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, "INSERT INTO events (id) VALUES ($1)", id)
+	return err
+}
+`;
+
+describe("parseSqlStatement", () => {
+	it("should detect verbs, tables and upserts", () => {
+		expect(parseSqlStatement("select * from users")).toEqual({
+			verb: "SELECT",
+			table: "users",
+			upsert: false,
+		});
+		expect(
+			parseSqlStatement("INSERT INTO t (a) VALUES (1) ON DUPLICATE KEY UPDATE a=1"),
+		).toMatchObject({ table: "t", upsert: true });
+		expect(parseSqlStatement("orders")).toBeUndefined();
+	});
+});
+
+describe("checkIdempotency", () => {
+	it("should flag a plain INSERT and accept an upsert", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "orders/store.go"),
+		]);
+
+		const diagnostics = checkIdempotency(graph);
+
+		expect(diagnostics).toHaveLength(1);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "idempotency",
+			nodeId: "orders.Store.Record",
+			line: 10,
+			metadata: { table: "orders", callee: "s.db.ExecContext" },
+		});
+	});
+});
@@ -0,0 +1,91 @@
+/**
+ * Ignore File Tests
+ * .linkerignore 패턴 매칭 및 디렉토리 스캔 제외 테스트
+ */
+
+import { mkdir, mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { isIgnored, parseIgnoreFile } from "../../src/semantic/ignore";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+describe("isIgnored", () => {
+	const rules = parseIgnoreFile(`# generated code
+*_gen.go
+!keep_gen.go
+build/
+/docs/*.go
+\\#literal.go
+`);
+
+	it("should follow gitignore globs, negation and directory-only rules", () => {
+		expect(rules.map((rule) => rule.pattern)).toEqual([
+			"*_gen.go",
+			"keep_gen.go",
+			"build",
+			"/docs/*.go",
+			"#literal.go",
+		]);
+		expect(isIgnored("api/types_gen.go", false, rules)).toBe(true);
+		expect(isIgnored("api/keep_gen.go", false, rules)).toBe(false);
+		expect(isIgnored("cmd/build", true, rules)).toBe(true);
+		expect(isIgnored("cmd/build", false, rules)).toBe(false);
+		expect(isIgnored("docs/example.go", false, rules)).toBe(true);
+		expect(isIgnored("api/docs/example.go", false, rules)).toBe(false);
+		expect(isIgnored("#literal.go", false, rules)).toBe(true);
+	});
+
+	it("should let nested rules override their parents", () => {
+		const nested = [
+			...parseIgnoreFile("*.go\n"),
+			...parseIgnoreFile("!main.go\n", "cmd"),
+		];
+
+		expect(isIgnored("cmd/main.go", false, nested)).toBe(false);
+		expect(isIgnored("main.go", false, nested)).toBe(true);
+		expect(isIgnored("cmd/util.go", false, nested)).toBe(true);
+	});
+});
+
+describe("SemanticAnalyzer.analyzeDirectory with .linkerignore", () => {
+	let root: string;
+
+	beforeEach(async () => {
+		root = await mkdtemp(join(tmpdir(), "semantic-ignore-"));
+		await mkdir(join(root, "demo", "vendor_copy"), { recursive: true });
+		await mkdir(join(root, "app"));
+		await writeFile(join(root, ".linkerignore"), "demo/\n");
+		await writeFile(join(root, "app", ".linkerignore"), "*_gen.go\n");
+		await writeFile(
+			join(root, "app", "app.go"),
+			"package app\n\nfunc Run() {}\n",
+		);
+		await writeFile(
+			join(root, "app", "types_gen.go"),
+			"package app\n\nfunc Generated() {}\n",
+		);
+		await writeFile(
+			join(root, "demo", "vendor_copy", "lib.go"),
+			"package lib\n\nfunc Lib() {}\n",
+		);
+	});
+
+	afterEach(async () => {
+		await rm(root, { recursive: true, force: true });
+	});
+
+	it("should skip ignored files unless passed explicitly", async () => {
+		const analyzer = new SemanticAnalyzer({ projectRoot: root });
+
+		const graph = await analyzer.analyzeDirectory(root);
+		expect(graph.hasNode("app.Run")).toBe(true);
+		expect(graph.hasNode("app.Generated")).toBe(false);
+		expect(graph.hasNode("demo/vendor_copy.Lib")).toBe(false);
+
+		const explicit = await analyzer.analyzeDirectory(
+			join(root, "app", "types_gen.go"),
+		);
+		expect(explicit.hasNode("app.Generated")).toBe(true);
+	});
+});
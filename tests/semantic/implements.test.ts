@@ -0,0 +1,71 @@
+/**
+ * Interface Implementation Tests
+ * 구체 타입과 인터페이스 사이의 implements 엣지 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+const FIXTURE = path.join(
+	__dirname,
+	"../fixtures/semantic/implements/repository.go",
+);
+
+const implementsEdges = async (filePath: string) => {
+	const analyzer = new SemanticAnalyzer({
+		projectRoot: path.dirname(filePath),
+	});
+	const graph = await analyzer.analyzeFiles([filePath]);
+	return graph.edges
+		.filter((edge) => edge.type === "implements")
+		.map((edge) => [edge.from, edge.to, edge.metadata?.pointer])
+		.sort();
+};
+
+describe("implements edges", () => {
+	it("should not link the demo UserService to UserRepository", async () => {
+		expect(await implementsEdges(DEMO_USER)).toEqual([]);
+	});
+
+	it("should match full method sets, including embedded interfaces", async () => {
+		expect(await implementsEdges(FIXTURE)).toEqual([
+			["user.SQLUserRepository", "user.Reader", true],
+			["user.SQLUserRepository", "user.UserRepository", true],
+			["user.StaticReader", "user.Reader", false],
+		]);
+	});
+
+	it("should ignore parameter names but not parameter types", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(
+				[
+					"package shape",
+					"",
+					"type Sizer interface {",
+					"\tSize(a, b int) (int, error)",
+					"}",
+					"",
+					"type Box struct{}",
+					"",
+					"func (b Box) Size(width int, height int) (n int, err error) {",
+					"\treturn 0, nil",
+					"}",
+					"",
+					"type Bag struct{}",
+					"",
+					"func (b Bag) Size(width int64, height int) (int, error) {",
+					"\treturn 0, nil",
+					"}",
+					"",
+				].join("\n"),
+				"shape/shape.go",
+			),
+		]);
+
+		expect(graph.hasEdge("shape.Box", "shape.Sizer", "implements")).toBe(true);
+		expect(graph.hasEdge("shape.Bag", "shape.Sizer", "implements")).toBe(false);
+	});
+});
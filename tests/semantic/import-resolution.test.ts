@@ -0,0 +1,164 @@
+/**
+ * Import Resolution Tests
+ * import 경로를 분석된 파일/모듈 노드로 다시 연결하는 테스트
+ */
+
+import { mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import {
+	type ImportResolver,
+	loadModulePaths,
+	mapModulePath,
+	resolveImports,
+} from "../../src/semantic/import-resolution";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const analyze = async (files: Record<string, string>) => {
+	const analyzer = new SemanticAnalyzer();
+	return analyzer.buildGraph(
+		await Promise.all(
+			Object.entries(files).map(([filePath, source]) =>
+				analyzer.analyzeSource(source, filePath),
+			),
+		),
+	);
+};
+
+const importsOf = (
+	graph: ReturnType<SemanticAnalyzer["buildGraph"]>,
+	from: string,
+) =>
+	graph
+		.getOutgoingEdges(from, ["imports", "imports_type"])
+		.map((edge) => [edge.to, edge.metadata?.importPath]);
+
+describe("resolveImports", () => {
+	it("should resolve Go module paths to the analyzed package", async () => {
+		const graph = await analyze({
+			"api/handler.go": [
+				"package api",
+				"",
+				"import (",
+				'\t"fmt"',
+				'\t"github.com/acme/shop/user"',
+				")",
+				"",
+				"func Handle() { fmt.Println(user.Name()) }",
+				"",
+			].join("\n"),
+			"user/user.go": 'package user\n\nfunc Name() string { return "x" }\n',
+		});
+
+		const resolved = resolveImports(graph, {
+			modulePaths: { "github.com/acme/shop": "" },
+		});
+
+		expect(importsOf(resolved, "api/handler.go")).toEqual([
+			["fmt", undefined],
+			["user", "github.com/acme/shop/user"],
+		]);
+		expect(resolved.getNode("fmt")).toMatchObject({
+			kind: "external",
+			metadata: { importPath: "fmt" },
+		});
+		expect(resolved.hasNode("github.com/acme/shop/user")).toBe(false);
+		// 원본 그래프는 그대로
+		expect(graph.hasNode("github.com/acme/shop/user")).toBe(true);
+	});
+
+	it("should resolve relative and aliased TypeScript imports", async () => {
+		const graph = await analyze({
+			"web/src/api/user.ts": [
+				'import { get } from "./http";',
+				'import type { User } from "../models";',
+				'import { config } from "@/config.js";',
+				'import React from "react";',
+				"export const load = () => get(config.url);",
+				"",
+			].join("\n"),
+			"web/src/api/http.ts": "export const get = (url: string) => url;\n",
+			"web/src/models/index.ts": "export interface User { id: string }\n",
+			"web/src/config.ts": 'export const config = { url: "/" };\n',
+		});
+
+		const resolved = resolveImports(graph, {
+			modulePaths: { "@/": "web/src/" },
+		});
+
+		expect(importsOf(resolved, "web/src/api/user.ts")).toEqual([
+			["web/src/api/http.ts", "./http"],
+			["web/src/models/index.ts", "../models"],
+			["web/src/config.ts", "@/config.js"],
+			["react", undefined],
+		]);
+		expect(
+			resolved
+				.getOutgoingEdges("web/src/api/user.ts", ["imports_type"])
+				.map((edge) => edge.to),
+		).toEqual(["web/src/models/index.ts"]);
+	});
+
+	it("should resolve Python imports against a source root", async () => {
+		const graph = await analyze({
+			"app/services/user.py": "import db\n\ndef load():\n    db.connect()\n",
+			"app/db.py": "def connect():\n    pass\n",
+		});
+
+		expect(importsOf(resolveImports(graph), "app.services.user")).toEqual([
+			["db", undefined],
+		]);
+		expect(
+			importsOf(
+				resolveImports(graph, { modulePaths: { "": "app" } }),
+				"app.services.user",
+			),
+		).toEqual([["app.db", "db"]]);
+	});
+
+	it("should try custom resolvers before the defaults", async () => {
+		const graph = await analyze({
+			"api/handler.go": 'package api\n\nimport "legacy/user"\n',
+			"user/user.go": "package user\n",
+		});
+		const legacy: ImportResolver = {
+			languages: ["go"],
+			resolve: ({ importPath }) =>
+				importPath.startsWith("legacy/") ? importPath.slice(7) : undefined,
+		};
+
+		const resolved = resolveImports(graph, { resolvers: [legacy] });
+
+		expect(importsOf(resolved, "api/handler.go")).toEqual([
+			["user", "legacy/user"],
+		]);
+	});
+});
+
+describe("mapModulePath", () => {
+	it("should match whole path segments unless the prefix ends with a slash", () => {
+		const paths = { "github.com/acme/shop": "", "@/": "web/src/" };
+
+		expect(mapModulePath("github.com/acme/shop/user", paths)).toBe("user");
+		expect(mapModulePath("github.com/acme/shop", paths)).toBe("");
+		expect(mapModulePath("github.com/acme/shopping", paths)).toBeUndefined();
+		expect(mapModulePath("@/lib/date", paths)).toBe("web/src/lib/date");
+	});
+
+	it("should read the module path from go.mod", async () => {
+		const root = await mkdtemp(join(tmpdir(), "semantic-gomod-"));
+		try {
+			expect(await loadModulePaths(root)).toEqual({});
+			await writeFile(
+				join(root, "go.mod"),
+				"module github.com/acme/shop\n\ngo 1.22\n",
+			);
+			expect(await loadModulePaths(root)).toEqual({
+				"github.com/acme/shop": "",
+			});
+		} finally {
+			await rm(root, { recursive: true, force: true });
+		}
+	});
+});
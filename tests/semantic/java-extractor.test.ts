@@ -0,0 +1,170 @@
+/**
+ * Java Extractor Tests
+ * Java package/import, 타입/메서드 심볼, 패키지 한정 이름 해석 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { JavaExtractor } from "../../src/semantic/extractors/JavaExtractor";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const USER = `package com.example.model;
+
+/**
+ * 사용자 엔티티
+ *
+ * @semantic-tags: entity, user-domain
+ * @description 사용자 정보
+ */
+public class User {
+    public static User create(String name) {
+        return new User();
+    }
+}
+`;
+
+const REPO = `package com.example.repo;
+
+public interface UserRepository {
+    com.example.model.User findById(String id);
+}
+
+abstract class BaseService {}
+`;
+
+const SERVICE = `package com.example.service;
+
+import com.example.model.User;
+import com.example.repo.*;
+import static com.example.util.Strings.trim;
+import java.util.List;
+
+// @semantic-tags: user-service
+@Service
+public class UserService extends BaseService implements Lookup<User> {
+    private UserRepository repository;
+
+    /**
+     * @description 사용자 조회
+     */
+    public User find(String id) {
+        return repository.findById(trim(id));
+    }
+
+    public List<User> findAll() {
+        return List.of();
+    }
+
+    public List<User> findAll(int limit) {
+        return List.of(User.create("x"));
+    }
+
+    interface Lookup<T> {
+        T find(String id);
+    }
+}
+`;
+
+const analyze = async () => {
+	const analyzer = new SemanticAnalyzer({
+		extractors: [
+			new JavaExtractor({ annotationTags: { Service: ["service-struct"] } }),
+		],
+	});
+	return analyzer.buildGraph([
+		await analyzer.analyzeSource(SERVICE, "src/UserService.java"),
+		await analyzer.analyzeSource(USER, "src/User.java"),
+		await analyzer.analyzeSource(REPO, "src/UserRepository.java"),
+	]);
+};
+
+describe("JavaExtractor", () => {
+	it("should extract types, methods and doc annotations", async () => {
+		const graph = await analyze();
+		const service = "com.example.service.UserService";
+
+		expect(graph.getNode(service)).toMatchObject({
+			kind: "class",
+			line: 9,
+			semanticTags: ["user-service", "service-struct"],
+			metadata: { javaAnnotations: ["Service"], exported: true },
+		});
+		expect(graph.getNode("com.example.model.User")).toMatchObject({
+			kind: "class",
+			semanticTags: ["entity", "user-domain"],
+			description: "사용자 정보",
+		});
+		expect(graph.getNode(`${service}.find`)).toMatchObject({
+			kind: "method",
+			description: "사용자 조회",
+			metadata: {
+				parameters: [{ name: "id", type: "String" }],
+				results: ["User"],
+				signature: "find(String)",
+			},
+		});
+		expect(graph.getNode(`${service}.findAll`)?.metadata.overloads).toBe(2);
+		expect(graph.getNode(`${service}.Lookup.find`)?.metadata.exported).toBe(
+			true,
+		);
+		expect(
+			graph.getNode("com.example.repo.BaseService")?.metadata.exported,
+		).toBe(false);
+		expect(graph.hasEdge("com.example.service", service, "contains")).toBe(
+			true,
+		);
+		expect(
+			graph.hasEdge(service, `${service}.Lookup`, "contains"),
+		).toBe(true);
+	});
+
+	it("should resolve imports and package-qualified names across files", async () => {
+		const graph = await analyze();
+		const service = "com.example.service.UserService";
+
+		expect(
+			graph
+				.getOutgoingEdges("src/UserService.java", ["imports"])
+				.map((edge) => [edge.to, graph.getNode(edge.to)?.kind]),
+		).toEqual([
+			["com.example.model.User", "class"],
+			["com.example.repo", "package"],
+			["com.example.util.Strings", "external"],
+			["java.util.List", "external"],
+		]);
+		expect(
+			graph.getOutgoingEdges("src/UserService.java", ["imports"])[2]
+				.metadata,
+		).toMatchObject({ static: true, names: ["trim"] });
+
+		expect(
+			graph.hasEdge(service, "com.example.repo.BaseService", "extends"),
+		).toBe(true);
+		expect(
+			graph.hasEdge(service, `${service}.Lookup`, "implements"),
+		).toBe(true);
+		expect(
+			graph.hasEdge(
+				`${service}.find`,
+				"com.example.repo.UserRepository.findById",
+				"calls",
+			),
+		).toBe(true);
+		expect(
+			graph.hasEdge(
+				`${service}.findAll`,
+				"com.example.model.User.create",
+				"calls",
+			),
+		).toBe(true);
+		expect(
+			graph.hasEdge(`${service}.find`, "com.example.model.User", "references"),
+		).toBe(true);
+		expect(
+			graph.hasEdge(
+				"com.example.repo.UserRepository.findById",
+				"com.example.model.User",
+				"references",
+			),
+		).toBe(true);
+	});
+});
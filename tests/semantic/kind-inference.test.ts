@@ -0,0 +1,127 @@
+/**
+ * Kind Inference Tests
+ * 태그가 뜻하는 종류로 노드 종류를 정규화하고 충돌을 진단하는 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkTagKinds } from "../../src/semantic/checks/tag-kinds";
+import {
+	applyTagKinds,
+	DEFAULT_KIND_REFINEMENTS,
+	resolveTagKind,
+} from "../../src/semantic/kind-inference";
+import { parseTagTaxonomy } from "../../src/semantic/tag-taxonomy";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const buildGraph = () =>
+	createTestGraph(
+		[
+			createTestNode("user", { kind: "package" }),
+			createTestNode("user.NewUserService", {
+				semanticTags: ["constructor-function", "public-api"],
+			}),
+			createTestNode("user.UserService", {
+				kind: "struct",
+				semanticTags: ["service-struct"],
+			}),
+			createTestNode("user.Repository", {
+				kind: "type",
+				semanticTags: ["interface-type"],
+			}),
+			createTestNode("user.Validate", {
+				semanticTags: ["interface-type"],
+			}),
+			createTestNode("user.Build", {
+				kind: "type",
+				semanticTags: ["service-struct", "interface-type"],
+			}),
+		],
+		[
+			["user", "user.NewUserService", "contains"],
+			["user.NewUserService", "user.UserService", "references"],
+		],
+	);
+
+describe("resolveTagKind", () => {
+	it("should refine coarse kinds and keep the AST kind on conflicts", () => {
+		const graph = buildGraph();
+		const kindOf = (id: string) => {
+			const node = graph.getNode(id);
+			return node ? resolveTagKind(node) : undefined;
+		};
+
+		expect(kindOf("user.NewUserService")).toEqual({
+			kind: "constructor",
+			astKind: "function",
+			implied: [{ tag: "constructor-function", kind: "constructor" }],
+			conflict: false,
+		});
+		expect(kindOf("user.UserService")?.kind).toBe("struct");
+		expect(kindOf("user.Repository")?.kind).toBe("interface");
+		expect(kindOf("user.Validate")).toMatchObject({
+			kind: "function",
+			conflict: true,
+		});
+		expect(kindOf("user.Build")).toMatchObject({
+			kind: "type",
+			conflict: true,
+		});
+		expect(kindOf("user")).toMatchObject({ kind: "package", implied: [] });
+	});
+});
+
+describe("applyTagKinds", () => {
+	it("should normalize kinds and record the AST kind", () => {
+		const graph = applyTagKinds(buildGraph());
+
+		expect(graph.getNode("user.NewUserService")).toMatchObject({
+			kind: "constructor",
+			metadata: { astKind: "function" },
+		});
+		expect(
+			graph.getNode("user.UserService")?.metadata.astKind,
+		).toBeUndefined();
+		expect(graph.getNode("user.Repository")?.kind).toBe("interface");
+		expect(graph.getNode("user.Validate")?.kind).toBe("function");
+		expect(graph.edges).toHaveLength(2);
+	});
+});
+
+describe("checkTagKinds", () => {
+	it("should report tags that conflict with the extracted kind", () => {
+		const diagnostics = checkTagKinds(buildGraph());
+
+		expect(
+			diagnostics.map((d) => [d.nodeId, d.ruleId, d.severity]),
+		).toEqual([
+			["user.Validate", "tag-kind", "warning"],
+			["user.Build", "tag-kind", "warning"],
+		]);
+		expect(diagnostics[0].message).toBe(
+			"user.Validate is a function but its tags imply interface-type (interface)",
+		);
+		expect(diagnostics[1].metadata).toEqual({
+			astKind: "type",
+			impliedKinds: ["struct", "interface"],
+			tags: ["service-struct", "interface-type"],
+		});
+	});
+
+	it("should use tag kinds declared in the taxonomy", () => {
+		const taxonomy = parseTagTaxonomy(
+			"tags: [handler]\ntagKinds:\n  handler: method\n",
+			"yaml",
+		);
+		const graph = createTestGraph([
+			createTestNode("api.Handle", { semanticTags: ["handler"] }),
+		]);
+
+		expect(taxonomy.tagKinds).toEqual({ handler: "method" });
+		expect(
+			checkTagKinds(graph, {
+				tagKinds: taxonomy.tagKinds ?? {},
+				refinements: DEFAULT_KIND_REFINEMENTS,
+			}).map((d) => d.nodeId),
+		).toEqual(["api.Handle"]);
+	});
+});
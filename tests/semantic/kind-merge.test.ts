@@ -0,0 +1,70 @@
+/**
+ * Kind Merge Tests
+ * 필드/매개변수 노드를 상위 노드로 병합하는 단순화 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { edgeKey } from "../../src/semantic/graph-diff";
+import { mergeKinds } from "../../src/semantic/kind-merge";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const buildGraph = () =>
+	createTestGraph(
+		[
+			createTestNode("user", { kind: "package" }),
+			createTestNode("user.User", { kind: "struct" }),
+			createTestNode("user.User.Email", { kind: "field" }),
+			createTestNode("user.User.Address", { kind: "field" }),
+			createTestNode("user.Address", { kind: "struct" }),
+			createTestNode("user.Validate"),
+			createTestNode("user.Validate.u", { kind: "parameter" }),
+		],
+		[
+			["user", "user.User", "contains"],
+			["user", "user.Address", "contains"],
+			["user.User", "user.User.Email", "contains"],
+			["user.User", "user.User.Address", "contains"],
+			["user.User.Address", "user.Address", "references"],
+			["user.Validate", "user.Validate.u", "contains"],
+			["user.Validate.u", "user.User", "references"],
+			["user.Validate", "user.User.Email", "references"],
+		],
+	);
+
+describe("mergeKinds", () => {
+	it("should merge fields into their struct and keep edges", () => {
+		const merged = mergeKinds(buildGraph(), {
+			field: "struct",
+			parameter: "*",
+		});
+
+		expect(Array.from(merged.nodes.keys()).sort()).toEqual([
+			"user",
+			"user.Address",
+			"user.User",
+			"user.Validate",
+		]);
+		expect(merged.getNode("user.User")?.metadata.mergedNodes).toEqual([
+			"user.User.Address",
+			"user.User.Email",
+		]);
+		expect(merged.edges.map(edgeKey).sort()).toEqual(
+			[
+				{ from: "user", to: "user.Address", type: "contains" },
+				{ from: "user", to: "user.User", type: "contains" },
+				{ from: "user.User", to: "user.Address", type: "references" },
+				{ from: "user.Validate", to: "user.User", type: "references" },
+			]
+				.map(edgeKey)
+				.sort(),
+		);
+	});
+
+	it("should not mutate the source graph", () => {
+		const graph = buildGraph();
+		mergeKinds(graph, { field: "*" });
+
+		expect(graph.nodes.size).toBe(7);
+		expect(graph.edges).toHaveLength(8);
+	});
+});
@@ -0,0 +1,91 @@
+/**
+ * Layering Check Tests
+ * 태그 계층 간 의존 방향 규칙 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkLayering } from "../../src/semantic/checks/layering";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const build = () =>
+	createTestGraph(
+		[
+			createTestNode("api.Handler", {
+				kind: "struct",
+				semanticTags: ["handler", "public-api"],
+			}),
+			createTestNode("api.Handler.Get", { kind: "method" }),
+			createTestNode("user.UserService", {
+				kind: "struct",
+				semanticTags: ["service-struct", "public-api"],
+			}),
+			createTestNode("user.UserService.GetUser", { kind: "method" }),
+			createTestNode("user.UserService.Save", { kind: "method" }),
+			createTestNode("db.Query", { semanticTags: ["repository"] }),
+			createTestNode("util.Format"),
+		],
+		[
+			["api.Handler", "api.Handler.Get", "contains"],
+			["user.UserService", "user.UserService.GetUser", "contains"],
+			["user.UserService", "user.UserService.Save", "contains"],
+			["api.Handler.Get", "user.UserService.GetUser"],
+			["api.Handler.Get", "db.Query"],
+			["user.UserService.GetUser", "api.Handler.Get"],
+			["user.UserService.GetUser", "db.Query"],
+			["user.UserService.GetUser", "util.Format"],
+			["user.UserService.GetUser", "user.UserService.Save"],
+		],
+	);
+
+describe("checkLayering", () => {
+	it("should deny unlisted layer pairs in default-deny mode", () => {
+		const diagnostics = checkLayering(build(), {
+			defaultPolicy: "deny",
+			layers: ["handler", "service-*", "repository"],
+			rules: [
+				{ from: "handler", to: "service-*", allow: true },
+				{ from: "*", to: "handler", allow: false },
+				{ from: "service-*", to: "repository", allow: true },
+			],
+		});
+
+		expect(
+			diagnostics.map(({ metadata }) => [
+				metadata?.from,
+				metadata?.to,
+				metadata?.fromTag,
+				metadata?.toTag,
+				metadata?.rule,
+			]),
+		).toEqual([
+			["api.Handler.Get", "db.Query", "handler", "repository", undefined],
+			[
+				"user.UserService.GetUser",
+				"api.Handler.Get",
+				"service-struct",
+				"handler",
+				{ from: "*", to: "handler", allow: false },
+			],
+		]);
+		expect(diagnostics[1].message).toBe(
+			"user.UserService.GetUser (service-struct) must not depend on api.Handler.Get (handler) via calls",
+		);
+	});
+
+	it("should only report explicit denials in default-allow mode", () => {
+		const diagnostics = checkLayering(build(), {
+			rules: [{ from: "*", to: "handler", allow: false }],
+		});
+
+		expect(
+			diagnostics.map(({ metadata }) => [metadata?.from, metadata?.to]),
+		).toEqual([["user.UserService.GetUser", "api.Handler.Get"]]);
+		expect(
+			checkLayering(build(), {
+				rules: [{ from: "*", to: "handler", allow: false }],
+				allowSameLayer: false,
+				edgeTypes: ["contains"],
+			}),
+		).toHaveLength(0);
+	});
+});
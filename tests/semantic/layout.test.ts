@@ -0,0 +1,86 @@
+/**
+ * Layout Tests
+ * 계층 배치 힌트 계산 테스트
+ */
+
+import { mkdtemp, readFile, rm } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { computeLayers } from "../../src/semantic/layout";
+import { exportShards } from "../../src/semantic/sharded-export";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const buildDag = () =>
+	createTestGraph(
+		[
+			createTestNode("app.Main"),
+			createTestNode("app.Handler"),
+			createTestNode("app.Service"),
+			createTestNode("app.Repository"),
+			createTestNode("app.Logger"),
+			createTestNode("app", { kind: "package" }),
+		],
+		[
+			["app.Main", "app.Handler", "calls"],
+			["app.Main", "app.Logger", "calls"],
+			["app.Handler", "app.Service", "calls"],
+			["app.Handler", "app.Repository", "calls"],
+			["app.Service", "app.Repository", "calls"],
+			["app", "app.Main", "contains"],
+		],
+	);
+
+describe("computeLayers", () => {
+	it("should place dependencies in lower layers than their dependents", () => {
+		const graph = buildDag();
+		const layers = computeLayers(graph);
+
+		expect(Object.fromEntries(layers)).toEqual({
+			app: 0,
+			"app.Handler": 2,
+			"app.Logger": 0,
+			"app.Main": 3,
+			"app.Repository": 0,
+			"app.Service": 1,
+		});
+		for (const edge of graph.edges) {
+			if (edge.type === "contains") continue;
+			expect(layers.get(edge.to)).toBeLessThan(
+				layers.get(edge.from) as number,
+			);
+		}
+	});
+
+	it("should break cycles instead of recursing forever", () => {
+		const graph = createTestGraph(
+			[createTestNode("app.A"), createTestNode("app.B")],
+			[
+				["app.A", "app.B", "calls"],
+				["app.B", "app.A", "calls"],
+			],
+		);
+
+		expect(Object.fromEntries(computeLayers(graph))).toEqual({
+			"app.A": 1,
+			"app.B": 0,
+		});
+	});
+
+	it("should add a layer attribute to exported nodes", async () => {
+		const outputDir = await mkdtemp(join(tmpdir(), "semantic-layout-"));
+		try {
+			await exportShards(buildDag(), outputDir, {
+				layout: true,
+				projection: { node: ["id", "layer"] },
+			});
+
+			const shard = JSON.parse(
+				await readFile(join(outputDir, "packages/user.json"), "utf-8"),
+			);
+			expect(shard.nodes).toContainEqual({ id: "app.Main", layer: 3 });
+		} finally {
+			await rm(outputDir, { recursive: true, force: true });
+		}
+	});
+});
@@ -0,0 +1,66 @@
+/**
+ * Log Statement Tests
+ * 로깅 호출 탐지 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	checkLogStatements,
+	DEFAULT_LOGGERS,
+} from "../../src/semantic/checks/log-statements";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+import "log/slog"
+
+func DeleteUser(id int) error {
+	if id <= 0 {
+		slog.Error("invalid user id", "id", id)
+		return nil
+	}
+	logger.Debug("deleting user")
+	return nil
+}
+
+func CountUsers() int {
+	return 0
+}
+`;
+
+describe("Log statement detection", () => {
+	it("should record an error-level log on the enclosing function", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+
+		const diagnostics = checkLogStatements(graph);
+
+		expect(diagnostics).toEqual([
+			expect.objectContaining({
+				ruleId: "logs",
+				nodeId: "user.DeleteUser",
+				line: 7,
+				metadata: { level: "error", callee: "slog.Error" },
+			}),
+		]);
+	});
+
+	it("should match configured wildcard receivers", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+
+		const diagnostics = checkLogStatements(graph, {
+			...DEFAULT_LOGGERS,
+			"*.Debug": "debug",
+		});
+
+		expect(diagnostics.map((d) => d.metadata?.level)).toEqual([
+			"error",
+			"debug",
+		]);
+	});
+});
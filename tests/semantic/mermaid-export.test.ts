@@ -0,0 +1,64 @@
+/**
+ * Mermaid Export Tests
+ * Mermaid flowchart 출력 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { renderMermaid } from "../../src/semantic/mermaid-export";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const graph = createTestGraph(
+	[
+		createTestNode("api.Handle", { filePath: "api/handler.go" }),
+		createTestNode("user.(*Service).Get", {
+			name: 'Get("id")',
+			filePath: "user/service.go",
+		}),
+		createTestNode("user.load", { filePath: "user/store.go" }),
+		createTestNode("db.Query", { filePath: "db/db.go" }),
+	],
+	[
+		["api.Handle", "user.(*Service).Get", "calls"],
+		["user.(*Service).Get", "user.load", "calls"],
+		["user.load", "db.Query", "calls"],
+		["user.load", "user.(*Service).Get", "calls"],
+	],
+);
+
+describe("renderMermaid", () => {
+	it("should escape labels and group symbols by package", () => {
+		expect(renderMermaid(graph, { groupBy: "package", fence: true })).toBe(
+			[
+				"```mermaid",
+				"graph LR",
+				'\tsubgraph g0["api"]',
+				'\t\tn0["Handle"]',
+				"\tend",
+				'\tsubgraph g1["db"]',
+				'\t\tn1["Query"]',
+				"\tend",
+				'\tsubgraph g2["user"]',
+				'\t\tn2["Get#40;#quot;id#quot;#41;"]',
+				'\t\tn3["load"]',
+				"\tend",
+				"\tn0 -->|calls| n2",
+				"\tn2 -->|calls| n3",
+				"\tn3 -->|calls| n1",
+				"\tn3 -->|calls| n2",
+				"\tlinkStyle 0,1,2,3 stroke:#2ca02c",
+				"```",
+				"",
+			].join("\n"),
+		);
+	});
+
+	it("should stop at maxDepth from the roots", () => {
+		const output = renderMermaid(graph, { maxDepth: 1 });
+
+		expect(output).toContain('n0["Handle"]');
+		expect(output).toContain('n1["Get#40;#quot;id#quot;#41;"]');
+		expect(output).not.toContain("load");
+		expect(output).not.toContain("Query");
+		expect(output).toContain("\tn0 -->|calls| n1");
+	});
+});
@@ -0,0 +1,54 @@
+/**
+ * Metrics Instrumentation Tests
+ * @metric 누락 리포트 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { findMissingMetrics, getMetrics } from "../../src/semantic/metrics";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+// GetUser serves GET /users/{id}
+// @semantic-tags: public-api
+// @metric request_count, request_latency
+func GetUser(id int) error {
+	return nil
+}
+
+// ListUsers serves GET /users
+// @semantic-tags: public-api
+// @metric request_latency
+func ListUsers() error {
+	return nil
+}
+
+// rebuildIndex runs in the background
+func rebuildIndex() {}
+`;
+
+describe("findMissingMetrics", () => {
+	it("should report a public-api handler missing a required metric", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+
+		const getUser = graph.getNode("user.GetUser");
+		expect(getUser && getMetrics(getUser)).toEqual([
+			"request_count",
+			"request_latency",
+		]);
+
+		const report = findMissingMetrics(graph, {
+			"public-api": ["request_count", "request_latency"],
+		});
+
+		expect(report).toHaveLength(1);
+		expect(report[0]).toMatchObject({
+			node: { id: "user.ListUsers" },
+			missing: ["request_count"],
+			requiredBy: ["public-api"],
+		});
+	});
+});
@@ -0,0 +1,158 @@
+/**
+ * Multi-Root Tests
+ * 여러 저장소 루트를 분석해 하나의 그래프로 병합하는 테스트
+ */
+
+import { mkdir, mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import {
+	mergeRootGraphs,
+	normalizeRoots,
+	scopeRootGraph,
+} from "../../src/semantic/multi-root";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("scopeRootGraph", () => {
+	it("should prefix IDs and paths but keep shared placeholders", () => {
+		const graph = createTestGraph(
+			[
+				createTestNode("user/user.go", {
+					kind: "file",
+					metadata: { goPackage: "user" },
+				}),
+				createTestNode("user", { kind: "package" }),
+				createTestNode("user.Get"),
+				createTestNode("fmt", { kind: "external", filePath: "fmt" }),
+			],
+			[
+				["user", "user.Get", "contains"],
+				["user/user.go", "fmt", "imports"],
+			],
+		);
+
+		const scoped = scopeRootGraph(graph, "shop");
+
+		expect(Array.from(scoped.nodes.keys())).toEqual([
+			"shop/user/user.go",
+			"shop/user",
+			"shop/user.Get",
+			"fmt",
+		]);
+		expect(scoped.getNode("shop/user/user.go")).toMatchObject({
+			filePath: "shop/user/user.go",
+			metadata: { goPackage: "shop/user", root: "shop" },
+		});
+		expect(scoped.getNode("shop/user.Get")?.fqn).toBe("user.Get");
+		expect(scoped.getNode("fmt")?.metadata.roots).toEqual(["shop"]);
+		expect(scoped.edges.map((edge) => [edge.from, edge.to])).toEqual([
+			["shop/user", "shop/user.Get"],
+			["shop/user/user.go", "fmt"],
+		]);
+	});
+});
+
+describe("mergeRootGraphs", () => {
+	it("should collapse shared external dependencies", () => {
+		const root = (label: string) =>
+			scopeRootGraph(
+				createTestGraph(
+					[
+						createTestNode("main.go", { kind: "file" }),
+						createTestNode("fmt", { kind: "external" }),
+					],
+					[["main.go", "fmt", "imports"]],
+				),
+				label,
+			);
+
+		const merged = mergeRootGraphs([root("shop"), root("billing")]);
+
+		expect(merged.nodes.size).toBe(3);
+		expect(merged.getNode("fmt")?.metadata.roots).toEqual(["billing", "shop"]);
+		expect(merged.getIncomingEdges("fmt").map((edge) => edge.from)).toEqual([
+			"shop/main.go",
+			"billing/main.go",
+		]);
+	});
+});
+
+describe("normalizeRoots", () => {
+	it("should label roots by directory name and reject duplicates", () => {
+		expect(
+			normalizeRoots(["/src/shop", { label: "pay", path: "/x" }]),
+		).toEqual([
+			{ label: "shop", path: "/src/shop" },
+			{ label: "pay", path: "/x" },
+		]);
+		expect(() => normalizeRoots(["/a/shop", "/b/shop"])).toThrow(
+			"Duplicate root label: shop",
+		);
+	});
+});
+
+describe("SemanticAnalyzer.analyzeRoots", () => {
+	let workspace: string;
+
+	beforeEach(async () => {
+		workspace = await mkdtemp(join(tmpdir(), "semantic-roots-"));
+		await mkdir(join(workspace, "billing", "invoice"), { recursive: true });
+		await mkdir(join(workspace, "shop", "order"), { recursive: true });
+		await writeFile(
+			join(workspace, "billing", "go.mod"),
+			"module github.com/acme/billing\n",
+		);
+		await writeFile(
+			join(workspace, "billing", "invoice", "invoice.go"),
+			'package invoice\n\nimport "fmt"\n\nfunc Create() { fmt.Println() }\n',
+		);
+		await writeFile(
+			join(workspace, "shop", "go.mod"),
+			"module github.com/acme/shop\n",
+		);
+		await writeFile(
+			join(workspace, "shop", "order", "order.go"),
+			[
+				"package order",
+				"",
+				"import (",
+				'\t"fmt"',
+				'\t"github.com/acme/billing/invoice"',
+				")",
+				"",
+				"func Place() { fmt.Println(); invoice.Create() }",
+				"",
+			].join("\n"),
+		);
+	});
+
+	afterEach(async () => {
+		await rm(workspace, { recursive: true, force: true });
+	});
+
+	it("should resolve imports across roots", async () => {
+		const analyzer = new SemanticAnalyzer();
+
+		const graph = await analyzer.analyzeRoots([
+			join(workspace, "shop"),
+			join(workspace, "billing"),
+		]);
+
+		expect(graph.getNode("billing/invoice.Create")).toMatchObject({
+			filePath: "billing/invoice/invoice.go",
+			metadata: { root: "billing" },
+		});
+		expect(
+			graph
+				.getOutgoingEdges("shop/order/order.go", ["imports"])
+				.map((edge) => [edge.to, edge.metadata?.importPath]),
+		).toEqual([
+			["fmt", undefined],
+			["billing/invoice", "github.com/acme/billing/invoice"],
+		]);
+		expect(graph.hasNode("github.com/acme/billing/invoice")).toBe(false);
+		expect(graph.getNode("fmt")?.metadata.roots).toEqual(["billing", "shop"]);
+	});
+});
@@ -0,0 +1,238 @@
+/**
+ * Package Rollup Tests
+ * 패키지 노드와 패키지 단위 의존 그래프 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import {
+	couplingScore,
+	findPackageId,
+	graphByPackage,
+	mostCoupledPairs,
+} from "../../src/semantic/packages";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("package nodes", () => {
+	it("should contain the demo's top-level declarations", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+
+		expect(graph.getNode("user")).toMatchObject({
+			kind: "package",
+			filePath: "user.go",
+			line: 5,
+			semanticTags: ["user-package", "user-domain", "public-api"],
+			description: "사용자 관리 기능을 제공하는 패키지",
+		});
+
+		const contained = graph
+			.getOutgoingEdges("user", ["contains"])
+			.map((edge) => edge.to);
+		expect(contained).toEqual(
+			expect.arrayContaining([
+				"user.User",
+				"user.UserService",
+				"user.NewUserService",
+				"user.UserRepository",
+				"user.ValidateUser",
+			]),
+		);
+		expect(contained).not.toContain("user.UserService.GetUser");
+		expect(findPackageId(graph, "user.UserService.GetUser")).toBe("user");
+		expect(findPackageId(graph, "user.User.Email")).toBe("user");
+	});
+
+	it("should merge one package declared across files", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const extractions = [
+			await analyzer.analyzeSource(
+				"package user\n\nfunc Save() {}\n",
+				"user/store.go",
+			),
+			await analyzer.analyzeSource(
+				"// @semantic-tags: user-package\npackage user\n\nfunc Get() {}\n",
+				"user/user.go",
+			),
+		];
+		const graph = analyzer.buildGraph(extractions);
+		const reversed = analyzer.buildGraph([...extractions].reverse());
+
+		expect(graph.getNode("user")).toMatchObject({
+			filePath: "user/user.go",
+			semanticTags: ["user-package"],
+		});
+		expect(reversed.getNode("user")).toEqual(graph.getNode("user"));
+		expect(graph.hasEdge("user", "user.Save", "contains")).toBe(true);
+		expect(graph.hasEdge("user", "user.Get", "contains")).toBe(true);
+	});
+
+	it("should keep same-named packages in different directories apart", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(
+				"package config\n\nfunc Load() {}\n",
+				"internal/config/config.go",
+			),
+			await analyzer.analyzeSource(
+				"package config\n\nfunc Load() {}\n",
+				"pkg/config/config.go",
+			),
+			await analyzer.analyzeSource(
+				"package main\n\nfunc main() {}\n",
+				"main.go",
+			),
+			await analyzer.analyzeSource(
+				"package main\n\nfunc main() {}\n",
+				"cmd/tool/main.go",
+			),
+		]);
+
+		expect(graph.getNode("internal/config")).toMatchObject({
+			kind: "package",
+			name: "config",
+			filePath: "internal/config/config.go",
+		});
+		expect(graph.getNode("pkg/config")?.filePath).toBe("pkg/config/config.go");
+		expect(
+			graph.hasEdge("internal/config", "internal/config.Load", "contains"),
+		).toBe(true);
+		expect(graph.hasEdge("pkg/config", "pkg/config.Load", "contains")).toBe(
+			true,
+		);
+		expect(graph.getNode("main")?.filePath).toBe("main.go");
+		expect(graph.getNode("cmd/tool")?.name).toBe("main");
+		expect(graph.hasNode("cmd/tool.main")).toBe(true);
+		expect(
+			Array.from(graph.nodes.values()).filter(
+				(node) => node.metadata.collidesWith,
+			),
+		).toEqual([]);
+	});
+});
+
+describe("graphByPackage", () => {
+	it("should roll symbol edges up to weighted package edges", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(
+				[
+					"package api",
+					"",
+					"type Handler struct{}",
+					"",
+					"func (h *Handler) Get() {",
+					"\tuser.Load()",
+					"\tuser.Validate()",
+					"\th.render()",
+					"}",
+					"",
+					"func (h *Handler) render() {}",
+					"",
+				].join("\n"),
+				"api/handler.go",
+			),
+			await analyzer.analyzeSource(
+				"package user\n\nfunc Load() {\n\tValidate()\n}\n\nfunc Validate() {}\n",
+				"user/user.go",
+			),
+		]);
+		// 패키지 간 호출은 import 해석 없이 직접 연결
+		graph.addEdge({ from: "api.Handler.Get", to: "user.Load", type: "calls" });
+		graph.addEdge({
+			from: "api.Handler.Get",
+			to: "user.Validate",
+			type: "calls",
+		});
+
+		const packages = graphByPackage(graph);
+
+		expect(Array.from(packages.nodes.keys()).sort()).toEqual(["api", "user"]);
+		expect(packages.getNode("api")?.metadata).toMatchObject({
+			nodeIds: ["api.Handler", "api.Handler.Get", "api.Handler.render"],
+			internalEdges: 1,
+		});
+		expect(packages.edges).toEqual([
+			{
+				from: "api",
+				to: "user",
+				type: "depends_on",
+				metadata: { count: 2, types: { calls: 2 } },
+			},
+		]);
+		expect(packages.getNode("user")?.metadata.internalEdges).toBe(1);
+	});
+});
+
+describe("couplingScore", () => {
+	const packageGraph = () =>
+		graphByPackage(
+			createTestGraph(
+				[
+					createTestNode("api", { kind: "package" }),
+					createTestNode("user", { kind: "package" }),
+					createTestNode("db", { kind: "package" }),
+					createTestNode("api.A"),
+					createTestNode("api.B"),
+					createTestNode("user.U1"),
+					createTestNode("user.U2"),
+					createTestNode("db.D"),
+				],
+				[
+					["api", "api.A", "contains"],
+					["api", "api.B", "contains"],
+					["user", "user.U1", "contains"],
+					["user", "user.U2", "contains"],
+					["db", "db.D", "contains"],
+					["api.A", "user.U1"],
+					["api.B", "user.U1"],
+					["user.U2", "api.A"],
+					["api.A", "api.B"],
+					["user.U1", "db.D"],
+					["user.U1", "user.U2"],
+				],
+			),
+		);
+
+	it("should count crossing edges in both directions", () => {
+		const packages = packageGraph();
+
+		expect(couplingScore(packages, "api", "user")).toEqual({
+			packageA: "api",
+			packageB: "user",
+			aToB: 2,
+			bToA: 1,
+			score: 0.6,
+		});
+		expect(couplingScore(packages, "user", "api")).toMatchObject({
+			aToB: 1,
+			bToA: 2,
+			score: 0.6,
+		});
+		expect(couplingScore(packages, "api", "db").score).toBe(0);
+		expect(() => couplingScore(packages, "api", "web")).toThrow(
+			"Unknown package: web",
+		);
+	});
+
+	it("should rank the most coupled package pairs", () => {
+		const packages = packageGraph();
+
+		expect(
+			mostCoupledPairs(packages, 5).map((pair) => [
+				pair.packageA,
+				pair.packageB,
+				pair.score,
+			]),
+		).toEqual([
+			["api", "user", 0.6],
+			["db", "user", 0.5],
+		]);
+		expect(mostCoupledPairs(packages, 1)).toHaveLength(1);
+	});
+});
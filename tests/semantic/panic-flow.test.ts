@@ -0,0 +1,54 @@
+/**
+ * Panic Flow Detection Tests
+ * panic/recover 탐지 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkPanicFlows } from "../../src/semantic/checks/panic-flow";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package app
+
+func mustLoad() {
+	panic("boom")
+}
+
+func safeRun() {
+	defer func() {
+		if r := recover(); r != nil {
+			println("recovered")
+		}
+	}()
+	mustLoad()
+}
+
+func explode() {
+	panic("unguarded")
+}
+`;
+
+describe("Panic flow detection", () => {
+	it("should mark functions that panic or recover", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "app/app.go"),
+		]);
+
+		expect(graph.getNode("app.mustLoad")?.metadata.panics).toBe(true);
+		expect(graph.getNode("app.safeRun")?.metadata.recovers).toBe(true);
+		expect(graph.getNode("app.safeRun")?.metadata.panics).toBe(false);
+		expect(graph.hasEdge("app.safeRun", "app.mustLoad", "calls")).toBe(true);
+	});
+
+	it("should only report panics without a recovering caller", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "app/app.go"),
+		]);
+
+		const diagnostics = checkPanicFlows(graph);
+		expect(diagnostics.map((d) => d.nodeId)).toEqual(["app.explode"]);
+		expect(diagnostics[0].ruleId).toBe("panics");
+		expect(diagnostics[0].line).toBe(16);
+	});
+});
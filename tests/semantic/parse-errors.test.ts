@@ -0,0 +1,47 @@
+/**
+ * Parse Error Tests
+ * 구문 오류가 있는 파일의 부분 추출 결과와 오류 목록 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const BROKEN = `package shop
+
+// @semantic-tags: cart-function
+func CartTotal() int {
+	return 0
+}
+
+func Broken( {
+`;
+
+describe("parse errors", () => {
+	it("should keep valid symbols and report syntax errors", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const extraction = await analyzer.analyzeSource(BROKEN, "shop/cart.go");
+
+		expect(extraction.partial).toBe(true);
+		expect(
+			extraction.nodes.find((node) => node.id === "shop.CartTotal"),
+		).toMatchObject({ line: 4, semanticTags: ["cart-function"] });
+		expect(extraction.errors?.length).toBeGreaterThan(0);
+		for (const error of extraction.errors ?? []) {
+			expect(error.line).toBeGreaterThanOrEqual(8);
+			expect(error.message).toMatch(/^(Syntax error|Missing )/);
+			expect(error.endIndex).toBeGreaterThanOrEqual(error.startIndex);
+			expect(BROKEN.slice(error.startIndex, error.endIndex)).toBe(error.text);
+		}
+	});
+
+	it("should report a complete file as not partial", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const extraction = await analyzer.analyzeSource(
+			"package shop\n\nfunc CartTotal() int {\n\treturn 0\n}\n",
+			"shop/cart.go",
+		);
+
+		expect(extraction.partial).toBe(false);
+		expect(extraction.errors).toEqual([]);
+	});
+});
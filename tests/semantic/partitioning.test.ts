@@ -0,0 +1,76 @@
+/**
+ * Partitioning Tests
+ * 샤드 분할 분석 및 병합 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { edgeKey } from "../../src/semantic/graph-diff";
+import { partitionFiles } from "../../src/semantic/partitioning";
+import type { SemanticGraph } from "../../src/semantic/SemanticGraph";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const FIXTURE = path.join(__dirname, "../fixtures/semantic/shards");
+const FILES = ["model.go", "repository.go", "service.go", "validate.go"].map(
+	(file) => path.join(FIXTURE, "user", file),
+);
+
+function snapshot(graph: SemanticGraph) {
+	return {
+		nodes: Array.from(graph.nodes.values()).sort((a, b) =>
+			a.id < b.id ? -1 : 1,
+		),
+		edges: graph.edges.map(edgeKey).sort(),
+	};
+}
+
+describe("partitionFiles", () => {
+	it("should keep directories together by default", () => {
+		const shards = partitionFiles(
+			["a/x.go", "a/y.go", "a/z.go", "b/x.go", "c/x.go"],
+			2,
+		);
+
+		expect(shards).toEqual([
+			["a/x.go", "a/y.go", "a/z.go"],
+			["b/x.go", "c/x.go"],
+		]);
+	});
+
+	it("should balance individual files by cost", () => {
+		const sizes: Record<string, number> = { a: 5, b: 3, c: 2, d: 2 };
+		const shards = partitionFiles(["a", "b", "c", "d"], 2, {
+			strategy: "cost",
+			cost: (file) => sizes[file],
+		});
+
+		expect(shards).toEqual([
+			["a", "d"],
+			["b", "c"],
+		]);
+	});
+});
+
+describe("mergeShards", () => {
+	it("should equal a single-pass analysis after merging two shards", async () => {
+		const analyzer = new SemanticAnalyzer({ projectRoot: FIXTURE });
+		const shards = partitionFiles(FILES, 2, { strategy: "cost" });
+		expect(shards.map((files) => files.length)).toEqual([2, 2]);
+
+		const partials = [];
+		for (const files of shards) {
+			partials.push(await analyzer.analyzePartial(files));
+		}
+		expect(partials.some((partial) => partial.unresolved.length > 0)).toBe(
+			true,
+		);
+
+		const merged = analyzer.mergeShards(partials);
+		const single = await analyzer.analyzeFiles(FILES);
+
+		expect(snapshot(merged)).toEqual(snapshot(single));
+		expect(
+			merged.hasEdge("user.Service.Register", "user.validate", "calls"),
+		).toBe(true);
+	});
+});
@@ -0,0 +1,63 @@
+/**
+ * Pattern Extractor Tests
+ * 호출 패턴 기반 사용자 정의 심볼 추출 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { PatternExtractor } from "../../src/semantic/extractors/PatternExtractor";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const FIXTURES = path.join(__dirname, "../fixtures/semantic");
+
+describe("PatternExtractor", () => {
+	const analyzer = new SemanticAnalyzer({
+		projectRoot: FIXTURES,
+		extractors: [
+			new PatternExtractor({
+				language: "typescript",
+				extensions: ["ts"],
+				patterns: [{ call: "defineService", kind: "service" }],
+			}),
+		],
+	});
+
+	it("should extract a service node from a top-level defineService call", async () => {
+		const graph = await analyzer.analyzeFiles([
+			path.join(FIXTURES, "services.ts"),
+		]);
+
+		expect(graph.getNode("service:billing")).toMatchObject({
+			name: "billing",
+			kind: "service",
+			filePath: "services.ts",
+			line: 7,
+			semanticTags: ["billing", "public-api"],
+			description: "Issues and settles invoices",
+		});
+		// 선언 범위는 호출 식, 이름 범위는 따옴표를 포함한 이름 인자
+		expect(graph.getNode("service:billing")?.range).toMatchObject({
+			startLine: 7,
+			startColumn: 24,
+			endLine: 9,
+			endColumn: 3,
+		});
+		expect(graph.getNode("service:billing")?.nameRange).toMatchObject({
+			startLine: 7,
+			startColumn: 38,
+			endLine: 7,
+			endColumn: 47,
+		});
+	});
+
+	it("should ignore calls inside function bodies", async () => {
+		const graph = await analyzer.analyzeFiles([
+			path.join(FIXTURES, "services.ts"),
+		]);
+
+		expect(Array.from(graph.nodes.keys()).sort()).toEqual([
+			"service:billing",
+			"service:users",
+		]);
+	});
+});
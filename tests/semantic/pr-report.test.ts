@@ -0,0 +1,79 @@
+/**
+ * PR Impact Report Tests
+ * 그래프 diff + 영향 범위 리포트 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	createPrReport,
+	renderPrReportMarkdown,
+} from "../../src/semantic/pr-report";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+function buildGraph(changed: boolean) {
+	return createTestGraph(
+		[
+			createTestNode("user.ValidateUser", {
+				semanticTags: changed ? ["validation", "strict"] : ["validation"],
+			}),
+			createTestNode("user.UserService.CreateUser", { kind: "method" }),
+			createTestNode("handler.Register", { filePath: "handler/handler.go" }),
+			createTestNode("billing.Charge", { filePath: "billing/billing.go" }),
+			...(changed ? [] : [createTestNode("user.LegacyCheck")]),
+		],
+		[
+			["user.UserService.CreateUser", "user.ValidateUser", "calls"],
+			["handler.Register", "user.UserService.CreateUser", "calls"],
+			["billing.Charge", "handler.Register", "contains"],
+			...(changed
+				? []
+				: ([["user.ValidateUser", "user.LegacyCheck", "calls"]] as Array<
+						[string, string, string]
+					>)),
+		],
+	);
+}
+
+describe("createPrReport", () => {
+	const report = createPrReport(buildGraph(false), buildGraph(true));
+
+	it("should list structural changes", () => {
+		expect(report.diff.changedNodes.map((c) => c.after.id)).toEqual([
+			"user.ValidateUser",
+		]);
+		expect(report.diff.changedNodes[0].changes).toEqual(["semanticTags"]);
+		expect(report.diff.removedNodes.map((n) => n.id)).toEqual([
+			"user.LegacyCheck",
+		]);
+		expect(report.diff.removedEdges).toHaveLength(1);
+	});
+
+	it("should list transitive dependents of the change", () => {
+		expect(
+			report.affected.map((entry) => [entry.node.id, entry.depth]),
+		).toEqual([
+			["user.UserService.CreateUser", 1],
+			["handler.Register", 2],
+		]);
+	});
+
+	it("should render both the change and its dependents as Markdown", () => {
+		const markdown = renderPrReportMarkdown(report);
+
+		expect(markdown).toContain("### Changed symbols (1)");
+		expect(markdown).toContain("- `user.ValidateUser`: semanticTags");
+		expect(markdown).toContain("### Removed symbols (1)");
+		expect(markdown).toContain("### Affected dependents (2)");
+		expect(markdown).toContain("- `user.UserService.CreateUser` (direct)");
+		expect(markdown).toContain("- `handler.Register` (depth 2)");
+		expect(markdown).not.toContain("billing.Charge");
+	});
+
+	it("should report no changes for identical graphs", () => {
+		const markdown = renderPrReportMarkdown(
+			createPrReport(buildGraph(false), buildGraph(false)),
+		);
+
+		expect(markdown).toContain("No structural changes.");
+	});
+});
@@ -0,0 +1,172 @@
+/**
+ * Protocol Buffer Extractor Tests
+ * .proto 정의 추출 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const USER_PROTO = `syntax = "proto3";
+
+package user.v1;
+
+import "common/v1/types.proto";
+
+option go_package = "example.com/gen/user/v1;userpb";
+
+// @semantic-tags: user-domain, public-api
+message User {
+  int64 id = 1;
+  string email = 2;
+
+  message Address {
+    string city = 1;
+  }
+}
+
+enum Status {
+  STATUS_UNSPECIFIED = 0;
+  STATUS_ACTIVE = 1;
+}
+
+service UserService {
+  rpc GetUser(GetUserRequest) returns (User);
+  rpc WatchUsers(GetUserRequest) returns (stream User) {}
+}
+
+message GetUserRequest {
+  int64 id = 1;
+}
+`;
+
+const GENERATED_GO = `package userpb
+
+type User struct {
+	Id int64
+}
+
+type User_Address struct {
+	City string
+}
+
+type Address struct {
+	Street string
+}
+
+type UserServiceServer interface {
+	GetUser() (*User, error)
+}
+`;
+
+describe("ProtoExtractor", () => {
+	it("should extract messages, enums, services, rpcs and imports", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(USER_PROTO, "proto/user/v1/user.proto"),
+		]);
+
+		expect(graph.getNode("user.v1.User")?.kind).toBe("message");
+		expect(graph.getNode("user.v1.User")?.semanticTags).toEqual([
+			"user-domain",
+			"public-api",
+		]);
+		expect(graph.getNode("user.v1.User.Address")?.kind).toBe("message");
+		expect(graph.getNode("user.v1.Status")?.kind).toBe("enum");
+		expect(graph.getNode("user.v1.UserService")?.kind).toBe("service");
+
+		const getUser = graph.getNode("user.v1.UserService.GetUser");
+		expect(getUser?.kind).toBe("rpc");
+		expect(getUser?.metadata.request).toBe("user.v1.GetUserRequest");
+		expect(getUser?.metadata.response).toBe("user.v1.User");
+		expect(
+			graph.getNode("user.v1.UserService.WatchUsers")?.metadata.serverStreaming,
+		).toBe(true);
+
+		expect(
+			graph.hasEdge(
+				"proto/user/v1/user.proto",
+				"common/v1/types.proto",
+				"imports",
+			),
+		).toBe(true);
+		expect(
+			graph.hasEdge("user.v1.UserService", "user.v1.UserService.GetUser", "contains"),
+		).toBe(true);
+		expect(
+			graph.hasEdge("user.v1.UserService.GetUser", "user.v1.User", "references"),
+		).toBe(true);
+	});
+
+	it("should record declaration and name ranges", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const { nodes } = await analyzer.analyzeSource(
+			USER_PROTO,
+			"proto/user/v1/user.proto",
+		);
+		const text = (id: string, key: "range" | "nameRange") => {
+			const range = nodes.find((node) => node.id === id)?.[key];
+			return USER_PROTO.slice(range?.startByte, range?.endByte);
+		};
+
+		expect(text("user.v1.User", "range")).toBe(
+			USER_PROTO.slice(
+				USER_PROTO.indexOf("message User"),
+				USER_PROTO.indexOf("}\n\nenum") + 1,
+			),
+		);
+		expect(text("user.v1.User", "nameRange")).toBe("User");
+		expect(text("user.v1.User.Address", "range")).toBe(
+			"message Address {\n    string city = 1;\n  }",
+		);
+		expect(text("user.v1.UserService.GetUser", "range")).toBe(
+			"rpc GetUser(GetUserRequest) returns (User);",
+		);
+		expect(text("user.v1.UserService.WatchUsers", "range")).toBe(
+			"rpc WatchUsers(GetUserRequest) returns (stream User) {}",
+		);
+		expect(
+			nodes.find((node) => node.id === "user.v1.UserService.GetUser")
+				?.nameRange,
+		).toMatchObject({ startLine: 25, startColumn: 7, endColumn: 14 });
+	});
+
+	it("should link proto definitions to generated Go types", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(USER_PROTO, "proto/user/v1/user.proto"),
+			await analyzer.analyzeSource(GENERATED_GO, "gen/user/v1/user.pb.go"),
+		]);
+
+		expect(
+			graph.hasEdge("user.v1.User", "gen/user/v1.User", "generates"),
+		).toBe(true);
+		expect(
+			graph.hasEdge(
+				"user.v1.UserService",
+				"gen/user/v1.UserServiceServer",
+				"generates",
+			),
+		).toBe(true);
+	});
+
+	it("should link nested messages to Parent_Child Go types", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(USER_PROTO, "proto/user/v1/user.proto"),
+			await analyzer.analyzeSource(GENERATED_GO, "gen/user/v1/user.pb.go"),
+		]);
+
+		expect(graph.getNode("user.v1.User.Address")?.metadata.goName).toBe(
+			"User_Address",
+		);
+		expect(
+			graph.getOutgoingEdges("user.v1.User.Address", ["generates"]),
+		).toEqual([
+			{
+				from: "user.v1.User.Address",
+				to: "gen/user/v1.User_Address",
+				type: "generates",
+			},
+		]);
+	});
+});
@@ -0,0 +1,98 @@
+/**
+ * Public Surface Tests
+ * 공개 심볼만 남기는 publicOnly 분석 모드 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+const ORDERS = `package orders
+
+// @semantic-tags: public-api
+type OrderService struct {
+	Store Store
+	cache map[string]int
+}
+
+// @semantic-tags: public-api
+func (s *OrderService) Place() {
+	s.validate()
+	audit()
+}
+
+// @semantic-tags: public-api
+func (s *OrderService) validate() {}
+
+// @semantic-tags: public-api
+func audit() {}
+
+type ledger struct{}
+
+func (l *ledger) Append() {}
+`;
+
+describe("publicOnly", () => {
+	it("should keep the demo's exported symbols", async () => {
+		const options = { projectRoot: path.dirname(DEMO_USER) };
+		const full = await new SemanticAnalyzer(options).analyzeFiles([
+			DEMO_USER,
+		]);
+		const graph = await new SemanticAnalyzer({
+			...options,
+			publicOnly: true,
+		}).analyzeFiles([DEMO_USER]);
+
+		const callables = (ids: string[]) =>
+			ids.filter((id) => /^user\.(\w+\.)?[A-Z]\w*$/.test(id)).sort();
+		expect(callables(Array.from(graph.nodes.keys()))).toEqual(
+			callables(Array.from(full.nodes.keys())),
+		);
+		expect(graph.hasNode("user.UserService.db")).toBe(false);
+		expect(graph.hasNode("user.UserService.GetUser")).toBe(true);
+	});
+
+	it("should prune unexported symbols and edges to them", async () => {
+		const analyzer = new SemanticAnalyzer({ publicOnly: true });
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(ORDERS, "orders/service.go"),
+			await analyzer.analyzeSource(
+				"export function render() {}\nfunction helper() {}\n",
+				"web/view.ts",
+			),
+			await analyzer.analyzeSource(
+				"def load():\n    pass\n\ndef _parse():\n    pass\n",
+				"app/db.py",
+			),
+		]);
+
+		expect(
+			Array.from(graph.nodes.keys())
+				.filter((id) => id.startsWith("orders."))
+				.sort(),
+		).toEqual([
+			"orders.OrderService",
+			"orders.OrderService.Place",
+			"orders.OrderService.Store",
+		]);
+		expect(
+			graph.getOutgoingEdges("orders.OrderService.Place", ["calls"]),
+		).toEqual([]);
+		expect(graph.hasNode("web/view.ts#render")).toBe(true);
+		expect(graph.hasNode("web/view.ts#helper")).toBe(false);
+		expect(graph.hasNode("app.db.load")).toBe(true);
+		expect(graph.hasNode("app.db._parse")).toBe(false);
+
+		const engine = new SemanticQueryEngine(graph);
+		expect(engine.findByTag("public-api").map((ref) => ref.id)).toEqual([
+			"orders.OrderService",
+			"orders.OrderService.Place",
+		]);
+		expect(engine.findByName("*e*").map((ref) => ref.id)).not.toContain(
+			"orders.OrderService.validate",
+		);
+	});
+});
@@ -0,0 +1,97 @@
+/**
+ * Python Extractor Tests
+ * Python import, 최상위 정의, 호출 관계 추출 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { pythonModuleName } from "../../src/semantic/extractors/PythonExtractor";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SERVICE = `import os
+import numpy as np
+from app.db import connect, Session as DbSession
+from . import cache
+
+# UserService manages users
+# @semantic-tags: user-service, public-api
+# @description: 사용자 서비스
+class UserService:
+    def get(self, user_id):
+        return connect().find(user_id)
+
+
+# @semantic-tags: helper
+@cache.memoize
+def load_all():
+    helper()
+    return np.array(DbSession().all())
+
+
+def helper():
+    return os.getcwd()
+`;
+
+const DB = `def connect():
+    return None
+`;
+
+describe("PythonExtractor", () => {
+	it("should extract imports, top-level definitions and calls", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SERVICE, "app/services/user.py"),
+			await analyzer.analyzeSource(DB, "app/db.py"),
+		]);
+
+		expect(graph.getNode("app.services.user")?.kind).toBe("module");
+		expect(
+			graph
+				.getOutgoingEdges("app.services.user", ["imports"])
+				.map((edge) => [
+					edge.to,
+					edge.metadata?.names,
+					edge.metadata?.aliases,
+				]),
+		).toEqual([
+			["os", [], []],
+			["numpy", [], ["np"]],
+			["app.db", ["connect", "Session"], ["DbSession"]],
+			["app.services", ["cache"], []],
+		]);
+		expect(graph.getNode("app.db")?.kind).toBe("module");
+		expect(graph.getNode("numpy")?.kind).toBe("external");
+
+		expect(graph.getNode("app.services.user.UserService")).toMatchObject({
+			kind: "class",
+			line: 9,
+			semanticTags: ["user-service", "public-api"],
+			description: "사용자 서비스",
+		});
+		expect(graph.getNode("app.services.user.load_all")).toMatchObject({
+			kind: "function",
+			line: 15,
+			semanticTags: ["helper"],
+		});
+
+		expect(
+			graph.hasEdge(
+				"app.services.user.UserService",
+				"app.db.connect",
+				"calls",
+			),
+		).toBe(true);
+		expect(
+			graph.hasEdge(
+				"app.services.user.load_all",
+				"app.services.user.helper",
+				"calls",
+			),
+		).toBe(true);
+	});
+
+	it("should derive module names from file paths", () => {
+		expect(pythonModuleName("app/services/user.py")).toBe("app.services.user");
+		expect(pythonModuleName("app/services/__init__.py")).toBe("app.services");
+		expect(pythonModuleName("./main.py")).toBe("main");
+	});
+});
@@ -0,0 +1,65 @@
+/**
+ * Rate Limit Tests
+ * @rate-limit 파싱 및 누락 리포트 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	findMissingRateLimits,
+	parseRateLimit,
+} from "../../src/semantic/rate-limit";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+// GetUser serves GET /users/{id}
+// @semantic-tags: public-api
+// @rate-limit 100/min
+func GetUser(id int) error {
+	return nil
+}
+
+// DeleteUser serves DELETE /users/{id}
+// @semantic-tags: public-api
+func DeleteUser(id int) error {
+	return nil
+}
+
+// purge runs from a cron job
+func purge() {}
+`;
+
+describe("parseRateLimit", () => {
+	it("should normalize the window unit", () => {
+		expect(parseRateLimit({ "rate-limit": ["5 / second"] })).toEqual({
+			limit: 5,
+			window: "second",
+			windowMs: 1000,
+			raw: "5 / second",
+		});
+		expect(parseRateLimit({ "rate-limit": ["fast"] })).toBeUndefined();
+		expect(parseRateLimit({ "rate-limit": ["10/fortnight"] })).toBeUndefined();
+	});
+});
+
+describe("findMissingRateLimits", () => {
+	it("should report a public-api handler lacking a rate limit", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+
+		expect(graph.getNode("user.GetUser")?.metadata.rateLimit).toEqual({
+			limit: 100,
+			window: "minute",
+			windowMs: 60_000,
+			raw: "100/min",
+		});
+
+		const missing = findMissingRateLimits(graph);
+
+		expect(missing.map((entry) => [entry.node.id, entry.requiredBy])).toEqual([
+			["user.DeleteUser", ["public-api"]],
+		]);
+	});
+});
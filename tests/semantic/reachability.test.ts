@@ -0,0 +1,65 @@
+/**
+ * Reachability Tests
+ * 증분 도달 가능성 갱신과 전체 재계산 비교 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	computeReachability,
+	ReachabilityIndex,
+} from "../../src/semantic/reachability";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const IDS = ["a", "b", "c", "d", "e", "f"];
+
+describe("ReachabilityIndex", () => {
+	it("should match full recomputation after a series of edge mutations", () => {
+		const graph = createTestGraph(
+			IDS.map((id) => createTestNode(id)),
+			[
+				["a", "b", "calls"],
+				["b", "c", "calls"],
+				["d", "e", "calls"],
+				["a", "f", "contains"],
+			],
+		);
+		const index = new ReachabilityIndex(graph, { incremental: true });
+
+		const steps: Array<() => boolean> = [
+			() => index.addEdge({ from: "c", to: "d", type: "calls" }),
+			() => index.addEdge({ from: "e", to: "a", type: "imports" }),
+			() => index.addEdge({ from: "c", to: "d", type: "imports" }),
+			() => index.removeEdge("c", "d", "calls"),
+			() => index.removeEdge("c", "d", "imports"),
+			() => index.addEdge({ from: "f", to: "f", type: "calls" }),
+			() => index.removeEdge("a", "b", "calls"),
+			() => index.addEdge({ from: "b", to: "e", type: "calls" }),
+			() => index.removeEdge("e", "a", "imports"),
+			() => index.removeEdge("a", "f", "contains"),
+		];
+
+		for (const step of steps) {
+			expect(step()).toBe(true);
+			expect(index.snapshot()).toEqual(computeReachability(graph));
+		}
+
+		expect(Array.from(index.reachable("b")).sort()).toEqual(["c", "e"]);
+		expect(index.canReach("f", "f")).toBe(true);
+	});
+
+	it("should report cycles through the start node", () => {
+		const graph = createTestGraph(
+			IDS.slice(0, 3).map((id) => createTestNode(id)),
+			[
+				["a", "b", "calls"],
+				["b", "a", "calls"],
+			],
+		);
+		const index = new ReachabilityIndex(graph, { incremental: true });
+
+		expect(index.canReach("a", "a")).toBe(true);
+		index.addEdge({ from: "b", to: "c", type: "calls" });
+		expect(Array.from(index.reachable("a")).sort()).toEqual(["a", "b", "c"]);
+		expect(index.removeEdge("c", "a", "calls")).toBe(false);
+	});
+});
@@ -0,0 +1,63 @@
+/**
+ * Receiver Method Tests
+ * 메서드 리시버 타입과 contains 엣지 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+
+const TYPES = `package user
+
+// @semantic-tags: public-api
+type UserService struct{}
+`;
+
+const METHODS = `package user
+
+// @semantic-tags: public-api
+func (s *UserService) CreateUser() error {
+	return nil
+}
+
+// @semantic-tags: public-api
+func (svc UserService) GetUser() error {
+	return nil
+}
+`;
+
+describe("receiver methods", () => {
+	it("should attach value and pointer receiver methods to their type", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(TYPES, "user/types.go"),
+			await analyzer.analyzeSource(METHODS, "user/methods.go"),
+		]);
+
+		expect(graph.getNode("user.UserService.CreateUser")?.metadata).toMatchObject(
+			{
+				receiverType: "UserService",
+				receiverName: "s",
+				pointerReceiver: true,
+			},
+		);
+		expect(graph.getNode("user.UserService.GetUser")?.metadata).toMatchObject({
+			receiverType: "UserService",
+			receiverName: "svc",
+			pointerReceiver: false,
+		});
+		expect(
+			graph
+				.getOutgoingEdges("user.UserService", ["contains"])
+				.map((edge) => edge.to)
+				.sort(),
+		).toEqual(["user.UserService.CreateUser", "user.UserService.GetUser"]);
+
+		const refs = new SemanticQueryEngine(graph).findByTag("public-api");
+		expect(refs.map((ref) => [ref.id, ref.parent])).toEqual([
+			["user.UserService.CreateUser", "user.UserService"],
+			["user.UserService.GetUser", "user.UserService"],
+			["user.UserService", "user"],
+		]);
+	});
+});
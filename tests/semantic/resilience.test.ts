@@ -0,0 +1,69 @@
+/**
+ * Resilience Policy Tests
+ * @retry / @timeout 어노테이션 파싱 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { parseDocAnnotations } from "../../src/semantic/annotations";
+import { checkResiliencePolicies } from "../../src/semantic/checks/resilience-policy";
+import {
+	getResiliencePolicy,
+	parseDuration,
+	parseResiliencePolicy,
+} from "../../src/semantic/resilience";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+type UserService struct{}
+
+// GetUser loads a user
+// @retry 3 @timeout 5s
+func (s *UserService) GetUser(id int) error {
+	return nil
+}
+
+// DeleteUser removes a user
+func (s *UserService) DeleteUser(id int) error {
+	return nil
+}
+`;
+
+describe("Resilience policies", () => {
+	it("should parse @retry 3 @timeout 5s into a policy", () => {
+		const { annotations } = parseDocAnnotations(["@retry 3 @timeout 5s"]);
+
+		expect(parseResiliencePolicy(annotations)).toEqual({
+			retries: 3,
+			timeout: "5s",
+			timeoutMs: 5000,
+		});
+	});
+
+	it("should parse Go durations and ignore invalid values", () => {
+		expect(parseDuration("1m30s")).toBe(90_000);
+		expect(parseDuration("250ms")).toBe(250);
+		expect(parseDuration("soon")).toBeUndefined();
+		expect(
+			parseResiliencePolicy({ retry: ["many"], timeout: ["later"] }),
+		).toBeUndefined();
+	});
+
+	it("should attach policies to methods and report methods without one", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+
+		const getUser = graph.getNode("user.UserService.GetUser");
+		expect(getUser && getResiliencePolicy(getUser)).toEqual({
+			retries: 3,
+			timeout: "5s",
+			timeoutMs: 5000,
+		});
+
+		expect(checkResiliencePolicies(graph).map((d) => d.nodeId)).toEqual([
+			"user.UserService.DeleteUser",
+		]);
+	});
+});
@@ -0,0 +1,76 @@
+/**
+ * Resource Ownership Tests
+ * @owns 반환 자원 정리 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkResourceOwnership } from "../../src/semantic/checks/resource-ownership";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package storage
+
+// OpenLog opens the append-only log; the caller must close it
+// @owns
+func OpenLog(path string) (*os.File, error) {
+	return os.Open(path)
+}
+
+func Count(path string) int {
+	f, err := OpenLog(path)
+	if err != nil {
+		return 0
+	}
+	return lines(f)
+}
+
+func Touch(path string) {
+	OpenLog(path)
+}
+
+func Tail(path string) string {
+	f, _ := OpenLog(path)
+	defer f.Close()
+	return ""
+}
+
+func Leak(path string) {
+	f, _ := OpenLog(path)
+	f.Stat()
+}
+
+// Reopen hands the log to its caller
+// @owns
+func Reopen(path string) (*os.File, error) {
+	return OpenLog(path)
+}
+
+func lines(f *os.File) int {
+	return 0
+}
+`;
+
+describe("checkResourceOwnership", () => {
+	it("should flag callers ignoring or never closing an owned resource", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "storage/log.go"),
+		]);
+
+		const site = (
+			graph.getNode("storage.Count")?.metadata.callSites as Array<{
+				callee: string;
+				assignedTo?: string[];
+			}>
+		).find((s) => s.callee === "OpenLog");
+		expect(site?.assignedTo).toEqual(["f", "err"]);
+
+		const diagnostics = checkResourceOwnership(graph);
+
+		expect(
+			diagnostics.map((d) => [d.nodeId, d.line, d.metadata?.reason]),
+		).toEqual([
+			["storage.Touch", 18, "discarded"],
+			["storage.Leak", 28, "not-closed"],
+		]);
+	});
+});
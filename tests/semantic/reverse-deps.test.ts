@@ -0,0 +1,79 @@
+/**
+ * Reverse Dependency Tests
+ * 심볼에 의존하는 심볼 조회와 트리 출력 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { formatReverseDepsTree } from "../../src/cli/actions/reverse-deps-action";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+// handler -> GetUser, router -> handler, Ping <-> Pong -> router 순환
+const build = () =>
+	createTestGraph(
+		[
+			createTestNode("user.UserService", { kind: "struct", line: 10 }),
+			createTestNode("user.UserService.GetUser", {
+				kind: "method",
+				line: 20,
+			}),
+			createTestNode("api.Handle", { filePath: "api/handler.go", line: 5 }),
+			createTestNode("api.Route", { filePath: "api/router.go", line: 8 }),
+			createTestNode("api.Ping", { filePath: "api/ping.go", line: 3 }),
+			createTestNode("api.Pong", { filePath: "api/ping.go", line: 9 }),
+		],
+		[
+			["user.UserService", "user.UserService.GetUser", "contains"],
+			["api.Handle", "user.UserService.GetUser"],
+			["api.Route", "api.Handle"],
+			["api.Pong", "api.Route"],
+			["api.Ping", "api.Pong"],
+			["api.Pong", "api.Ping"],
+		],
+	);
+
+describe("reverseDeps", () => {
+	it("should walk dependents of a type and its methods through cycles", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		expect(
+			engine
+				.reverseDeps("user.UserService")
+				.map((entry) => [entry.node.id, entry.depth, entry.via]),
+		).toEqual([
+			["api.Handle", 1, "user.UserService.GetUser"],
+			["api.Route", 2, "api.Handle"],
+			["api.Pong", 3, "api.Route"],
+			["api.Ping", 4, "api.Pong"],
+		]);
+	});
+
+	it("should stop at the given depth", () => {
+		const engine = new SemanticQueryEngine(build());
+
+		expect(
+			engine.reverseDeps("api.Handle", 2).map((entry) => entry.node.id),
+		).toEqual(["api.Route", "api.Pong"]);
+		expect(() => engine.reverseDeps("api.Handle", -1)).toThrow(
+			"Invalid depth: -1",
+		);
+		expect(() => engine.reverseDeps("api.Missing")).toThrow(
+			"Unknown symbol: api.Missing",
+		);
+	});
+
+	it("should format dependents as an indented tree", () => {
+		const graph = build();
+		const root = graph.getNode("user.UserService");
+		const entries = new SemanticQueryEngine(graph).reverseDeps(
+			"user.UserService",
+			2,
+		);
+
+		expect(root && formatReverseDepsTree(root, entries)).toEqual([
+			"user.UserService (user/user.go:10)",
+			"  └─ api.Handle (api/handler.go:5) (via GetUser)",
+			"     └─ api.Route (api/router.go:8)",
+		]);
+	});
+});
@@ -0,0 +1,95 @@
+/**
+ * Rule Engine Tests
+ * 태그 규칙 일괄 실행 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import {
+	DEFAULT_TAG_RULES,
+	runChecks,
+} from "../../src/semantic/checks/run-checks";
+import { createTagCombinationRule } from "../../src/semantic/checks/tag-combinations";
+import { createTagExclusivityRule } from "../../src/semantic/checks/tag-exclusivity";
+import { RuleEngine, type TagRule } from "../../src/semantic/rule-engine";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("RuleEngine", () => {
+	it("should collect violations from all rules in one pass", () => {
+		const graph = createTestGraph([
+			createTestNode("user.Export", {
+				semanticTags: ["public-api", "internal"],
+			}),
+			createTestNode("user.Email", { semanticTags: ["pii"] }),
+			createTestNode("user.Helper", { semanticTags: ["Internal"] }),
+		]);
+
+		const visited: string[] = [];
+		const lowercase: TagRule = {
+			id: "lowercase-tags",
+			check(node) {
+				visited.push(node.id);
+				return node.semanticTags
+					.filter((tag) => tag !== tag.toLowerCase())
+					.map((tag) => ({
+						ruleId: "lowercase-tags",
+						severity: "warning" as const,
+						message: `${node.fqn} has non-lowercase tag: ${tag}`,
+						nodeId: node.id,
+					}));
+			},
+		};
+
+		const engine = new RuleEngine([
+			createTagExclusivityRule(),
+			createTagCombinationRule(),
+		]);
+		engine.register(lowercase);
+
+		const diagnostics = engine.run(graph);
+
+		expect(engine.getRuleIds()).toEqual([
+			"tag-exclusivity",
+			"tag-combination",
+			"lowercase-tags",
+		]);
+		expect(diagnostics.map((d) => [d.ruleId, d.nodeId])).toEqual([
+			["tag-exclusivity", "user.Export"],
+			["tag-combination", "user.Email"],
+			["lowercase-tags", "user.Helper"],
+		]);
+		expect(visited).toEqual(["user.Export", "user.Email", "user.Helper"]);
+	});
+
+	it("should reject duplicate rule ids", () => {
+		const engine = new RuleEngine([createTagExclusivityRule()]);
+
+		expect(() => engine.register(createTagExclusivityRule())).toThrow(
+			"Tag rule already registered: tag-exclusivity",
+		);
+	});
+
+	it("should run the default tag rules in a single pass from runChecks", () => {
+		const graph = createTestGraph([
+			createTestNode("user.Export", {
+				semanticTags: ["public-api", "internal"],
+			}),
+			createTestNode("user.Email", { semanticTags: ["pii"] }),
+		]);
+
+		const visited: string[] = [];
+		const counting: TagRule = {
+			id: "counting",
+			check(node) {
+				visited.push(node.id);
+				return [];
+			},
+		};
+		const diagnostics = runChecks(graph, {}, { ...DEFAULT_TAG_RULES, counting });
+
+		expect(diagnostics.map((d) => [d.ruleId, d.nodeId])).toEqual([
+			["tag-exclusivity", "user.Export"],
+			["tag-combination", "user.Email"],
+		]);
+		expect(visited).toEqual(["user.Export", "user.Email"]);
+	});
+});
@@ -0,0 +1,74 @@
+/**
+ * Semantic Query Pagination Tests
+ * 커서 기반 페이지네이션 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("SemanticQueryEngine pagination", () => {
+	const nodes = Array.from({ length: 25 }, (_, i) =>
+		createTestNode(`user.Func${String(i).padStart(2, "0")}`, {
+			semanticTags: i % 5 === 0 ? ["internal"] : ["public-api"],
+		}),
+	);
+	const engine = new SemanticQueryEngine(createTestGraph(nodes));
+
+	it("should return the full result when no page is given", () => {
+		const result = engine.queryByTag("public-api");
+		expect(result.items).toHaveLength(20);
+		expect(result.nextCursor).toBeUndefined();
+	});
+
+	it("should iterate all pages of a tag query without duplicates", () => {
+		const all = engine.queryByTag("public-api").items.map((n) => n.id);
+		const collected: string[] = [];
+
+		let cursor: string | undefined;
+		let pages = 0;
+		do {
+			const page = engine.queryByTag("public-api", { cursor, limit: 6 });
+			expect(page.items.length).toBeLessThanOrEqual(6);
+			collected.push(...page.items.map((n) => n.id));
+			cursor = page.nextCursor;
+			pages++;
+		} while (cursor);
+
+		expect(pages).toBe(4);
+		expect(new Set(collected).size).toBe(collected.length);
+		expect(collected).toEqual(all);
+	});
+
+	it("should paginate pattern queries with stable ordering", () => {
+		const first = engine.queryByPattern(/Func1/, { limit: 5 });
+		expect(first.items.map((n) => n.name)).toEqual([
+			"Func10",
+			"Func11",
+			"Func12",
+			"Func13",
+			"Func14",
+		]);
+		const second = engine.queryByPattern(/Func1/, {
+			cursor: first.nextCursor,
+			limit: 5,
+		});
+		expect(second.items.map((n) => n.name)).toEqual([
+			"Func15",
+			"Func16",
+			"Func17",
+			"Func18",
+			"Func19",
+		]);
+		expect(second.nextCursor).toBeUndefined();
+	});
+
+	it("should reject invalid cursors and limits", () => {
+		expect(() =>
+			engine.queryByTag("public-api", { cursor: "%%%", limit: 5 }),
+		).toThrow("Invalid pagination cursor");
+		expect(() => engine.queryByTag("public-api", { limit: 0 })).toThrow(
+			"Invalid page limit",
+		);
+	});
+});
@@ -0,0 +1,46 @@
+/**
+ * Semantic Graph Test Helpers
+ */
+
+import { SemanticGraph } from "../../src/semantic/SemanticGraph";
+import type { SemanticEdge, SemanticNode } from "../../src/semantic/types";
+
+/**
+ * Create a semantic node with sensible defaults
+ */
+export function createTestNode(
+	id: string,
+	overrides: Partial<SemanticNode> = {},
+): SemanticNode {
+	const name = id.split(".").pop() || id;
+	return {
+		id,
+		fqn: id,
+		name,
+		kind: "function",
+		filePath: "user/user.go",
+		language: "go",
+		line: 1,
+		semanticTags: [],
+		metadata: {},
+		...overrides,
+	};
+}
+
+/**
+ * Build a graph from nodes and [from, to, type] edge tuples
+ */
+export function createTestGraph(
+	nodes: SemanticNode[],
+	edges: Array<[string, string, string?]> = [],
+): SemanticGraph {
+	const graph = new SemanticGraph();
+	for (const node of nodes) {
+		graph.addNode(node);
+	}
+	for (const [from, to, type] of edges) {
+		const edge: SemanticEdge = { from, to, type: type ?? "calls" };
+		graph.addEdge(edge);
+	}
+	return graph;
+}
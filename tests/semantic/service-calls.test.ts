@@ -0,0 +1,52 @@
+/**
+ * Service Call Tests
+ * HTTP 클라이언트 호출 대상 호스트 연결 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { linkServiceCalls } from "../../src/semantic/service-calls";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const BILLING = `package billing
+
+const chargesURL = "https://payments.example.com/v1/charges"
+
+func FetchRates() {
+	http.Get("https://rates.example.com/latest?base=USD")
+}
+
+func Charge(ctx context.Context) {
+	req, _ := http.NewRequestWithContext(ctx, "POST", chargesURL, nil)
+	http.Post(chargesURL, "application/json", nil)
+	http.Get(endpoint(ctx))
+	_ = req
+}
+`;
+
+describe("HTTP service call linking", () => {
+	it("should link functions calling literal or constant URLs to host nodes", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(BILLING, "billing/billing.go"),
+		]);
+
+		expect(linkServiceCalls(graph)).toBe(2);
+		expect(graph.getNode("service:rates.example.com")?.kind).toBe("service");
+
+		const rates = graph.getOutgoingEdges("billing.FetchRates", [
+			"calls-service",
+		]);
+		expect(rates.map((edge) => [edge.to, edge.metadata?.line])).toEqual([
+			["service:rates.example.com", 6],
+		]);
+
+		// 같은 호스트를 여러 번 호출해도 엣지는 하나
+		const charge = graph
+			.getOutgoingEdges("billing.Charge", ["calls-service"])
+			.map((edge) => edge.to);
+		expect(charge).toEqual(["service:payments.example.com"]);
+		expect(
+			graph.getNode("billing.Charge")?.metadata.unresolvedServiceCalls,
+		).toEqual(["endpoint(ctx)"]);
+	});
+});
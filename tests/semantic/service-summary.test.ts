@@ -0,0 +1,108 @@
+/**
+ * Service Summary Tests
+ * 동사 태그 기반 서비스 메서드 요약 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import {
+	renderServiceSummaryMarkdown,
+	summarizeServices,
+} from "../../src/semantic/service-summary";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+const ORDERS = `package orders
+
+type Repository struct{}
+
+func (r *Repository) Find() {}
+
+// @description: 주문 서비스
+type OrderService struct{}
+
+// @semantic-tags: create-method, update-method
+// @description: 주문 생성 또는 갱신
+func (s *OrderService) Upsert(order Order) (string, error) {
+	return "", nil
+}
+
+func (s *OrderService) flush() {}
+`;
+
+describe("summarizeServices", () => {
+	it("should group the demo service methods by verb tag", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const summaries = summarizeServices(
+			await analyzer.analyzeFiles([DEMO_USER]),
+		);
+
+		expect(summaries.map((summary) => summary.service)).toEqual([
+			"user.UserService",
+		]);
+		expect(
+			summaries[0].categories.map(({ category, operations }) => [
+				category,
+				operations.map((operation) => operation.name),
+			]),
+		).toEqual([
+			["create", ["CreateUser"]],
+			["read", ["GetUser", "GetUserByEmail"]],
+			["update", ["UpdateUser"]],
+			["delete", ["DeleteUser"]],
+			["count", ["GetUserCount"]],
+			["list", ["ListUsers"]],
+			["search", ["SearchUsers"]],
+			["other", ["UserExists"]],
+		]);
+		expect(summaries[0].categories[1].operations[0]).toMatchObject({
+			id: "user.UserService.GetUser",
+			parameters: [
+				{ name: "ctx", type: "context.Context" },
+				{ name: "id", type: "int64" },
+			],
+			results: ["*User", "error"],
+			line: 70,
+		});
+	});
+
+	it("should list multi-verb methods under each category", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const summaries = summarizeServices(
+			analyzer.buildGraph([
+				await analyzer.analyzeSource(ORDERS, "orders/service.go"),
+			]),
+		);
+
+		expect(renderServiceSummaryMarkdown(summaries)).toBe(
+			[
+				"# Service Summary",
+				"",
+				"## OrderService",
+				"",
+				"주문 서비스",
+				"",
+				"`orders.OrderService` (orders/service.go)",
+				"",
+				"### create",
+				"",
+				"- `Upsert(order Order) (string, error)` - 주문 생성 또는 갱신",
+				"",
+				"### update",
+				"",
+				"- `Upsert(order Order) (string, error)` - 주문 생성 또는 갱신",
+				"",
+				"### other",
+				"",
+				"- `flush()`",
+				"",
+			].join("\n"),
+		);
+		expect(renderServiceSummaryMarkdown([])).toBe(
+			"# Service Summary\n\n_No tagged service methods._\n",
+		);
+	});
+});
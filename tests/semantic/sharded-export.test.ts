@@ -0,0 +1,104 @@
+/**
+ * Sharded Export Tests
+ * 패키지별 증분 JSON 익스포트 테스트
+ */
+
+import { mkdtemp, readFile, rm, stat } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { exportShards, readShardIndex } from "../../src/semantic/sharded-export";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("exportShards", () => {
+	let outputDir: string;
+
+	beforeEach(async () => {
+		outputDir = await mkdtemp(join(tmpdir(), "semantic-shards-"));
+	});
+
+	afterEach(async () => {
+		await rm(outputDir, { recursive: true, force: true });
+	});
+
+	const buildGraph = (description?: string) =>
+		createTestGraph(
+			[
+				createTestNode("user.UserService", {
+					filePath: "services/user/user.go",
+					description,
+				}),
+				createTestNode("billing.Invoice", {
+					filePath: "services/billing/invoice.go",
+				}),
+			],
+			[["user.UserService", "billing.Invoice", "calls"]],
+		);
+
+	it("should write one shard per package plus an index", async () => {
+		const result = await exportShards(buildGraph(), outputDir);
+
+		expect(result.written.sort()).toEqual([
+			"index.json",
+			"packages/services__billing.json",
+			"packages/services__user.json",
+		]);
+
+		const index = await readShardIndex(outputDir);
+		expect(index?.shards["services/user"]).toMatchObject({
+			file: "packages/services__user.json",
+			nodeCount: 1,
+			edgeCount: 1,
+		});
+	});
+
+	it("should rewrite only the changed package shard and the index", async () => {
+		await exportShards(buildGraph(), outputDir);
+		const billingPath = join(outputDir, "packages/services__billing.json");
+		const billingBefore = await stat(billingPath);
+
+		const result = await exportShards(buildGraph("changed"), outputDir);
+
+		expect(result.written).toEqual([
+			"packages/services__user.json",
+			"index.json",
+		]);
+		expect(result.unchanged).toEqual(["packages/services__billing.json"]);
+		expect((await stat(billingPath)).mtimeMs).toBe(billingBefore.mtimeMs);
+
+		const userShard = JSON.parse(
+			await readFile(join(outputDir, "packages/services__user.json"), "utf-8"),
+		);
+		expect(userShard.nodes[0].description).toBe("changed");
+	});
+
+	it("should not touch any file when nothing changed", async () => {
+		await exportShards(buildGraph(), outputDir);
+		const result = await exportShards(buildGraph(), outputDir);
+
+		expect(result.written).toEqual([]);
+		expect(result.unchanged).toHaveLength(2);
+	});
+
+	it("should write only projected attributes", async () => {
+		const graph = buildGraph();
+		graph.getNode("user.UserService")?.semanticTags.push("public-api");
+
+		await exportShards(graph, outputDir, {
+			projection: {
+				node: ["fqn", "semanticTags", "metadata.owners"],
+				edge: ["from", "to"],
+			},
+		});
+
+		const userShard = JSON.parse(
+			await readFile(join(outputDir, "packages/services__user.json"), "utf-8"),
+		);
+		expect(userShard.nodes).toEqual([
+			{ fqn: "user.UserService", semanticTags: ["public-api"] },
+		]);
+		expect(userShard.edges).toEqual([
+			{ from: "user.UserService", to: "billing.Invoice" },
+		]);
+	});
+});
@@ -0,0 +1,58 @@
+/**
+ * SLA Consistency Tests
+ * @sla 등급 전파 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkSLAConsistency } from "../../src/semantic/checks/sla-consistency";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package checkout
+
+type Service struct{}
+
+// @sla tier=gold availability=99.95
+func (s *Service) PlaceOrder() error {
+	s.reserve()
+	return s.Notify()
+}
+
+func (s *Service) reserve() {
+	s.Recommend()
+}
+
+// @sla tier=bronze
+func (s *Service) Recommend() {}
+
+// @sla tier=gold
+func (s *Service) Notify() error {
+	return nil
+}
+`;
+
+describe("checkSLAConsistency", () => {
+	it("should flag a gold method depending on a bronze method", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "checkout/service.go"),
+		]);
+
+		expect(graph.getNode("checkout.Service.PlaceOrder")?.metadata.sla).toEqual(
+			{ tier: "gold", options: { availability: "99.95" } },
+		);
+
+		const diagnostics = checkSLAConsistency(graph);
+
+		expect(diagnostics).toHaveLength(1);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "sla-consistency",
+			nodeId: "checkout.Service.PlaceOrder",
+			metadata: {
+				tier: "gold",
+				dependency: "checkout.Service.Recommend",
+				dependencyTier: "bronze",
+				via: ["checkout.Service.reserve"],
+			},
+		});
+	});
+});
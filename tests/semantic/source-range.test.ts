@@ -0,0 +1,65 @@
+/**
+ * Source Range Tests
+ * 심볼 선언/이름 토큰의 UTF-8 바이트 범위 테스트
+ */
+
+import { readFile } from "node:fs/promises";
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("source ranges", () => {
+	it("should cover the demo constructor and its name token", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+		const source = await readFile(DEMO_USER);
+		const start = source.indexOf("func NewUserService");
+		const end = source.indexOf("}\n", start) + 1;
+
+		const node = graph.getNode("user.NewUserService");
+		expect(node?.range).toEqual({
+			startByte: start,
+			endByte: end,
+			startLine: 35,
+			startColumn: 1,
+			endLine: 39,
+			endColumn: 2,
+		});
+		expect(node?.nameRange).toEqual({
+			startByte: start + 5,
+			endByte: start + 19,
+			startLine: 35,
+			startColumn: 6,
+			endLine: 35,
+			endColumn: 20,
+		});
+		expect(source.subarray(start + 5, start + 19).toString()).toBe(
+			"NewUserService",
+		);
+	});
+
+	it("should count multibyte characters as UTF-8 bytes", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const sourceCode = "package shop\n\n// 합계\nfunc /* 가 */ Total() {}\n";
+		const extraction = await analyzer.analyzeSource(
+			sourceCode,
+			"shop/cart.go",
+		);
+		const bytes = Buffer.from(sourceCode, "utf-8");
+
+		const node = extraction.nodes.find((n) => n.id === "shop.Total");
+		expect(node?.nameRange).toEqual({
+			startByte: bytes.indexOf("Total"),
+			endByte: bytes.indexOf("Total") + 5,
+			startLine: 4,
+			startColumn: 16,
+			endLine: 4,
+			endColumn: 21,
+		});
+		expect(node?.range?.startByte).toBe(bytes.indexOf("func"));
+	});
+});
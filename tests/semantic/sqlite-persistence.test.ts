@@ -0,0 +1,154 @@
+/**
+ * SQLite Persistence Tests
+ * SQLite 파일 저장/로드와 직접 SQL 조회 테스트
+ */
+
+import { mkdtemp, rm } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import path from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { Database } from "sqlite3";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import {
+	createSQLiteGraphStore,
+	loadGraphFromSQLite,
+	saveGraphToSQLite,
+} from "../../src/semantic/store/SQLiteGraphStore";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+const query = <T>(dbPath: string, sql: string, params: unknown[] = []) =>
+	new Promise<T[]>((resolve, reject) => {
+		const db = new Database(dbPath);
+		db.all(sql, params, (err: Error | null, rows: T[]) => {
+			db.close();
+			if (err) reject(err);
+			else resolve(rows);
+		});
+	});
+
+describe("SQLite persistence", () => {
+	let dir: string;
+	let dbPath: string;
+
+	beforeEach(async () => {
+		dir = await mkdtemp(path.join(tmpdir(), "semantic-sqlite-"));
+		dbPath = path.join(dir, "graph.db");
+	});
+
+	afterEach(async () => {
+		await rm(dir, { recursive: true, force: true });
+	});
+
+	it("should round-trip the demo analysis", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+
+		await saveGraphToSQLite(graph, dbPath);
+		const loaded = await loadGraphFromSQLite(dbPath);
+
+		expect(Array.from(loaded.nodes.keys())).toEqual(
+			Array.from(graph.nodes.keys()),
+		);
+		expect(Array.from(loaded.nodes.values())).toEqual(
+			Array.from(graph.nodes.values()),
+		);
+		expect(loaded.edges).toEqual(graph.edges);
+		expect(loaded.getNode("user.NewUserService")?.range).toEqual(
+			graph.getNode("user.NewUserService")?.range,
+		);
+		expect(loaded.getNode("user.NewUserService")?.nameRange).toMatchObject({
+			startLine: 35,
+			startColumn: 6,
+		});
+	});
+
+	it("should expose tags, file paths and metadata to SQL", async () => {
+		await saveGraphToSQLite(
+			createTestGraph([
+				createTestNode("user.GetUser", {
+					kind: "method",
+					filePath: "user/user.go",
+					semanticTags: ["read-method", "public-api"],
+					metadata: { receiverType: "UserService", line: 70 },
+				}),
+				createTestNode("user.ValidateUser", {
+					filePath: "user/validate.go",
+					semanticTags: ["public-api"],
+				}),
+			]),
+			dbPath,
+		);
+
+		expect(
+			await query(
+				dbPath,
+				`SELECT n.id FROM semantic_nodes n
+				JOIN semantic_node_tags t ON t.node_id = n.id
+				WHERE t.tag = ? AND n.file_path = ?`,
+				["public-api", "user/user.go"],
+			),
+		).toEqual([{ id: "user.GetUser" }]);
+		expect(
+			await query(
+				dbPath,
+				"SELECT node_id, value FROM semantic_node_metadata WHERE key = ?",
+				["receiverType"],
+			),
+		).toEqual([{ node_id: "user.GetUser", value: '"UserService"' }]);
+		expect(
+			(
+				await query<{ name: string }>(
+					dbPath,
+					"SELECT name FROM sqlite_master WHERE type = 'index' AND tbl_name = 'semantic_nodes'",
+				)
+			).map((row) => row.name),
+		).toContain("idx_semantic_nodes_file");
+	});
+
+	it("should keep the previous graph when a save fails", async () => {
+		await saveGraphToSQLite(
+			createTestGraph([createTestNode("user.GetUser")]),
+			dbPath,
+		);
+
+		const circular: Record<string, unknown> = {};
+		circular.self = circular;
+		await expect(
+			saveGraphToSQLite(
+				createTestGraph([
+					createTestNode("user.CreateUser"),
+					createTestNode("user.Broken", { metadata: { circular } }),
+				]),
+				dbPath,
+			),
+		).rejects.toThrow();
+
+		const loaded = await loadGraphFromSQLite(dbPath);
+		expect(Array.from(loaded.nodes.keys())).toEqual(["user.GetUser"]);
+	});
+
+	it("should rebuild tables written by an older schema", async () => {
+		await query(
+			dbPath,
+			"CREATE TABLE semantic_nodes (id TEXT PRIMARY KEY, metadata TEXT NOT NULL)",
+		);
+
+		const store = await createSQLiteGraphStore(dbPath);
+		await store.save(createTestGraph([createTestNode("user.GetUser")]));
+		await store.close();
+
+		expect(
+			Array.from((await loadGraphFromSQLite(dbPath)).nodes.keys()),
+		).toEqual(["user.GetUser"]);
+	});
+
+	it("should refuse to load a missing database", async () => {
+		await expect(
+			loadGraphFromSQLite(path.join(dir, "missing.db")),
+		).rejects.toThrow("Database not found");
+	});
+});
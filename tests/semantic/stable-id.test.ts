@@ -0,0 +1,105 @@
+/**
+ * Stable ID Tests
+ * 재분석에도 유지되는 내용 주소 기반 노드 ID 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import {
+	stableIdFields,
+	stableNodeId,
+	stableNodeIds,
+} from "../../src/semantic/stable-id";
+import { createTestNode } from "./semantic-test-helpers";
+
+const SOURCE = `package user
+
+type UserService struct{}
+
+func (s *UserService) GetUser(id int64) {}
+
+func NewUserService() *UserService { return nil }
+`;
+
+describe("stableNodeId", () => {
+	it("should hash the normalized identity fields", () => {
+		const node = createTestNode("user.UserService.GetUser", {
+			kind: "method",
+			filePath: "./user\\user.go",
+			metadata: { package: "user", receiverType: "UserService" },
+		});
+
+		expect(stableIdFields(node)).toEqual({
+			filePath: "user/user.go",
+			qualifiedName: "user.UserService.GetUser",
+			discriminator: "",
+		});
+		// 규칙이 바뀌면 STABLE_ID_VERSION과 함께 갱신해야 하는 고정값
+		expect(stableNodeId(node)).toBe("v2:a2fdadc82103bea4d3f4cc6543fe9cc4");
+	});
+
+	it("should survive re-analysis and line shifts but not moves", async () => {
+		const analyze = async (source: string, filePath: string) => {
+			const analyzer = new SemanticAnalyzer();
+			return stableNodeIds(
+				analyzer.buildGraph([await analyzer.analyzeSource(source, filePath)]),
+			);
+		};
+
+		const first = await analyze(SOURCE, "user/user.go");
+		const shifted = await analyze(
+			SOURCE.replace("type", "// 서비스\n\ntype"),
+			"user/user.go",
+		);
+		const moved = await analyze(SOURCE, "account/user.go");
+
+		expect(new Set(first.values()).size).toBe(first.size);
+		expect(shifted).toEqual(first);
+		expect(moved.get("account.UserService.GetUser")).toBeDefined();
+		expect(moved.get("account.UserService.GetUser")).not.toBe(
+			first.get("user.UserService.GetUser"),
+		);
+	});
+
+	it("should keep a symbol's ID when another file declares the same FQN", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const user = await analyzer.analyzeSource(SOURCE, "user/user.go");
+		const legacy = await analyzer.analyzeSource(
+			"package user\n\nfunc NewUserService() {}\n",
+			"user/legacy.go",
+		);
+
+		const before = stableNodeIds(analyzer.buildGraph([user]));
+		const after = stableNodeIds(analyzer.buildGraph([user, legacy]));
+
+		expect(after.has("user.NewUserService")).toBe(false);
+		expect(after.get("user.NewUserService@user/user.go")).toBe(
+			before.get("user.NewUserService"),
+		);
+		expect(after.get("user.NewUserService@user/legacy.go")).not.toBe(
+			before.get("user.NewUserService"),
+		);
+	});
+
+	it("should discriminate same-file duplicates only where needed", async () => {
+		const analyzer = new SemanticAnalyzer({ collisionPolicy: "suffix" });
+		const analyze = async (source: string) =>
+			analyzer.buildGraph([
+				await analyzer.analyzeSource(source, "app/app.go"),
+			]);
+
+		const single = stableNodeIds(
+			await analyze("package app\n\nfunc init() {}\n"),
+		);
+		const graph = await analyze(
+			"package app\n\nfunc init() {}\n\nfunc init() {}\n",
+		);
+		const ids = stableNodeIds(graph);
+
+		expect(graph.getNode("app.init#2")?.metadata.collidesWith).toBe(
+			"app.init",
+		);
+		expect(ids.get("app.init")).toBe(single.get("app.init"));
+		expect(ids.get("app.init#2")).not.toBe(ids.get("app.init"));
+	});
+});
@@ -0,0 +1,66 @@
+/**
+ * Stream Query Tests
+ * 스트리밍 쿼리 및 중단 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import type { SemanticNode } from "../../src/semantic/types";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("SemanticQueryEngine.streamQuery", () => {
+	const nodes = Array.from({ length: 5000 }, (_, i) =>
+		createTestNode(`user.Func${String(i).padStart(4, "0")}`, {
+			kind: i % 3 === 0 ? "method" : "function",
+		}),
+	);
+	const engine = new SemanticQueryEngine(createTestGraph(nodes));
+
+	it("should stream the same results as the full query", async () => {
+		const streamed: string[] = [];
+		for await (const node of engine.streamQuery({ kind: "method" })) {
+			streamed.push(node.id);
+		}
+
+		expect(streamed).toEqual(
+			engine.query({ kind: "method" }).items.map((n) => n.id),
+		);
+	});
+
+	it("should stop promptly when cancelled and deliver a prefix", async () => {
+		const controller = new AbortController();
+		const delivered: SemanticNode[] = [];
+
+		const stream = engine.streamQuery(
+			{ kind: "function" },
+			{ signal: controller.signal, batchSize: 100 },
+		);
+		for await (const node of stream) {
+			delivered.push(node);
+			if (delivered.length === 50) {
+				controller.abort();
+			}
+		}
+
+		const full = engine.query({ kind: "function" }).items;
+		expect(delivered).toHaveLength(50);
+		expect(delivered).toEqual(full.slice(0, delivered.length));
+	});
+
+	it("should yield to the event loop between batches", async () => {
+		let yielded = false;
+		setImmediate(() => {
+			yielded = true;
+		});
+
+		let beforeYield = 0;
+		let total = 0;
+		for await (const node of engine.streamQuery({}, { batchSize: 500 })) {
+			if (!yielded && node) beforeYield++;
+			total++;
+		}
+
+		expect(total).toBe(5000);
+		expect(beforeYield).toBe(500);
+	});
+});
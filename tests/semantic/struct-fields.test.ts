@@ -0,0 +1,74 @@
+/**
+ * Struct Field Tests
+ * struct 필드와 구조체 태그 추출 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { parseStructTag } from "../../src/semantic/extractors/GoExtractor";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+const SOURCE = `package user
+
+type Account struct {
+	*Base
+	audit.Trail
+	First, Last string
+	Secret      string \`json:"-" db:"secret" validate:"required"\`
+}
+`;
+
+describe("struct field extraction", () => {
+	it("should record struct tags for the demo User fields", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: path.dirname(DEMO_USER),
+		});
+		const graph = await analyzer.analyzeFiles([DEMO_USER]);
+
+		expect(graph.getNode("user.User.Email")).toMatchObject({
+			kind: "field",
+			line: 19,
+			metadata: {
+				fieldType: "string",
+				structTags: { json: "email", db: "email" },
+			},
+		});
+		expect(graph.hasEdge("user.User", "user.User.CreatedAt", "contains")).toBe(
+			true,
+		);
+	});
+
+	it("should handle embedded, multi-name and untagged fields", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/account.go"),
+		]);
+
+		expect(graph.getNode("user.Account.Base")?.metadata).toMatchObject({
+			embedded: true,
+			structTags: {},
+		});
+		expect(graph.getNode("user.Account.Trail")?.metadata.embedded).toBe(true);
+		expect(graph.getNode("user.Account.First")?.metadata.structTags).toEqual(
+			{},
+		);
+		expect(graph.getNode("user.Account.Last")?.metadata.fieldType).toBe(
+			"string",
+		);
+		expect(graph.getNode("user.Account.Secret")?.metadata.structTags).toEqual({
+			json: "-",
+			db: "secret",
+			validate: "required",
+		});
+	});
+});
+
+describe("parseStructTag", () => {
+	it("should keep the first value of a repeated key", () => {
+		expect(
+			parseStructTag('json:"a,omitempty" json:"b" xml:"c\\"d"'),
+		).toEqual({ json: "a,omitempty", xml: 'c"d' });
+	});
+});
@@ -0,0 +1,74 @@
+/**
+ * Tag Combination Tests
+ * 필수 동반 태그 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkTagCombinations } from "../../src/semantic/checks/tag-combinations";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("checkTagCombinations", () => {
+	it("should flag a pii symbol lacking security-reviewed", () => {
+		const graph = createTestGraph([
+			createTestNode("user.Email", { semanticTags: ["pii"] }),
+			createTestNode("user.Phone", {
+				semanticTags: ["pii", "security-reviewed"],
+			}),
+			createTestNode("user.Name", { semanticTags: ["security-reviewed"] }),
+		]);
+
+		const diagnostics = checkTagCombinations(graph);
+
+		expect(diagnostics).toHaveLength(1);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "tag-combination",
+			nodeId: "user.Email",
+			metadata: { tag: "pii", missing: ["security-reviewed"] },
+		});
+	});
+
+	it("should accept companions inherited from the receiver type", () => {
+		const graph = createTestGraph([
+			createTestNode("user.Profile", {
+				kind: "struct",
+				semanticTags: ["security-reviewed"],
+			}),
+			createTestNode("user.Profile.Address", {
+				kind: "method",
+				semanticTags: ["pii"],
+				metadata: { package: "user", receiverType: "Profile" },
+			}),
+		]);
+
+		expect(checkTagCombinations(graph)).toEqual([]);
+	});
+
+	it("should report an inherited tag only on the declaring symbol", () => {
+		const graph = createTestGraph(
+			[
+				createTestNode("user", { kind: "package", semanticTags: ["pii"] }),
+				createTestNode("user.Email"),
+				createTestNode("user.Phone"),
+			],
+			[
+				["user", "user.Email", "contains"],
+				["user", "user.Phone", "contains"],
+			],
+		);
+
+		expect(checkTagCombinations(graph).map((d) => d.nodeId)).toEqual(["user"]);
+	});
+
+	it("should use configured rules", () => {
+		const graph = createTestGraph([
+			createTestNode("pay.Charge", { semanticTags: ["payment"] }),
+		]);
+
+		expect(checkTagCombinations(graph)).toEqual([]);
+		expect(
+			checkTagCombinations(graph, {
+				requires: { payment: ["audited", "idempotent"] },
+			})[0].metadata?.missing,
+		).toEqual(["audited", "idempotent"]);
+	});
+});
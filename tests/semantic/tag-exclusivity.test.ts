@@ -0,0 +1,88 @@
+/**
+ * Tag Exclusivity Tests
+ * 배타적 태그 그룹 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkTagExclusivity } from "../../src/semantic/checks/tag-exclusivity";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("checkTagExclusivity", () => {
+	it("should flag a symbol carrying both tags directly", () => {
+		const graph = createTestGraph([
+			createTestNode("user.Export", {
+				semanticTags: ["public-api", "internal"],
+			}),
+			createTestNode("user.Helper", { semanticTags: ["internal"] }),
+		]);
+
+		const diagnostics = checkTagExclusivity(graph);
+
+		expect(diagnostics).toHaveLength(1);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "tag-exclusivity",
+			nodeId: "user.Export",
+		});
+	});
+
+	it("should include tags inherited from the receiver type", () => {
+		const graph = createTestGraph([
+			createTestNode("user.UserService", {
+				kind: "struct",
+				semanticTags: ["internal"],
+			}),
+			createTestNode("user.UserService.CreateUser", {
+				kind: "method",
+				semanticTags: ["public-api"],
+				metadata: { package: "user", receiverType: "UserService" },
+			}),
+		]);
+
+		const diagnostics = checkTagExclusivity(graph);
+
+		expect(diagnostics.map((d) => d.nodeId)).toEqual([
+			"user.UserService.CreateUser",
+		]);
+		expect(diagnostics[0].message).toContain("internal (from user.UserService)");
+	});
+
+	it("should report an inherited conflict only on the declaring symbol", () => {
+		const graph = createTestGraph([
+			createTestNode("user.UserService", {
+				kind: "struct",
+				semanticTags: ["public-api", "internal"],
+			}),
+			createTestNode("user.UserService.CreateUser", {
+				kind: "method",
+				metadata: { package: "user", receiverType: "UserService" },
+			}),
+			createTestNode("user.UserService.DeleteUser", {
+				kind: "method",
+				semanticTags: ["internal"],
+				metadata: { package: "user", receiverType: "UserService" },
+			}),
+		]);
+
+		expect(checkTagExclusivity(graph).map((d) => d.nodeId)).toEqual([
+			"user.UserService",
+			"user.UserService.DeleteUser",
+		]);
+	});
+
+	it("should use configured groups", () => {
+		const graph = createTestGraph(
+			[
+				createTestNode("app.Module", { kind: "package", semanticTags: ["sync"] }),
+				createTestNode("app.Run", { semanticTags: ["async"] }),
+			],
+			[["app.Module", "app.Run", "contains"]],
+		);
+
+		expect(checkTagExclusivity(graph)).toEqual([]);
+		expect(
+			checkTagExclusivity(graph, { groups: [["sync", "async"]] }).map(
+				(d) => d.nodeId,
+			),
+		).toEqual(["app.Run"]);
+	});
+});
@@ -0,0 +1,116 @@
+/**
+ * Tag Taxonomy Tests
+ * 택소노미 파일 로드와 허용 태그/필수 분류 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkTagTaxonomy } from "../../src/semantic/checks/tag-taxonomy";
+import { parseTagTaxonomy } from "../../src/semantic/tag-taxonomy";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const YAML = `# 태그 어휘
+tags:
+  - deprecated
+categories:
+  visibility:
+    required: true
+    tags: [public-api, internal]
+  domain:
+  - user-domain
+  - "order-domain"
+`;
+
+describe("parseTagTaxonomy", () => {
+	it("should read the same taxonomy from YAML and JSON", () => {
+		const fromYaml = parseTagTaxonomy(YAML, "yaml");
+
+		expect(fromYaml).toEqual({
+			tags: ["deprecated"],
+			categories: [
+				{
+					name: "visibility",
+					tags: ["public-api", "internal"],
+					required: true,
+				},
+				{
+					name: "domain",
+					tags: ["user-domain", "order-domain"],
+					required: false,
+				},
+			],
+		});
+		expect(
+			parseTagTaxonomy(
+				JSON.stringify({
+					tags: ["deprecated"],
+					categories: {
+						visibility: { tags: ["public-api", "internal"], required: true },
+						domain: ["user-domain", "order-domain"],
+					},
+				}),
+				"json",
+			),
+		).toEqual(fromYaml);
+		expect(() => parseTagTaxonomy("tags: deprecated\n", "yaml")).toThrow(
+			"Invalid tag taxonomy: tags must be a list of strings",
+		);
+	});
+});
+
+describe("checkTagTaxonomy", () => {
+	it("should report unknown tags and required category counts", () => {
+		const graph = createTestGraph(
+			[
+				createTestNode("user.UserService", {
+					kind: "type",
+					semanticTags: ["public-api", "user-domain"],
+				}),
+				createTestNode("user.UserService.Get", {
+					kind: "method",
+					semanticTags: ["user_domain"],
+				}),
+				createTestNode("user.Load", {
+					semanticTags: ["public-apis", "test"],
+				}),
+				createTestNode("user.helper", {
+					semanticTags: ["public-api", "internal"],
+				}),
+				createTestNode("user.untagged"),
+			],
+			[["user.UserService", "user.UserService.Get", "contains"]],
+		);
+
+		const diagnostics = checkTagTaxonomy(
+			graph,
+			parseTagTaxonomy(YAML, "yaml"),
+		);
+
+		expect(
+			diagnostics.map((diagnostic) => [
+				diagnostic.ruleId,
+				diagnostic.nodeId,
+				diagnostic.metadata,
+			]),
+		).toEqual([
+			[
+				"unknown-tag",
+				"user.UserService.Get",
+				{ tag: "user_domain", suggestion: "user-domain" },
+			],
+			[
+				"unknown-tag",
+				"user.Load",
+				{ tag: "public-apis", suggestion: "public-api" },
+			],
+			["tag-category", "user.Load", { category: "visibility", tags: [] }],
+			[
+				"tag-category",
+				"user.helper",
+				{ category: "visibility", tags: ["public-api", "internal"] },
+			],
+		]);
+		expect(diagnostics[0].message).toBe(
+			"user.UserService.Get uses tag user_domain which is not in the taxonomy (did you mean user-domain?)",
+		);
+	});
+});
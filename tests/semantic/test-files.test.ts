@@ -0,0 +1,100 @@
+/**
+ * Test File Tests
+ * 테스트 파일 제외/포함 옵션과 테스트 출처 노드 태그 테스트
+ */
+
+import { mkdir, mkdtemp, rm, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+import { SemanticQueryEngine } from "../../src/semantic/SemanticQueryEngine";
+import { excludeTests, isTestFile } from "../../src/semantic/test-files";
+import { findUnreferenced } from "../../src/semantic/unreferenced";
+
+const PRODUCTION = `package user
+
+// @semantic-tags: public-api
+func Load() string { return "" }
+
+// @semantic-tags: public-api
+func Unused() string { return "" }
+`;
+
+const TEST = `package user
+
+// @semantic-tags: public-api
+func NewFixture() string { return Load() }
+`;
+
+describe("isTestFile", () => {
+	it("should recognize each language's test file convention", () => {
+		expect(isTestFile("user/user_test.go")).toBe(true);
+		expect(isTestFile("web/src/api.test.ts")).toBe(true);
+		expect(isTestFile("web/src/api.spec.jsx")).toBe(true);
+		expect(isTestFile("web/src/__tests__/util.ts")).toBe(true);
+		expect(isTestFile("app/tests/test_db.py")).toBe(true);
+		expect(isTestFile("app/db_test.py")).toBe(true);
+
+		expect(isTestFile("user/user.go")).toBe(false);
+		expect(isTestFile("user/testing.go")).toBe(false);
+		expect(isTestFile("web/src/latest.ts")).toBe(false);
+		expect(isTestFile("app/contest.py")).toBe(false);
+	});
+});
+
+describe("includeTests", () => {
+	let root: string;
+	let files: string[];
+
+	beforeEach(async () => {
+		root = await mkdtemp(join(tmpdir(), "semantic-tests-"));
+		await mkdir(join(root, "user"));
+		files = [join(root, "user/user.go"), join(root, "user/user_test.go")];
+		await writeFile(files[0], PRODUCTION);
+		await writeFile(files[1], TEST);
+	});
+
+	afterEach(async () => {
+		await rm(root, { recursive: true, force: true });
+	});
+
+	it("should skip test files by default", async () => {
+		const analyzer = new SemanticAnalyzer({ projectRoot: root });
+		const graph = await analyzer.analyzeFiles(files);
+
+		expect(analyzer.supportsFile("user/user_test.go")).toBe(false);
+		expect(graph.hasNode("user.NewFixture")).toBe(false);
+		expect(findUnreferenced(graph).map((node) => node.id)).toEqual([
+			"user.Load",
+			"user.Unused",
+		]);
+	});
+
+	it("should tag test symbols when test files are included", async () => {
+		const analyzer = new SemanticAnalyzer({
+			projectRoot: root,
+			includeTests: true,
+		});
+		const graph = await analyzer.analyzeFiles(files);
+
+		expect(graph.getNode("user.NewFixture")?.semanticTags).toEqual([
+			"public-api",
+			"test",
+		]);
+		expect(graph.getNode("user")?.semanticTags).not.toContain("test");
+		expect(
+			new SemanticQueryEngine(graph).findByTag("test").map((ref) => ref.id),
+		).toEqual(["user.NewFixture"]);
+		expect(graph.hasEdge("user.NewFixture", "user.Load", "calls")).toBe(true);
+		// 테스트에서만 쓰여도 사용 중, 테스트 심볼 자신은 후보가 아님
+		expect(findUnreferenced(graph).map((node) => node.id)).toEqual([
+			"user.Unused",
+		]);
+
+		const production = excludeTests(graph);
+		expect(production.hasNode("user.NewFixture")).toBe(false);
+		expect(production.hasNode("user.Load")).toBe(true);
+		expect(production.getIncomingEdges("user.Load", ["calls"])).toEqual([]);
+	});
+});
@@ -0,0 +1,80 @@
+/**
+ * Symbol Timeline Tests
+ * 스냅샷 간 심볼 이력 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { buildSymbolTimeline } from "../../src/semantic/timeline";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("buildSymbolTimeline", () => {
+	const v1 = createTestGraph([
+		createTestNode("user.UserService.CreateUser", { semanticTags: ["write"] }),
+		createTestNode("handler.Register"),
+	]);
+	const v2 = createTestGraph([
+		createTestNode("user.UserService.CreateUser", {
+			semanticTags: ["write", "public-api"],
+		}),
+		createTestNode("handler.Register"),
+	]);
+	const v3 = createTestGraph(
+		[
+			createTestNode("user.UserService.CreateUser", {
+				semanticTags: ["write", "public-api"],
+			}),
+			createTestNode("handler.Register"),
+			createTestNode("user.UserService", { kind: "struct" }),
+		],
+		[
+			["handler.Register", "user.UserService.CreateUser", "calls"],
+			["user.UserService", "user.UserService.CreateUser", "contains"],
+		],
+	);
+
+	it("should record a tag gained in the second and a dependent in the third snapshot", () => {
+		const timeline = buildSymbolTimeline(
+			[
+				{ label: "v1.0.0", graph: v1 },
+				{ label: "v1.1.0", graph: v2 },
+				{ label: "v1.2.0", graph: v3 },
+			],
+			"user.UserService.CreateUser",
+		);
+
+		expect(timeline.map((point) => point.event)).toEqual([
+			"introduced",
+			"changed",
+			"changed",
+		]);
+		expect(timeline[1]).toMatchObject({
+			tags: ["public-api", "write"],
+			addedTags: ["public-api"],
+			addedDependents: [],
+		});
+		expect(timeline[2]).toMatchObject({
+			addedTags: [],
+			dependents: ["handler.Register"],
+			addedDependents: ["handler.Register"],
+		});
+	});
+
+	it("should mark absence and removal", () => {
+		const empty = createTestGraph([]);
+		const timeline = buildSymbolTimeline(
+			[
+				{ label: "v0", graph: empty },
+				{ label: "v1", graph: v1 },
+				{ label: "v2", graph: empty },
+			],
+			"user.UserService.CreateUser",
+		);
+
+		expect(timeline.map((point) => point.event)).toEqual([
+			"absent",
+			"introduced",
+			"removed",
+		]);
+		expect(timeline[2].removedTags).toEqual(["write"]);
+	});
+});
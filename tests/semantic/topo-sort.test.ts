@@ -0,0 +1,99 @@
+/**
+ * Topological Ordering Tests
+ * 순환을 묶은 위상 정렬 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { topoSort } from "../../src/semantic/topo-sort";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+describe("topoSort", () => {
+	it("should order dependencies first and collapse cycles", () => {
+		const graph = createTestGraph(
+			[
+				createTestNode("app.Main"),
+				createTestNode("user.Get"),
+				createTestNode("user.Load"),
+				createTestNode("db.Query"),
+				createTestNode("log.Print"),
+			],
+			[
+				["app.Main", "user.Get"],
+				["user.Get", "user.Load"],
+				["user.Load", "user.Get"],
+				["user.Load", "db.Query"],
+				["log.Print", "log.Print"],
+			],
+		);
+
+		expect(topoSort(graph)).toEqual({
+			order: [
+				["db.Query"],
+				["log.Print"],
+				["user.Get", "user.Load"],
+				["app.Main"],
+			],
+			cycles: [["log.Print"], ["user.Get", "user.Load"]],
+		});
+	});
+
+	it("should not depend on insertion order", () => {
+		const nodes = ["a", "b", "c", "d"].map((id) => createTestNode(id));
+		const edges: Array<[string, string]> = [
+			["a", "c"],
+			["b", "c"],
+			["d", "a"],
+			["d", "b"],
+		];
+
+		const forward = topoSort(createTestGraph(nodes, edges));
+		const reversed = topoSort(
+			createTestGraph([...nodes].reverse(), [...edges].reverse()),
+		);
+
+		expect(forward.order).toEqual([["c"], ["a"], ["b"], ["d"]]);
+		expect(reversed).toEqual(forward);
+	});
+
+	it("should ignore structural edges and honor edgeTypes", () => {
+		const graph = createTestGraph(
+			[createTestNode("user"), createTestNode("user.Get")],
+			[
+				["user", "user.Get", "contains"],
+				["user.Get", "user", "references"],
+			],
+		);
+
+		expect(topoSort(graph).order).toEqual([["user"], ["user.Get"]]);
+		expect(topoSort(graph, { edgeTypes: ["calls"] }).order).toEqual([
+			["user"],
+			["user.Get"],
+		]);
+	});
+
+	it("should sort packages at package granularity", () => {
+		const graph = createTestGraph(
+			[
+				createTestNode("api", { kind: "package" }),
+				createTestNode("api.Handle"),
+				createTestNode("user", { kind: "package" }),
+				createTestNode("user.Get"),
+				createTestNode("store", { kind: "package" }),
+				createTestNode("store.Load"),
+			],
+			[
+				["api", "api.Handle", "contains"],
+				["user", "user.Get", "contains"],
+				["store", "store.Load", "contains"],
+				["api.Handle", "user.Get"],
+				["user.Get", "store.Load"],
+				["store.Load", "user.Get"],
+			],
+		);
+
+		expect(topoSort(graph, { granularity: "package" })).toEqual({
+			order: [["store", "user"], ["api"]],
+			cycles: [["store", "user"]],
+		});
+	});
+});
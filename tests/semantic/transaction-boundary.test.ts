@@ -0,0 +1,56 @@
+/**
+ * Transaction Boundary Tests
+ * @transactional 호출 경계 검사 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { checkTransactionBoundaries } from "../../src/semantic/checks/transaction-boundary";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SOURCE = `package user
+
+type UserService struct{}
+
+// UpdateUser writes the user and its audit row
+// @transactional
+func (s *UserService) UpdateUser(id int) error {
+	return nil
+}
+
+// RenameUser updates the user inside its own transaction
+// @transactional
+func (s *UserService) RenameUser(id int) error {
+	return s.UpdateUser(id)
+}
+
+// HandleUpdate is an HTTP handler
+func (s *UserService) HandleUpdate(id int) error {
+	return s.UpdateUser(id)
+}
+
+// Run opens the transaction explicitly
+// @transaction-boundary
+func (s *UserService) Run(id int) error {
+	return s.UpdateUser(id)
+}
+`;
+
+describe("checkTransactionBoundaries", () => {
+	it("should flag a transactional method called without a transaction", async () => {
+		const analyzer = new SemanticAnalyzer();
+		const graph = analyzer.buildGraph([
+			await analyzer.analyzeSource(SOURCE, "user/user.go"),
+		]);
+
+		const diagnostics = checkTransactionBoundaries(graph);
+
+		expect(diagnostics).toEqual([
+			expect.objectContaining({
+				ruleId: "transaction-boundary",
+				nodeId: "user.UserService.HandleUpdate",
+				line: 19,
+				metadata: { callee: "user.UserService.UpdateUser" },
+			}),
+		]);
+	});
+});
@@ -0,0 +1,150 @@
+/**
+ * TypeScript Extractor Tests
+ * ES 모듈/CommonJS 의존성과 TypeScript 심볼 추출 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const SERVICE = `import { api, type Options } from "./http";
+import type { User } from "../models/user";
+import * as log from "loglevel";
+import React from "react";
+import "./polyfills";
+export { formatUser } from "./format";
+export type { Role } from "../models/role";
+
+const config = require("../config");
+
+// @semantic-tags: user-service, public-api
+/** @description: 사용자 API 클라이언트 */
+export class UserClient {
+	// @semantic-tags: read-method
+	async get(id: string): Promise<User> {
+		return api.get(\`/users/\${id}\`);
+	}
+
+	static create(options: Options) {
+		return new UserClient();
+	}
+}
+
+export interface UserQuery {
+	name?: string;
+}
+
+export const loadAdmin = async () => import("./admin");
+
+function helper() {
+	return config.base;
+}
+
+export { helper as internalHelper };
+`;
+
+describe("TypeScriptExtractor", () => {
+	const analyze = async (source: string, filePath: string) => {
+		const analyzer = new SemanticAnalyzer();
+		return analyzer.buildGraph([
+			await analyzer.analyzeSource(source, filePath),
+		]);
+	};
+
+	it("should link ES module, CommonJS and dynamic imports", async () => {
+		const graph = await analyze(SERVICE, "web/src/api/user.ts");
+		const imports = graph
+			.getOutgoingEdges("web/src/api/user.ts")
+			.filter((edge) => edge.type !== "contains")
+			.map((edge) => [edge.type, edge.to, edge.metadata?.forms]);
+
+		expect(imports).toEqual([
+			["imports", "web/src/api/http", ["import"]],
+			["imports_type", "web/src/models/user", ["import"]],
+			["imports", "loglevel", ["import"]],
+			["imports", "react", ["import"]],
+			["imports", "web/src/api/polyfills", ["import"]],
+			["imports", "web/src/api/format", ["export"]],
+			["imports_type", "web/src/models/role", ["export"]],
+			["imports", "web/src/config", ["require"]],
+			["imports", "web/src/api/admin", ["dynamic"]],
+		]);
+		expect(
+			graph.getOutgoingEdges("web/src/api/user.ts", ["imports"])[0].metadata,
+		).toEqual({
+			line: 1,
+			specifier: "./http",
+			forms: ["import"],
+			names: ["api", "Options"],
+		});
+		expect(graph.getNode("react")).toMatchObject({
+			kind: "external",
+			metadata: { importPath: "react" },
+		});
+	});
+
+	it("should extract tagged symbols and class methods", async () => {
+		const graph = await analyze(SERVICE, "web/src/api/user.ts");
+
+		expect(graph.getNode("web/src/api/user.ts#UserClient")).toMatchObject({
+			kind: "class",
+			language: "typescript",
+			line: 13,
+			semanticTags: ["user-service", "public-api"],
+			description: "사용자 API 클라이언트",
+			metadata: { exported: true },
+		});
+		expect(graph.getNode("web/src/api/user.ts#UserClient.get")).toMatchObject({
+			kind: "method",
+			semanticTags: ["read-method"],
+			metadata: { className: "UserClient", static: false },
+		});
+		expect(
+			graph.getNode("web/src/api/user.ts#UserClient.create")?.metadata.static,
+		).toBe(true);
+		expect(
+			graph
+				.getOutgoingEdges("web/src/api/user.ts", ["contains"])
+				.map((edge) => [
+					edge.to.split("#")[1],
+					graph.getNode(edge.to)?.kind,
+					graph.getNode(edge.to)?.metadata.exported,
+				]),
+		).toEqual([
+			["formatUser", "export", true],
+			["Role", "export", true],
+			["config", "variable", false],
+			["UserClient", "class", true],
+			["UserQuery", "interface", true],
+			["loadAdmin", "function", true],
+			["helper", "function", true],
+		]);
+		expect(
+			graph.getNode("web/src/api/user.ts#helper")?.metadata.exportedAs,
+		).toBe("internalHelper");
+	});
+
+	it("should treat inline type specifiers as a type-only import", async () => {
+		const graph = await analyze(
+			[
+				'import { type A, type B } from "./types";',
+				'import { type C, d } from "./mixed";',
+				'const lib = require("lib");',
+				"module.exports = { lib };",
+				"",
+			].join("\n"),
+			"src/index.js",
+		);
+
+		expect(
+			graph
+				.getOutgoingEdges("src/index.js")
+				.filter((edge) => edge.type !== "contains")
+				.map((edge) => [edge.type, edge.to]),
+		).toEqual([
+			["imports_type", "src/types"],
+			["imports", "src/mixed"],
+			["imports", "lib"],
+		]);
+		expect(graph.getNode("src/index.js")?.language).toBe("javascript");
+	});
+});
@@ -0,0 +1,97 @@
+/**
+ * Unique Tag Check Tests
+ * 고유 태그 중복 사용 검사 테스트
+ */
+
+import { readFile } from "node:fs/promises";
+import path from "node:path";
+import { describe, expect, it } from "@jest/globals";
+import { runChecks } from "../../src/semantic/checks/run-checks";
+import { checkUniqueTags } from "../../src/semantic/checks/unique-tags";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+
+describe("checkUniqueTags", () => {
+	const analyze = async (source: string) => {
+		const analyzer = new SemanticAnalyzer();
+		return analyzer.buildGraph([
+			await analyzer.analyzeSource(source, "user/user.go"),
+		]);
+	};
+
+	it("should accept the demo package tag", async () => {
+		const graph = await analyze(await readFile(DEMO_USER, "utf-8"));
+
+		expect(checkUniqueTags(graph)).toEqual([]);
+	});
+
+	it("should list every location of a copy-pasted package tag", async () => {
+		const graph = await analyze(
+			(await readFile(DEMO_USER, "utf-8")).replace(
+				"// @semantic-tags: read-method, public-api",
+				"// @semantic-tags: read-method, user-package, public-api",
+			),
+		);
+
+		const diagnostics = checkUniqueTags(graph);
+
+		expect(diagnostics.map((d) => [d.nodeId, d.line])).toEqual([
+			["user", 5],
+			["user.UserService.GetUser", 70],
+		]);
+		expect(diagnostics[1]).toMatchObject({
+			ruleId: "unique-tag",
+			severity: "error",
+			message:
+				"user-package must be unique but is on 2 symbols: user (user/user.go:5), user.UserService.GetUser (user/user.go:70)",
+			metadata: {
+				tag: "user-package",
+				nodeIds: ["user", "user.UserService.GetUser"],
+			},
+		});
+	});
+
+	it("should use the configured tags", async () => {
+		const graph = await analyze(
+			[
+				"package user",
+				"",
+				"// @semantic-tags: entrypoint",
+				"func Main() {}",
+				"",
+				"// @semantic-tags: entrypoint",
+				"func Run() {}",
+				"",
+			].join("\n"),
+		);
+
+		expect(checkUniqueTags(graph)).toEqual([]);
+		expect(
+			checkUniqueTags(graph, { tags: ["entrypoint"] }).map((d) => d.nodeId),
+		).toEqual(["user.Main", "user.Run"]);
+	});
+
+	it("should report through runChecks alongside other diagnostics", async () => {
+		const graph = await analyze(
+			[
+				"// @semantic-tags: user-package",
+				"package user",
+				"",
+				"// @semantic-tags: user-package",
+				"// @suppress unique-tag",
+				"func Get() {}",
+				"",
+			].join("\n"),
+		);
+
+		expect(
+			runChecks(graph)
+				.filter((d) => d.ruleId === "unique-tag")
+				.map((d) => [d.nodeId, d.suppressed ?? false]),
+		).toEqual([
+			["user", false],
+			["user.Get", true],
+		]);
+	});
+});
@@ -0,0 +1,50 @@
+/**
+ * Unreferenced Symbol Tests
+ * 진입점 기준 미참조 공개 심볼 탐지 테스트
+ */
+
+import { describe, expect, it } from "@jest/globals";
+import { findUnreferenced } from "../../src/semantic/unreferenced";
+import { createTestGraph, createTestNode } from "./semantic-test-helpers";
+
+const publicApi = (id: string, filePath: string) =>
+	createTestNode(id, { filePath, semanticTags: ["public-api"] });
+
+const graph = createTestGraph(
+	[
+		createTestNode("main.main", { filePath: "cmd/main.go" }),
+		publicApi("user.NewService", "user/service.go"),
+		publicApi("user.Service.Get", "user/service.go"),
+		publicApi("user.Validate", "user/validate.go"),
+		publicApi("user.Export", "user/export.go"),
+		publicApi("user.Legacy", "user/legacy.go"),
+		publicApi("report.Build", "report/report.go"),
+	],
+	[
+		["main.main", "user.NewService", "calls"],
+		["user.NewService", "user.Service.Get", "calls"],
+		["report.Build", "user.Export", "calls"],
+		["user.Legacy", "user.Legacy", "calls"],
+	],
+);
+
+describe("findUnreferenced", () => {
+	it("should treat symbols reachable from root files as used", () => {
+		const unused = findUnreferenced(graph, { rootFiles: ["cmd/main.go"] });
+
+		expect(unused.map((node) => node.id)).toEqual([
+			"report.Build",
+			"user.Legacy",
+			"user.Validate",
+		]);
+	});
+
+	it("should only count references from other files without roots", () => {
+		expect(findUnreferenced(graph).map((node) => node.id)).toEqual([
+			"report.Build",
+			"user.Legacy",
+			"user.Service.Get",
+			"user.Validate",
+		]);
+	});
+});
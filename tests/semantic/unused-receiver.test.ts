@@ -0,0 +1,84 @@
+/**
+ * Unused Receiver Tests
+ * 리시버를 쓰지 않는 메서드 탐지 테스트
+ */
+
+import path from "node:path";
+import { describe, expect, it, jest } from "@jest/globals";
+import { executeSemanticCheckAction } from "../../src/cli/actions/semantic-check-action";
+import { checkUnusedReceivers } from "../../src/semantic/checks/unused-receiver";
+import { SemanticAnalyzer } from "../../src/semantic/SemanticAnalyzer";
+
+const DEMO_USER = path.join(__dirname, "../../demo/examples/go/user.go");
+const FIXTURE = path.join(
+	__dirname,
+	"../fixtures/semantic/unused-receiver/formatter.go",
+);
+
+const analyze = (filePath: string) =>
+	new SemanticAnalyzer({ projectRoot: path.dirname(filePath) }).analyzeFiles([
+		filePath,
+	]);
+
+describe("checkUnusedReceivers", () => {
+	it("should not flag demo methods that all use s.db", async () => {
+		expect(checkUnusedReceivers(await analyze(DEMO_USER))).toEqual([]);
+	});
+
+	it("should flag methods that never reference their receiver", async () => {
+		const diagnostics = checkUnusedReceivers(await analyze(FIXTURE));
+
+		expect(
+			diagnostics.map((diagnostic) => [
+				diagnostic.nodeId,
+				`${diagnostic.filePath}:${diagnostic.line}`,
+				diagnostic.metadata?.receiver,
+			]),
+		).toEqual([
+			["report.Formatter.Title", "formatter.go:24", "f"],
+			["report.Formatter.Label", "formatter.go:52", "f"],
+		]);
+		expect(diagnostics[0]).toMatchObject({
+			ruleId: "unused-receiver",
+			severity: "info",
+			message:
+				"report.Formatter.Title never uses its receiver f; consider making it a package function",
+		});
+	});
+
+	it("should record receiver usage on method nodes", async () => {
+		const graph = await analyze(FIXTURE);
+		const uses = (name: string) =>
+			graph.getNode(`report.Formatter.${name}`)?.metadata.usesReceiver;
+
+		expect(uses("Join")).toBe(true);
+		expect(uses("Header")).toBe(true);
+		expect(uses("Name")).toBe(false);
+		expect(uses("Version")).toBeUndefined();
+		expect(uses("Debug")).toBeUndefined();
+	});
+});
+
+describe("check --unused-receivers", () => {
+	const reported = async (unusedReceivers?: boolean) => {
+		const log = jest.spyOn(console, "log").mockImplementation(() => {});
+		await executeSemanticCheckAction({
+			directory: path.dirname(FIXTURE),
+			format: "json",
+			unusedReceivers,
+		});
+		const { diagnostics } = JSON.parse(log.mock.calls[0][0] as string);
+		log.mockRestore();
+		return diagnostics
+			.filter((d: { ruleId: string }) => d.ruleId === "unused-receiver")
+			.map((d: { nodeId: string }) => d.nodeId);
+	};
+
+	it("should surface hits only when the flag is set", async () => {
+		expect(await reported()).toEqual([]);
+		expect(await reported(true)).toEqual([
+			"report.Formatter.Title",
+			"report.Formatter.Label",
+		]);
+	});
+});
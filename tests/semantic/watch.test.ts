@@ -0,0 +1,97 @@
+/**
+ * Watch Mode Tests
+ * 파일 변경 감시와 디바운스, 삭제 이벤트 테스트
+ */
+
+import { mkdtemp, rm, unlink, writeFile } from "node:fs/promises";
+import { tmpdir } from "node:os";
+import { join } from "node:path";
+import { afterEach, beforeEach, describe, expect, it } from "@jest/globals";
+import { ExtractionCache } from "../../src/semantic/extraction-cache";
+import type { FileExtraction } from "../../src/semantic/extractors/LanguageExtractor";
+import {
+	SemanticAnalyzer,
+	type WatchEvent,
+} from "../../src/semantic/SemanticAnalyzer";
+
+const source = (name: string) => `package shop\n\nfunc ${name}() {}\n`;
+
+describe("SemanticAnalyzer.watch", () => {
+	let root: string;
+
+	beforeEach(async () => {
+		root = await mkdtemp(join(tmpdir(), "semantic-watch-"));
+		await writeFile(join(root, "cart.go"), source("Total"));
+		await writeFile(join(root, "README.md"), "# shop\n");
+	});
+
+	afterEach(async () => {
+		await rm(root, { recursive: true, force: true });
+	});
+
+	it("should re-emit changed files and report removals", async () => {
+		const cache = new ExtractionCache();
+		const analyzer = new SemanticAnalyzer({ projectRoot: root, cache });
+		const controller = new AbortController();
+		const events: WatchEvent[] = [];
+		let notify = () => {};
+		const next = (count: number) =>
+			new Promise<void>((resolve) => {
+				notify = () => {
+					if (events.length >= count) resolve();
+				};
+				notify();
+			});
+
+		const watching = analyzer.watch(
+			root,
+			(event) => {
+				events.push(event);
+				notify();
+			},
+			{ debounceMs: 100, signal: controller.signal },
+		);
+		await next(2);
+
+		// 연달아 저장해도 마지막 내용으로 한 번만 분석
+		await writeFile(join(root, "cart.go"), source("Sum"));
+		await writeFile(join(root, "cart.go"), source("Subtotal"));
+		await writeFile(join(root, "tax.go"), source("Tax"));
+		await next(4);
+		// 내용이 같으면 다시 파싱하지 않음
+		await writeFile(join(root, "cart.go"), source("Subtotal"));
+		await unlink(join(root, "tax.go"));
+		await next(5);
+		controller.abort();
+		await watching;
+
+		const functions = (extraction: FileExtraction) =>
+			extraction.nodes
+				.filter((node) => node.kind === "function")
+				.map((node) => node.name);
+		expect(
+			events.map((event) =>
+				event.type === "analyzed"
+					? [event.type, event.filePath, functions(event.extraction)]
+					: event.type === "ready"
+						? [event.type, event.files]
+						: [event.type, event.filePath],
+			),
+		).toEqual([
+			["analyzed", "cart.go", ["Total"]],
+			["ready", 1],
+			["analyzed", "cart.go", ["Subtotal"]],
+			["analyzed", "tax.go", ["Tax"]],
+			["removed", "tax.go"],
+		]);
+		expect(cache.stats.misses).toBe(3);
+	});
+
+	it("should reject an invalid debounce interval", async () => {
+		const analyzer = new SemanticAnalyzer();
+
+		await expect(
+			analyzer.watch(root, () => {}, { debounceMs: -1 }),
+		).rejects.toThrow("Invalid debounce interval: -1");
+	});
+});